@@ -0,0 +1,155 @@
+package baidu
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseNewsHTML parses a Baidu News (tn=news) HTML document.
+func ParseNewsHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyBaiduDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseBaiduNewsDocument(doc, 0, time.Now()), nil
+}
+
+// parseBaiduNewsDocument extracts news cards. Title links are Baidu redirect
+// hops, so the card's mu= attribute is preferred as the story URL.
+func parseBaiduNewsDocument(doc *goquery.Document, start int, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.NewsResults).Each(func(_ int, card *goquery.Selection) {
+		if baiduSelectionHasAdMarker(card) {
+			return
+		}
+		titleTag := card.Find(Selectors.NewsTitle).First()
+		href := canonicalBaiduURL(card)
+		if href == "" {
+			href = strings.TrimSpace(titleTag.AttrOr("href", ""))
+		}
+		title := core.NormalizeWhitespace(titleTag.Text())
+		if href == "" || title == "" {
+			return
+		}
+
+		meta := &core.NewsMeta{
+			Source:    core.NormalizeWhitespace(card.Find(Selectors.NewsSource).First().Text()),
+			Thumbnail: strings.TrimSpace(card.Find(Selectors.NewsThumbnail).First().AttrOr("src", "")),
+		}
+		if published, ok := core.ParsePublishedTime(card.Find(Selectors.NewsTime).First().Text(), now); ok {
+			meta.PublishedAt = published
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.NewsSnippet).First().Text()),
+			News:         meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// SearchNews runs a raw HTTP request against Baidu News.
+func SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "baidu", false)
+
+	newsURL, err := BuildNewsURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", newsURL).Debug(fmt.Sprintf("Baidu News URL built: %s", newsURL))
+
+	res, err := core.RawSearchRequest(ctx, newsURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyBaiduDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseBaiduNewsDocument(doc, query.Start, time.Now())
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: baidu news returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchNews executes a Baidu News search in the browser.
+func (baid *Baidu) SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, baid.Name(), true)
+	scoped := *baid
+	scoped.logger = baid.logger.WithRequest(ctx)
+	baid = &scoped
+
+	baid.logger.Debug("Starting news search, query: %+v", query)
+	u, err := BuildNewsURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := baid.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &baid.Browser)()
+
+	waitFor := []string{Selectors.NewsResults, Selectors.NoResults, Selectors.Captcha, Selectors.Timeout}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, baid.GetSelectorTimeout()); err != nil {
+		if blockErr := baid.classifyBlockPage(page, u); blockErr != nil {
+			return nil, blockErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyBaiduDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		baid.logger.Error("Page classified as %v: %s", pageErr, u)
+		return nil, pageErr
+	}
+
+	results := parseBaiduNewsDocument(doc, query.Start, time.Now())
+	baid.logger.Info("News search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
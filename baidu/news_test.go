@@ -0,0 +1,64 @@
+package baidu
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+func TestParseBaiduNewsDocument(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/news_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseBaiduNewsDocument(doc, 20, now)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 news results with the ad skipped, got %d", len(results))
+	}
+
+	first := results[0]
+	if first.URL != "https://www.oschina.net/news/280001/go-1-22-released" {
+		t.Fatalf("expected mu= URL over the redirect hop, got %q", first.URL)
+	}
+	if first.Rank != 21 || first.News.Source != "开源中国" || !first.News.PublishedAt.Equal(now.Add(-3*time.Hour)) {
+		t.Fatalf("unexpected first result: %+v %+v", first, first.News)
+	}
+	if first.News.Thumbnail == "" {
+		t.Fatal("expected thumbnail to be captured")
+	}
+	if want := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC); !results[1].News.PublishedAt.Equal(want) {
+		t.Fatalf("unexpected absolute date: %s", results[1].News.PublishedAt)
+	}
+}
+
+func TestBuildNewsURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildNewsURL(core.Query{Text: "golang", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildNewsURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if params.Get("tn") != "news" || params.Get("wd") != "golang" || params.Get("pn") != "10" {
+		t.Fatalf("unexpected news URL: %s", u)
+	}
+	if _, err := BuildNewsURL(core.Query{Text: "golang", DateInterval: "20240101"}); err == nil {
+		t.Fatal("expected malformed date interval to fail")
+	}
+}
@@ -45,7 +45,8 @@ func parseBaiduDocument(doc *goquery.Document) []core.SearchResult {
 	// collected. Selecting one variant at a time and returning on the first hit
 	// dropped baike and other result-op cards that interleave with organic rows.
 	results := parseBaiduSelection(doc.Find(baiduResultSelector()))
-	return core.AttachFeaturesToFirstResult(results, features)
+	results = core.AttachFeaturesToFirstResult(results, features)
+	return core.AttachSerpMetaToFirstResult(results, parseBaiduSerpMeta(doc))
 }
 
 func baiduResultSelectors() []string {
@@ -139,14 +140,19 @@ func parseBaiduSelection(sel *goquery.Selection) []core.SearchResult {
 		}
 
 		resultRank, absoluteRank := rank.Next(isAd)
-		results = append(results, core.SearchResult{
+		result := core.SearchResult{
 			Rank:         resultRank,
 			AbsoluteRank: absoluteRank,
 			URL:          href,
 			Title:        title,
 			Description:  desc,
 			Ad:           isAd,
-		})
+		}
+		if !isAd {
+			core.ApplyResultExtras(&result, item, Selectors.Extras, nil)
+		}
+		result.Layout = core.SelectionLayout(item)
+		results = append(results, result)
 	})
 
 	// Re-rank sequentially after dedup so callers get a clean 1..N sequence
@@ -117,6 +117,29 @@ func TestParseBaiduHTMLFallbackSelectors(t *testing.T) {
 	}
 }
 
+func TestParseBaiduHTMLMobileCard(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<div id="results">
+  <div class="c-result result" tpl="www_normal">
+    <a class="c-blocka" href="https://m.example.com/article"><h3 class="c-title">Mobile Title</h3></a>
+    <div class="c-abstract">Mobile description</div>
+  </div>
+</div>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 1 || results[0].URL != "https://m.example.com/article" || results[0].Title != "Mobile Title" {
+		t.Fatalf("unexpected mobile results: %+v", results)
+	}
+	if results[0].Description != "Mobile description" {
+		t.Fatalf("unexpected description: %s", results[0].Description)
+	}
+}
+
 func TestParseBaiduHTMLFallsBackWhenEarlierSelectorHasNoResult(t *testing.T) {
 	t.Parallel()
 
@@ -190,3 +213,33 @@ func TestParseBaiduHTMLAdsDoNotConsumeOrganicRank(t *testing.T) {
 		t.Fatalf("unexpected absolute ranks: %d, %d, %d", results[0].AbsoluteRank, results[1].AbsoluteRank, results[2].AbsoluteRank)
 	}
 }
+
+func TestParseBaiduHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	html := `<html lang="zh-CN"><body>
+<input id="kw" name="wd" value="百渡"/>
+<div class="hit_top_new"><span>您要找的是：</span><a href="/s?wd=百度"><strong>百度</strong></a></div>
+<span class="hint_PIwZX">百度为您找到相关结果约100,000,000个</span>
+<div id="content_left">
+  <div class="result-op c-container">
+    <h3><a href="https://example.com/result">Title</a></h3>
+    <div class="summary-gap_3Jb4I">Description</div>
+  </div>
+</div></body></html>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.TotalResults != 100000000 {
+		t.Errorf("TotalResults = %d, want 100000000", meta.TotalResults)
+	}
+	if meta.SuggestedQuery != "百度" || meta.EffectiveQuery != "百渡" || meta.Locale != "zh-CN" {
+		t.Errorf("meta = %+v", meta)
+	}
+}
@@ -0,0 +1,182 @@
+package baidu
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseScholarHTML parses a Baidu Xueshu results HTML document.
+func ParseScholarHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyBaiduScholarDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseBaiduScholarDocument(doc, 0), nil
+}
+
+// classifyBaiduScholarDocument adds Xueshu's empty-result notice to the
+// block checks shared with web search.
+func classifyBaiduScholarDocument(doc *goquery.Document) error {
+	if err := classifyBaiduDocument(doc); err != nil {
+		return err
+	}
+	if doc.Find(Selectors.ScholarResults).Length() == 0 && doc.Find(Selectors.ScholarNoResults).Length() > 0 {
+		return core.ErrEmptyResult
+	}
+	return nil
+}
+
+// parseBaiduScholarDocument extracts paper cards. The title links Xueshu's
+// paper page, which lists every database holding the paper.
+func parseBaiduScholarDocument(doc *goquery.Document, start int) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.ScholarResults).Each(func(_ int, card *goquery.Selection) {
+		titleTag := card.Find(Selectors.ScholarTitle).First()
+		href := baiduXueshuHref(strings.TrimSpace(titleTag.AttrOr("href", "")))
+		title := core.NormalizeWhitespace(titleTag.Text())
+		if !strings.HasPrefix(href, "http") || title == "" {
+			return
+		}
+
+		meta := &core.ScholarMeta{
+			Venue:    strings.Trim(core.NormalizeWhitespace(card.Find(Selectors.ScholarVenue).First().Text()), "《》"),
+			Year:     core.ParseScholarYear(card.Find(Selectors.ScholarYear).First().Text()),
+			CitedBy:  core.ParseScholarCount(card.Find(Selectors.ScholarCitedBy).First().Text()),
+			Versions: card.Find(Selectors.ScholarSources).Length(),
+		}
+		card.Find(Selectors.ScholarAuthors).Each(func(_ int, author *goquery.Selection) {
+			if name := core.NormalizeWhitespace(author.Text()); name != "" {
+				meta.Authors = append(meta.Authors, name)
+			}
+		})
+		if pdf := baiduXueshuHref(strings.TrimSpace(card.Find(Selectors.ScholarPDF).First().AttrOr("href", ""))); strings.HasPrefix(pdf, "http") {
+			meta.PDFURL = pdf
+		}
+		if versions := baiduXueshuHref(strings.TrimSpace(card.Find(Selectors.ScholarVersions).First().AttrOr("href", ""))); strings.HasPrefix(versions, "http") {
+			meta.VersionsURL = versions
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.ScholarSnippet).First().Text()),
+			Scholar:      meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// baiduXueshuHref makes Xueshu's relative and protocol-relative links
+// absolute.
+func baiduXueshuHref(href string) string {
+	switch {
+	case strings.HasPrefix(href, "//"):
+		return "https:" + href
+	case strings.HasPrefix(href, "/"):
+		return "https://xueshu.baidu.com" + href
+	}
+	return href
+}
+
+// SearchScholar runs a raw HTTP request against Baidu Xueshu.
+func SearchScholar(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "baidu", false)
+
+	scholarURL, err := BuildScholarURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", scholarURL).Debug(fmt.Sprintf("Baidu Xueshu URL built: %s", scholarURL))
+
+	res, err := core.RawSearchRequest(ctx, scholarURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyBaiduScholarDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseBaiduScholarDocument(doc, query.Start)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: baidu xueshu returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchScholar executes a Baidu Xueshu search in the browser.
+func (baid *Baidu) SearchScholar(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, baid.Name(), true)
+	scoped := *baid
+	scoped.logger = baid.logger.WithRequest(ctx)
+	baid = &scoped
+
+	baid.logger.Debug("Starting scholar search, query: %+v", query)
+	u, err := BuildScholarURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := baid.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &baid.Browser)()
+
+	waitFor := []string{Selectors.ScholarResults, Selectors.ScholarNoResults, Selectors.Captcha, Selectors.Timeout}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, baid.GetSelectorTimeout()); err != nil {
+		if blockErr := baid.classifyBlockPage(page, u); blockErr != nil {
+			return nil, blockErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyBaiduScholarDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		baid.logger.Error("Page classified as %v: %s", pageErr, u)
+		return nil, pageErr
+	}
+
+	results := parseBaiduScholarDocument(doc, query.Start)
+	baid.logger.Info("Scholar search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
@@ -0,0 +1,81 @@
+package baidu
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+func TestParseBaiduScholarDocument(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/scholar_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseBaiduScholarDocument(doc, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 scholar results, got %d", len(results))
+	}
+
+	first := results[0]
+	if first.URL != "https://xueshu.baidu.com/usercenter/paper/show?paperid=7c1a5d9e1f0b2e3a&site=xueshu_se" || first.Title != "深度学习研究综述" || first.Rank != 1 {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	want := core.ScholarMeta{
+		Authors:     []string{"孙志军", "薛磊", "许阳明"},
+		Venue:       "计算机应用研究",
+		Year:        2012,
+		CitedBy:     1152,
+		PDFURL:      "https://www.arocmag.com/getarticle/?aid=abc.pdf",
+		Versions:    3,
+		VersionsURL: "https://xueshu.baidu.com/usercenter/paper/show?paperid=7c1a5d9e1f0b2e3a&tab=versions",
+	}
+	if !reflect.DeepEqual(*first.Scholar, want) {
+		t.Fatalf("unexpected scholar metadata: %+v", first.Scholar)
+	}
+
+	second := results[1].Scholar
+	if second.Venue != "Nature" || second.Year != 2015 || second.CitedBy != 56210 || second.PDFURL != "https://www.cs.toronto.edu/~hinton/absps/NatureDeepReview.pdf" {
+		t.Fatalf("unexpected second result metadata: %+v", second)
+	}
+}
+
+func TestParseBaiduScholarHTMLNoResults(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/scholar_no_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	results, err := ParseScholarHTML(bytes.NewReader(data))
+	if err != nil || len(results) != 0 {
+		t.Fatalf("expected empty results, got %d results, err %v", len(results), err)
+	}
+}
+
+func TestBuildScholarURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildScholarURL(core.Query{Text: "深度学习", DateInterval: "20150101..20201231", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildScholarURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Host != "xueshu.baidu.com" || params.Get("wd") != "深度学习" || params.Get("pn") != "10" || params.Get("filter") != "sc_year={2015,2020}" {
+		t.Fatalf("unexpected scholar URL: %s", u)
+	}
+}
@@ -94,10 +94,12 @@ func (baid *Baidu) Search(ctx context.Context, query core.Query) (results []core
 	}
 	defer core.DeferClosePage(ctx, page, &baid.Browser)()
 
-	searchResults, err := baid.waitForParsedSearchResults(ctx, page, url)
+	searchResults, layout, err := baid.waitForParsedSearchResults(ctx, page, url, query.Layout)
 	if err != nil {
 		return nil, err
 	}
+	core.CaptureScreenshot(ctx, page)
+	searchResults = core.AttachSerpMetaToFirstResult(searchResults, core.WithSerpLayout(searchResults[0].SerpMeta, layout))
 
 	for i := range searchResults {
 		if searchResults[i].AbsoluteRank > 0 {
@@ -112,7 +114,11 @@ func (baid *Baidu) Search(ctx context.Context, query core.Query) (results []core
 	return searchResults, nil
 }
 
-func (baid *Baidu) waitForParsedSearchResults(ctx context.Context, page *rod.Page, url string) ([]core.SearchResult, error) {
+// waitForParsedSearchResults polls the page HTML until it parses into
+// results or a block page. With measure set the page is stamped before each
+// snapshot so rows carry their layout boxes, and the last page-level layout
+// is returned.
+func (baid *Baidu) waitForParsedSearchResults(ctx context.Context, page *rod.Page, url string, measure bool) ([]core.SearchResult, *core.SerpLayout, error) {
 	timeout := baid.GetSelectorTimeout()
 	if timeout <= 0 {
 		timeout = 5 * time.Second
@@ -120,23 +126,31 @@ func (baid *Baidu) waitForParsedSearchResults(ctx context.Context, page *rod.Pag
 	deadline := time.Now().Add(timeout)
 	var sawResultContainer bool
 	var lastErr error
+	var layout *core.SerpLayout
 
 	for {
+		// Archive every polled snapshot before stamping marks it, so the
+		// captcha, timeout and parse-error returns below leave the page they
+		// gave up on; the last snapshot replaces the earlier ones.
+		core.ArchivePage(ctx, page)
+		if measure {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
 		html, err := page.HTML()
 		if err == nil {
 			results, parseErr := ParseHTML(strings.NewReader(html))
 			if parseErr == nil && len(results) > 0 {
-				return results, nil
+				return results, layout, nil
 			}
 			if parseErr != nil && !errors.Is(parseErr, core.ErrEmptyResult) {
 				baid.logger.Error("Page classified as %v: %s", parseErr, url)
-				return nil, parseErr
+				return nil, nil, parseErr
 			}
 			lastErr = parseErr
 		} else {
 			lastErr = err
 			if blockErr := baid.classifyBlockPage(page, url); blockErr != nil {
-				return nil, blockErr
+				return nil, nil, blockErr
 			}
 		}
 
@@ -147,7 +161,7 @@ func (baid *Baidu) waitForParsedSearchResults(ctx context.Context, page *rod.Pag
 			break
 		}
 		if err := core.SleepContext(ctx, 120*time.Millisecond); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -157,14 +171,14 @@ func (baid *Baidu) waitForParsedSearchResults(ctx context.Context, page *rod.Pag
 		} else {
 			baid.logger.Debug("Baidu result containers found but no parseable organic results")
 		}
-		return nil, core.ErrParser
+		return nil, nil, core.ErrParser
 	}
 	// The page never reached a recognizable state: no result containers, no
 	// captcha or timeout markers. Baidu hydrates result cards client-side and
 	// can exceed the selector deadline, so report a timeout rather than a
 	// successful empty SERP — callers must retry/skip, not trust 0 results.
 	baid.logger.Debug("No result containers or block markers within selector timeout")
-	return nil, core.ErrSearchTimeout
+	return nil, nil, core.ErrSearchTimeout
 }
 
 // SearchImage executes a Baidu image search and returns normalized image
@@ -1,25 +1,64 @@
 package baidu
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for Baidu SERP CSS selectors.
 var Selectors = struct {
-	Captcha       string
-	Timeout       string
-	NoResults     string
-	Results       string
-	ResultsAlt    []string
-	AdMarkers     []string
-	ImageJSONRoot []string
-	Link          string
-	Desc          string
+	Captcha   string
+	Timeout   string
+	NoResults string
+	// SERP meta: the "百度为您找到相关结果约N个" line, the correction
+	// notices and the search box.
+	ResultCount    string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+	Results        string
+	ResultsAlt     []string
+	AdMarkers      []string
+	ImageJSONRoot  []string
+	Link           string
+	Desc           string
 	// DescAlt are additional description containers tried when Desc misses.
 	// Baidu varies abstract markup across feature blocks (info cards, news rows).
 	DescAlt []string
+	// Extras reads the snippet date of organic results.
+	Extras core.ResultExtrasSelectors
+
+	// News search (tn=news).
+	NewsResults   string
+	NewsTitle     string
+	NewsSnippet   string
+	NewsSource    string
+	NewsTime      string
+	NewsThumbnail string
+
+	// Baidu Xueshu (xueshu.baidu.com) scholar search.
+	ScholarNoResults string
+	ScholarResults   string
+	ScholarTitle     string
+	ScholarAuthors   string
+	ScholarVenue     string
+	ScholarYear      string
+	ScholarCitedBy   string
+	ScholarSnippet   string
+	ScholarPDF       string
+	ScholarSources   string
+	ScholarVersions  string
 }{
-	Captcha:       "div.passMod_dialog-wrapper",
-	Timeout:       "button.timeout-button",
-	NoResults:     "div.content_none, div.nors",
-	Results:       "#content_left div.result.c-container",
-	ResultsAlt:    []string{"#content_left div.result-op.c-container", "div.c-container.new-pmd"},
+	Captcha:     "div.passMod_dialog-wrapper",
+	Timeout:     "button.timeout-button",
+	NoResults:   "div.content_none, div.nors",
+	ResultCount: "span.hint_PIwZX, span.nums_text",
+	// SpellCorrected is the "已显示“X”的搜索结果" notice (Baidu already ran X);
+	// SpellSuggested is the "您要找的是" link.
+	SpellCorrected: "#super_se_tip strong",
+	SpellSuggested: "div.hit_top_new a",
+	SearchBox:      "input#kw",
+	Results:        "#content_left div.result.c-container",
+	// The last ResultsAlt entry is the m.baidu.com card that mobile browsers
+	// are redirected to; its h3 and link parse like the desktop card.
+	ResultsAlt:    []string{"#content_left div.result-op.c-container", "div.c-container.new-pmd", "#results div.c-result"},
 	AdMarkers:     []string{"[data-tuiguang]", "[data-click*='tuiguang']", ".ec-tuiguang", ".c-icon-bear-p"},
 	ImageJSONRoot: []string{"body > pre", "pre"},
 	Link:          "a",
@@ -33,4 +72,40 @@ var Selectors = struct {
 	// text-styling class reused on dozens of nodes, so the baike abstract body is
 	// pinned to its exact .text_2NOr6 hash and tried last.
 	DescAlt: []string{"[class*='content-right_']", "[class*='summary-gap_']", "div.text_2NOr6"},
+	// Extras: dated abstracts open with a gray "2024年3月5日" span, the same
+	// shade news rows use for their age.
+	Extras: core.ResultExtrasSelectors{
+		Date: "span.c-color-gray2",
+	},
+
+	NewsResults: "#content_left div.result-op.c-container",
+	NewsTitle:   "h3 a",
+	NewsSnippet: "span.c-font-normal.c-color-text",
+	// NewsSource/NewsTime: the publisher and age share one meta row and are
+	// told apart only by their gray shade.
+	NewsSource:    "span.c-color-gray",
+	NewsTime:      "span.c-color-gray2",
+	NewsThumbnail: "img.c-img",
+
+	// The sc_info row holds the author links, the 《venue》 link, the year
+	// and the 被引量 (cited by) count. ScholarSources are the per-database
+	// copies (知网, 维普, ...); ScholarVersions links the full source list.
+	ScholarNoResults: "div.sc_noresult, #noresult_tip",
+	ScholarResults:   "div.result.sc_default_result",
+	ScholarTitle:     "h3.t a",
+	ScholarAuthors:   "div.sc_info a[data-click*='author']",
+	ScholarVenue:     "div.sc_info a[data-click*='journal']",
+	ScholarYear:      "div.sc_info span.sc_time",
+	ScholarCitedBy:   "div.sc_info a.sc_cite_cont",
+	ScholarSnippet:   "div.c_abstract",
+	ScholarPDF:       "a.sc_download, div.sc_allversion a[href$='.pdf']",
+	ScholarSources:   "div.sc_allversion span.v_item_span",
+	ScholarVersions:  "div.sc_allversion a.sc_all_version",
+}
+
+// SupportsDevice reports whether Baidu results can be parsed from device's
+// SERP on vertical. The m.baidu.com card in ResultsAlt covers web search
+// only.
+func SupportsDevice(device core.Device, vertical core.Vertical) bool {
+	return device != core.DeviceMobile || vertical == core.VerticalWeb
 }
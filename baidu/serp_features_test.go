@@ -92,3 +92,33 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<div id="content_left">
+  <div class="result c-container">
+    <h3><a href="http://www.baidu.com/link?url=abc">火锅_百度百科</a></h3>
+    <div class="c-abstract"><span class="c-color-gray2">2024年3月5日 </span>火锅，古称“古董羹”。</div>
+  </div>
+  <div class="result c-container">
+    <h3><a href="http://www.baidu.com/link?url=def">火锅的做法</a></h3>
+    <div class="c-abstract"><span class="c-color-gray2">下厨房</span>重庆火锅底料的做法。</div>
+  </div>
+</div>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected two results, got %+v", results)
+	}
+	if rich := results[0].RichSnippet; rich == nil || rich.Date != "2024年3月5日" || rich.PublishedAt == "" {
+		t.Fatalf("unexpected rich snippet: %+v", rich)
+	}
+	if results[1].RichSnippet != nil {
+		t.Fatalf("a gray source label is not a date, got %+v", results[1].RichSnippet)
+	}
+}
@@ -0,0 +1,20 @@
+package baidu
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseBaiduSerpMeta reads the result estimate, correction notices and
+// locale. The browser path parses page HTML through ParseHTML, so this serves
+// both parsers.
+func parseBaiduSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		TotalResults:   core.ParseResultCount(doc.Find(Selectors.ResultCount).First().Text()),
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+		SuggestedQuery: strings.TrimSpace(doc.Find(Selectors.SpellSuggested).First().Text()),
+	}
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
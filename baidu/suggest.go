@@ -0,0 +1,40 @@
+package baidu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches Baidu's query completions for query.Text from the sugrec
+// endpoint the baidu.com search box uses.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "baidu", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("Baidu suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, parseSuggestions)
+}
+
+// parseSuggestions reads the sugrec payload: {"q": "...", "g": [{"q": "..."}]}.
+// "g" is absent when Baidu has no completions.
+func parseSuggestions(body []byte) ([]string, error) {
+	var payload struct {
+		G []struct {
+			Q string `json:"q"`
+		} `json:"g"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	texts := make([]string, 0, len(payload.G))
+	for _, item := range payload.G {
+		texts = append(texts, item.Q)
+	}
+	return texts, nil
+}
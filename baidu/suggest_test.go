@@ -0,0 +1,41 @@
+package baidu
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseSuggestions(t *testing.T) {
+	body, err := io.ReadAll(testutil.ResponseFromFixture(t, "suggest.json").Body)
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	texts, err := parseSuggestions(body)
+	if err != nil {
+		t.Fatalf("parseSuggestions() error = %v", err)
+	}
+	if want := []string{"golang教程", "golang面试题", "golang 官网"}; !reflect.DeepEqual(texts, want) {
+		t.Fatalf("unexpected suggestions %v", texts)
+	}
+
+	// Queries without completions come back without the "g" array.
+	texts, err = parseSuggestions([]byte(`{"q":"zzqx","p":false}`))
+	if err != nil || len(texts) != 0 {
+		t.Fatalf("expected no suggestions, got %v, %v", texts, err)
+	}
+}
+
+func TestBuildSuggestURL(t *testing.T) {
+	u, err := BuildSuggestURL(core.Query{Text: "golang"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	if !strings.HasPrefix(u, "https://www.baidu.com/sugrec?") || !strings.Contains(u, "wd=golang") {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+}
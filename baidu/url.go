@@ -22,6 +22,23 @@ func dateToTimestamp(date string) (int64, error) {
 	return t.Unix(), nil
 }
 
+// Operators is Baidu's spelling of the structured query operators. Baidu
+// uses | for OR and has no -site: exclusion.
+var Operators = core.OperatorSyntax{
+	Phrase:  true,
+	Exclude: "-",
+	InTitle: "intitle:",
+	InURL:   "inurl:",
+	Or:      " | ",
+}
+
+// SupportsSafeSearch reports whether Baidu can honour level. Baidu filters
+// adult content for every query and has no parameter to relax or tighten it,
+// so only the moderate default is honoured.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	return level == "" || level == core.SafeSearchModerate
+}
+
 // BuildURL builds a Baidu web search URL from Query fields.
 // It returns an error when query text, date, or pagination parameters are invalid.
 func BuildURL(q core.Query) (string, error) {
@@ -29,7 +46,7 @@ func BuildURL(q core.Query) (string, error) {
 	base.Path += "s"
 
 	params := url.Values{}
-	if q.Text != "" || q.Site != "" || q.Filetype != "" {
+	if !q.IsEmpty() {
 		text := q.Text
 		if q.Site != "" {
 			text += " site:" + q.Site
@@ -37,6 +54,8 @@ func BuildURL(q core.Query) (string, error) {
 		if q.Filetype != "" {
 			text += " filetype:" + q.Filetype
 		}
+		operators, _ := Operators.Compile(q)
+		text += operators
 		params.Add("wd", text)
 	}
 
@@ -86,6 +105,101 @@ func BuildURL(q core.Query) (string, error) {
 	return base.String(), nil
 }
 
+// BuildNewsURL builds a Baidu News (tn=news) search URL from Query fields.
+// It returns an error when query text, date, or pagination parameters are invalid.
+func BuildNewsURL(q core.Query) (string, error) {
+	base, _ := url.Parse("https://www.baidu.com/")
+	base.Path += "s"
+
+	params := url.Values{}
+	params.Add("tn", "news")
+	params.Add("rtt", "1")  // Sort by relevance; 4 sorts by time
+	params.Add("bsst", "1") // Include non-whitelisted news sources
+	params.Add("cl", "2")   // Cl = 2 indicates news search
+
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("wd", text)
+	}
+
+	if len(params.Get("wd")) == 0 {
+		return "", errors.New("Empty query built")
+	}
+
+	if q.DateInterval != "" {
+		dateInterval := strings.Split(q.DateInterval, "..")
+		if len(dateInterval) != 2 {
+			return "", errors.New("incorrect date interval provided")
+		}
+
+		ts1, err := dateToTimestamp(dateInterval[0])
+		if err != nil {
+			return "", err
+		}
+		ts2, err := dateToTimestamp(dateInterval[1])
+		if err != nil {
+			return "", err
+		}
+
+		params.Add("gpc", fmt.Sprintf("stf=%d,%d|stftype=2", ts1, ts2))
+	}
+
+	if q.Start < 0 {
+		return "", errors.New("incorrect start provided")
+	}
+	if q.Start > 0 {
+		params.Add("pn", strconv.Itoa(q.Start))
+	}
+
+	params.Add("ie", "utf-8")
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildScholarURL builds a Baidu Xueshu search URL from Query fields.
+// DateInterval is reduced to the publication year range Xueshu filters by
+// (filter=sc_year={from,to}). It returns an error when the query text is
+// empty or the interval or start is invalid.
+func BuildScholarURL(q core.Query) (string, error) {
+	base, _ := url.Parse("https://xueshu.baidu.com/s")
+
+	params := url.Values{}
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("wd", text)
+	}
+	if len(params.Get("wd")) == 0 {
+		return "", errors.New("Empty query built")
+	}
+
+	if q.DateInterval != "" {
+		from, to, err := core.ScholarYearRange(q.DateInterval)
+		if err != nil {
+			return "", err
+		}
+		params.Add("filter", fmt.Sprintf("sc_year={%d,%d}", from, to))
+	}
+
+	if q.Start < 0 {
+		return "", errors.New("incorrect start provided")
+	}
+	if q.Start > 0 {
+		params.Add("pn", strconv.Itoa(q.Start))
+	}
+
+	params.Add("tn", "SE_baiduxueshu_c1gjeupa")
+	params.Add("sc_hit", "1")
+	params.Add("ie", "utf-8")
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
 // BuildImageURL builds a Baidu image search URL from Query fields and page
 // index. It returns an error when the query text is empty.
 func BuildImageURL(q core.Query, pageNum int) (string, error) {
@@ -118,3 +232,15 @@ func BuildImageURL(q core.Query, pageNum int) (string, error) {
 	base.RawQuery = params.Encode()
 	return base.String(), nil
 }
+
+// BuildSuggestURL builds a Baidu autocomplete URL for q.Text. Baidu serves one
+// Chinese-language market, so LangCode and Region are not encoded.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params := url.Values{}
+	params.Add("prod", "pc")
+	params.Add("wd", q.Text)
+	return "https://www.baidu.com/sugrec?" + params.Encode(), nil
+}
@@ -309,3 +309,37 @@ func TestBuildImageURL(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildURLSafeSearch(t *testing.T) {
+	for _, level := range []core.SafeSearch{"", core.SafeSearchOff, core.SafeSearchModerate, core.SafeSearchStrict} {
+		q := core.Query{Text: "golang", SafeSearch: level}
+		for _, build := range []func(core.Query) (string, error){BuildURL, BuildImageURL, BuildVideoURL} {
+			got, err := build(q)
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			parsed, _ := url.Parse(got)
+			if adlt := parsed.Query().Get("adlt"); adlt != string(level) {
+				t.Fatalf("adlt=%q for level %q (%s)", adlt, level, got)
+			}
+		}
+	}
+	if !SupportsSafeSearch(core.SafeSearchOff) || !SupportsSafeSearch(core.SafeSearchStrict) || SupportsSafeSearch("family") {
+		t.Fatal("Bing supports exactly the off, moderate and strict levels")
+	}
+}
+
+func TestBuildURLVerbatim(t *testing.T) {
+	got, err := BuildURL(core.Query{Text: "pyhton", Verbatim: true})
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	parsed, _ := url.Parse(got)
+	if qs := parsed.Query().Get("qs"); qs != "n" {
+		t.Fatalf("qs=%q, want n (%s)", qs, got)
+	}
+	plain, _ := BuildURL(core.Query{Text: "pyhton"})
+	if parsed, _ := url.Parse(plain); parsed.Query().Has("qs") {
+		t.Fatalf("non-verbatim URL carries qs: %s", plain)
+	}
+}
@@ -0,0 +1,60 @@
+package bing
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// extractBingCopilotAnswer reads the Copilot answer with its sections and
+// numbered citations. Layouts Selectors.CopilotAnswer does not know fall back
+// to the generic ai_summary selector, which keeps the whole module text.
+func extractBingCopilotAnswer(doc *goquery.Document) (*core.SerpFeature, core.AISummaryStatus) {
+	answer, status := core.ExtractAISummary(doc, Selectors.CopilotAnswer, bingAbsoluteHref)
+	if answer == nil {
+		return nil, status
+	}
+	titles := bingCitationTitles(doc.Find(Selectors.CopilotAnswer.Container).First())
+	for i := range answer.Links {
+		applyBingCitation(&answer.Links[i], titles)
+	}
+	answer.Title = "Copilot"
+	answer.Position = &core.Position{Absolute: 1}
+	return answer, status
+}
+
+// bingCitationTitles maps citation numbers to the page titles their inline
+// badges announce ("FileReader - Web APIs | MDN - https://...").
+func bingCitationTitles(container *goquery.Selection) map[int]string {
+	titles := map[int]string{}
+	container.Find(Selectors.CopilotCitation).Each(func(_ int, badge *goquery.Selection) {
+		n, err := strconv.Atoi(strings.TrimSpace(badge.Find(Selectors.CopilotCitationIndex).First().Text()))
+		if err != nil || titles[n] != "" {
+			return
+		}
+		label := core.NormalizeWhitespace(badge.AttrOr("aria-label", ""))
+		if cut := strings.LastIndex(label, " - http"); cut > 0 {
+			label = label[:cut]
+		}
+		titles[n] = label
+	})
+	return titles
+}
+
+// applyBingCitation splits a source link labelled "2-Mozilla.org" into its
+// citation number and site, and titles it after the matching badge.
+func applyBingCitation(link *core.FeatureLink, titles map[int]string) {
+	number, site, found := strings.Cut(link.Title, "-")
+	n, err := strconv.Atoi(strings.TrimSpace(number))
+	if !found || err != nil || strings.TrimSpace(site) == "" {
+		return
+	}
+	link.Position = n
+	link.Source = strings.TrimSpace(site)
+	link.Title = link.Source
+	if title := titles[n]; title != "" {
+		link.Title = title
+	}
+}
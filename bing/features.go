@@ -8,46 +8,80 @@ import (
 	"github.com/karust/openserp/core"
 )
 
+// bingFeatureSelectors are the SERP modules extractBingFeatures reads; their
+// containers are also measured for layout=true.
+var bingFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type: core.ResultTypeAnswerBox,
+		// li.b_ans also wraps related modules; require answer payload.
+		Container:     []string{"li.b_ans:has(.b_focusTextLarge)", "li.b_ans:has(.b_focusLabel)", "li.b_ans:has(.b_xlText)", "li.b_ans:has(.b_factrow):not(:has(.b_entityTitle))"},
+		TitleSelector: []string{".b_focusLabel", "h2"},
+		TextSelector:  []string{".b_focusTextLarge", ".b_xlText", ".b_vPanel .b_factrow", ".b_caption p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.8,
+	},
+	{
+		Type:         core.ResultTypeRelatedQuestions,
+		Title:        "People also ask",
+		Container:    []string{".b_rrsr", ".rqnaacfacc", "li.b_ans:has(.df_alaskcr)"},
+		ItemSelector: []string{".df_qntext", ".rqnaacfacc a", "li a"},
+		LinkSelector: []string{"a[href^='http']"},
+		Confidence:   0.7,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"#brsv3", "#rs_root", "#inline_rs", "#brs", "#b_rs", "ol#b_rs", "li.b_rs"},
+		ItemSelector: []string{"li.rslist a", "li a", "a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.75,
+		SingleMatch:  true,
+	},
+	bingAIAnswerSelector(".developer_answercard_wrapper", ".ca_container", "#b_sydConvCont", ".b_sydConvCont", "[data-testid='bing-chat-answer']"),
+}
+
+// layoutContainers lists what StampLayout measures on a Bing SERP: the
+// result and ad blocks and every feature container the extractors read.
+func layoutContainers() []string {
+	results := []string{
+		Selectors.Results,
+		Selectors.Ads,
+		Selectors.CopilotAnswer.Container,
+		Selectors.ProductCarouselItem,
+		Selectors.LocalResults,
+		Selectors.KnowledgePanel.Container,
+	}
+	return core.LayoutContainers(results, bingFeatureSelectors, []core.SerpFeatureSelector{bingAIAnswerSelector("#ca_main")})
+}
+
 func extractBingFeatures(doc *goquery.Document) []core.SerpFeature {
-	return core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{
-		{
-			Type: core.ResultTypeAnswerBox,
-			// li.b_ans also wraps related modules; require answer payload.
-			Container:     []string{"li.b_ans:has(.b_focusTextLarge)", "li.b_ans:has(.b_focusLabel)", "li.b_ans:has(.b_xlText)", "li.b_ans:has(.b_factrow)"},
-			TitleSelector: []string{".b_focusLabel", "h2"},
-			TextSelector:  []string{".b_focusTextLarge", ".b_xlText", ".b_vPanel .b_factrow", ".b_caption p"},
-			LinkSelector:  []string{"a[href^='http']"},
-			Position:      1,
-			Confidence:    0.8,
-		},
-		{
-			Type:         core.ResultTypeRelatedQuestions,
-			Title:        "People also ask",
-			Container:    []string{".b_rrsr", ".rqnaacfacc", "li.b_ans:has(.df_alaskcr)"},
-			ItemSelector: []string{".df_qntext", ".rqnaacfacc a", "li a"},
-			LinkSelector: []string{"a[href^='http']"},
-			Confidence:   0.7,
-		},
-		{
-			Type:         core.ResultTypeRelatedSearches,
-			Title:        "Related searches",
-			Container:    []string{"#brsv3", "#rs_root", "#inline_rs", "#brs", "#b_rs", "ol#b_rs", "li.b_rs"},
-			ItemSelector: []string{"li.rslist a", "li a", "a"},
-			LinkSelector: []string{"a[href^='http']", "a"},
-			Confidence:   0.75,
-			SingleMatch:  true,
-		},
-		{
-			Type:          core.ResultTypeAISummary,
-			Title:         "AI answer",
-			Container:     []string{".developer_answercard_wrapper", "#ca_main", ".ca_container", "#b_sydConvCont", ".b_sydConvCont", "[data-testid='bing-chat-answer']"},
-			TitleSelector: []string{"h2.b_topTitle", ".b_sydAns"},
-			TextSelector:  []string{".devmag_card_content", ".rd_def_list", ".b_sydAns", "[data-testid='answer']", ".ca_div", "p"},
-			LinkSelector:  []string{".rd_cnt_srcs a[href^='http']", ".rd_gencon_attr a[href^='http']", "h2.b_topTitle a[href^='http']", "a[href^='http']"},
-			Position:      1,
-			Confidence:    0.6,
-		},
-	})
+	features := core.ExtractSerpFeaturesBySelectors(doc, bingFeatureSelectors)
+	// The Copilot answer is read with its sections and citations; the generic
+	// selector only covers Copilot layouts Selectors.CopilotAnswer misses.
+	if answer, _ := extractBingCopilotAnswer(doc); answer != nil {
+		features = append([]core.SerpFeature{*answer}, features...)
+	} else {
+		features = append(core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{bingAIAnswerSelector("#ca_main")}), features...)
+	}
+	features = append(features, extractBingProductCarousel(doc)...)
+	features = append(features, extractBingLocalPack(doc)...)
+	return append(features, core.ExtractKnowledgePanel(doc, Selectors.KnowledgePanel, bingAbsoluteHref)...)
+}
+
+// bingAIAnswerSelector is the generic ai_summary selector for the given
+// generative answer containers.
+func bingAIAnswerSelector(containers ...string) core.SerpFeatureSelector {
+	return core.SerpFeatureSelector{
+		Type:          core.ResultTypeAISummary,
+		Title:         "AI answer",
+		Container:     containers,
+		TitleSelector: []string{"h2.b_topTitle", ".b_sydAns"},
+		TextSelector:  []string{".devmag_card_content", ".rd_def_list", ".b_sydAns", "[data-testid='answer']", ".ca_div", "p"},
+		LinkSelector:  []string{".rd_cnt_srcs a[href^='http']", ".rd_gencon_attr a[href^='http']", "h2.b_topTitle a[href^='http']", "a[href^='http']"},
+		Position:      1,
+		Confidence:    0.6,
+	}
 }
 
 func extractBingFeaturesFromPage(ctx context.Context, page *rod.Page) []core.SerpFeature {
@@ -0,0 +1,12 @@
+package bing
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// extractBingLocalPack captures the local business answer shown for place
+// queries.
+func extractBingLocalPack(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractLocalPack(doc, Selectors.LocalPack, Selectors.LocalResults, Selectors.LocalCard, bingAbsoluteHref)
+}
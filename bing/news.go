@@ -0,0 +1,134 @@
+package bing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseNewsHTML parses a Bing News search HTML document.
+func ParseNewsHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyBingDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseBingNewsDocument(doc, 0, time.Now()), nil
+}
+
+func parseBingNewsDocument(doc *goquery.Document, start int, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.NewsResults).Each(func(_ int, card *goquery.Selection) {
+		titleTag := card.Find(Selectors.NewsTitle).First()
+		href := firstNonEmptyAttr(card, "url")
+		if href == "" {
+			href = strings.TrimSpace(titleTag.AttrOr("href", ""))
+		}
+		title := firstNonEmptyAttr(card, "data-title")
+		if title == "" {
+			title = core.NormalizeWhitespace(titleTag.Text())
+		}
+		if href == "" || title == "" {
+			return
+		}
+
+		meta := &core.NewsMeta{Source: firstNonEmptyAttr(card, "data-author")}
+		if meta.Source == "" {
+			meta.Source = core.NormalizeWhitespace(card.Find(Selectors.NewsSource).First().Text())
+		}
+		timeTag := card.Find(Selectors.NewsTime).First()
+		rawTime := timeTag.AttrOr("aria-label", "")
+		if rawTime == "" {
+			rawTime = timeTag.Text()
+		}
+		if published, ok := core.ParsePublishedTime(rawTime, now); ok {
+			meta.PublishedAt = published
+		}
+		meta.Thumbnail = bingNewsThumbnail(card.Find(Selectors.NewsThumbnail).First())
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.NewsSnippet).First().Text()),
+			News:         meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// bingNewsThumbnail resolves the card image; Bing serves it from a relative
+// /th?id= path, and lazy-loaded cards keep the real URL in data-src.
+func bingNewsThumbnail(img *goquery.Selection) string {
+	src := firstNonEmptyAttr(img, "data-src", "src")
+	switch {
+	case strings.HasPrefix(src, "//"):
+		return "https:" + src
+	case strings.HasPrefix(src, "/"):
+		return "https://www.bing.com" + src
+	case strings.HasPrefix(src, "http"):
+		return src
+	default:
+		return ""
+	}
+}
+
+// SearchNews executes a Bing News search in the browser.
+func (bing *Bing) SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, bing.Name(), false)
+	scoped := *bing
+	scoped.logger = bing.logger.WithRequest(ctx)
+	bing = &scoped
+
+	bing.logger.Debug("Starting news search, query: %+v", query)
+	u, err := BuildNewsURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := bing.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &bing.Browser)()
+
+	if err := bing.acceptCookies(ctx, page); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := core.WaitForElements(ctx, page, []string{Selectors.NewsResults}, bing.GetSelectorTimeout()); err != nil {
+		if pageErr := core.ClassifyFromPage(page, classifyBingDocument); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			bing.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	results := parseBingNewsDocument(doc, query.Start, time.Now())
+	bing.logger.Info("News search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
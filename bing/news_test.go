@@ -0,0 +1,82 @@
+package bing
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseBingNewsDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "news_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseBingNewsDocument(doc, 0, now)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 news results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	if first.News.Source != "InfoWorld" || !first.News.PublishedAt.Equal(now.Add(-3*time.Hour)) {
+		t.Fatalf("unexpected news metadata: %+v", first.News)
+	}
+	if !strings.HasPrefix(first.News.Thumbnail, "https://www.bing.com/th?id=") {
+		t.Fatalf("expected absolute thumbnail URL, got %q", first.News.Thumbnail)
+	}
+
+	second := results[1]
+	if second.URL != "https://www.theregister.com/2024/03/01/go_survey/" || second.News.Source != "The Register" {
+		t.Fatalf("expected attribute-less card to fall back to child nodes: %+v", second)
+	}
+	if !second.News.PublishedAt.Equal(now.AddDate(0, 0, -2)) {
+		t.Fatalf("expected abbreviated age to parse, got %s", second.News.PublishedAt)
+	}
+}
+
+func TestBuildNewsURL(t *testing.T) {
+	u, err := BuildNewsURL(core.Query{Text: "golang", LangCode: "en", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildNewsURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Path != "/news/search" || params.Get("q") != "golang" || params.Get("first") != "11" || params.Get("mkt") != "en-US" {
+		t.Fatalf("unexpected news URL: %s", u)
+	}
+
+	if _, err := BuildNewsURL(core.Query{Text: "golang", DateInterval: "2024"}); err == nil {
+		t.Fatal("expected malformed date interval to fail")
+	}
+}
+
+func TestBingNewsInterval(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		interval string
+		want     string
+	}{
+		{"20240310..20240310", "7"},
+		{"20240305..20240310", "8"},
+		{"20240215..20240310", "9"},
+		{"20230101..20240310", ""},
+	}
+	for _, tt := range tests {
+		got, err := bingNewsInterval(tt.interval, now)
+		if err != nil {
+			t.Fatalf("bingNewsInterval(%q) error = %v", tt.interval, err)
+		}
+		if got != tt.want {
+			t.Fatalf("bingNewsInterval(%q) = %q, want %q", tt.interval, got, tt.want)
+		}
+	}
+}
@@ -60,11 +60,15 @@ func parseBingDocument(doc *goquery.Document) []core.SearchResult {
 		desc := bingDocumentDescription(item, title)
 
 		if res, ok := assembleBingRow(href, title, desc, isAd, rank); ok {
+			if isOrganic {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			}
 			results = append(results, res)
 		}
 	})
 
-	return core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractBingFeatures(doc))
+	results = core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractBingFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseBingSerpMeta(doc))
 }
 
 // assembleBingRow validates an already-extracted Bing row and assigns ranks.
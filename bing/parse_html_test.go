@@ -217,3 +217,36 @@ func TestParseBingHTMLDescriptionFallsThroughEmptyPrimary(t *testing.T) {
 		t.Fatalf("description = %q, want fallback snippet", results[0].Description)
 	}
 }
+
+func TestParseBingHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	html := `<html lang="en-US"><body>
+<input id="sb_form_q" name="q" value="pyhton tutorial"/>
+<span class="sb_count">About 1,230,000 results</span>
+<div id="sp_requery">Including results for <a href="/search?q=python+tutorial"><strong>python</strong> tutorial</a>.</div>
+<ol id="b_results">
+  <li class="b_algo">
+    <h2><a href="https://example.com/python">Python Tutorial</a></h2>
+    <div class="b_caption"><p>Learn Python</p></div>
+  </li>
+</ol></body></html>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.TotalResults != 1230000 {
+		t.Errorf("TotalResults = %d, want 1230000", meta.TotalResults)
+	}
+	if meta.CorrectedQuery != "python tutorial" || meta.EffectiveQuery != "python tutorial" {
+		t.Errorf("correction = %q / effective = %q", meta.CorrectedQuery, meta.EffectiveQuery)
+	}
+	if meta.Locale != "en-US" {
+		t.Errorf("Locale = %q, want en-US", meta.Locale)
+	}
+}
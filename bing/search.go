@@ -177,6 +177,7 @@ func (bing *Bing) Search(ctx context.Context, query core.Query) (results []core.
 
 	if bing.checkCaptcha(page) {
 		bing.logger.Error("Captcha detected: %s", url)
+		core.ArchivePage(ctx, page)
 		return nil, core.ErrCaptcha
 	}
 
@@ -190,12 +191,14 @@ func (bing *Bing) Search(ctx context.Context, query core.Query) (results []core.
 		// no-results pages can both render after WaitLoad.
 		if bing.checkCaptcha(page) {
 			bing.logger.Error("Captcha detected: %s", url)
+			core.ArchivePage(ctx, page)
 			return nil, core.ErrCaptcha
 		}
 		if bing.checkNoResults(page) {
 			return []core.SearchResult{}, nil
 		}
 		bing.logger.Error("Cannot parse organic results: %s", err)
+		core.ArchivePage(ctx, page)
 		return nil, core.ErrSearchTimeout
 	}
 
@@ -205,6 +208,12 @@ func (bing *Bing) Search(ctx context.Context, query core.Query) (results []core.
 	}
 	bing.logger.Info("Found %d organic result containers", totalResults)
 
+	core.ArchivePage(ctx, page)
+	var layout *core.SerpLayout
+	if query.Layout {
+		layout = core.StampLayout(page, layoutContainers()...)
+	}
+	core.CaptureScreenshot(ctx, page)
 	rank := core.NewRankStateAt(query.Start, query.Start+1)
 	for _, result := range resultElements {
 		isAd := bingElementMatches(result, Selectors.Ads)
@@ -217,6 +226,10 @@ func (bing *Bing) Search(ctx context.Context, query core.Query) (results []core.
 		if !ok {
 			continue
 		}
+		if isOrganic {
+			core.ApplyElementExtras(&srchRes, result, Selectors.Extras, nil)
+		}
+		srchRes.Layout = core.ElementLayout(result)
 		searchResults = append(searchResults, srchRes)
 	}
 
@@ -228,7 +241,8 @@ func (bing *Bing) Search(ctx context.Context, query core.Query) (results []core.
 	if query.Features {
 		deduped = core.AttachFeaturesToFirstResult(deduped, extractBingFeaturesFromPage(ctx, page))
 	}
-	return deduped, nil
+	serpMeta := core.WithSerpLayout(core.SerpMetaFromPage(page, parseBingSerpMeta), layout)
+	return core.AttachSerpMetaToFirstResult(deduped, serpMeta), nil
 }
 
 // BingImageData represents metadata encoded in the image result `m` attribute.
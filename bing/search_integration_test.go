@@ -4,9 +4,13 @@
 package bing
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
 	"github.com/karust/openserp/testutil/ithelper"
 )
 
@@ -15,3 +19,34 @@ func TestSearchBing(t *testing.T) {
 		return New(*b, ithelper.EngineOptions())
 	})
 }
+
+// TestSearchArchivesCaptchaAndTimeoutPages checks the captcha and timeout
+// returns record the page they gave up on for replay.
+func TestSearchArchivesCaptchaAndTimeoutPages(t *testing.T) {
+	testutil.RequireIntegration(t)
+
+	tests := []struct {
+		name   string
+		body   string
+		want   error
+		marker string
+	}{
+		{"captcha", `<html><body><div class="captcha">Verify you are human</div></body></html>`, core.ErrCaptcha, "Verify you are human"},
+		{"timeout", `<html><body><p>Still loading</p></body></html>`, core.ErrSearchTimeout, "Still loading"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := New(*ithelper.ServeSERP(t, tt.body), core.SearchEngineOptions{SelectorTimeout: 1})
+			query := core.Query{Text: "golang", Limit: 10}
+			ctx := core.WithPageArchive(context.Background(), query)
+
+			if _, err := engine.Search(ctx, query); !errors.Is(err, tt.want) {
+				t.Fatalf("Search err = %v, want %v", err, tt.want)
+			}
+			pages := core.ArchivedPagesFromContext(ctx)
+			if len(pages) != 1 || pages[0].Engine != "bing" || !strings.Contains(string(pages[0].Body), tt.marker) {
+				t.Fatalf("expected the %s page archived, got %+v", tt.name, pages)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package bing
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for Bing SERP CSS selectors.
 var Selectors = struct {
 	Captcha          []string
@@ -16,6 +18,51 @@ var Selectors = struct {
 	DescFallback     string
 	DescAny          string
 	AdTitle          string
+	ResultCount      string
+	SpellCorrected   string
+	SearchBox        string
+
+	// Copilot answer above the results.
+	CopilotAnswer core.AISummarySelectors
+	// CopilotCitation is an inline citation badge and CopilotCitationIndex
+	// its number.
+	CopilotCitation      string
+	CopilotCitationIndex string
+
+	// Sitelinks, breadcrumbs and rich snippet lines of organic results.
+	Extras core.ResultExtrasSelectors
+
+	// News search (/news/search).
+	NewsResults   string
+	NewsTitle     string
+	NewsSnippet   string
+	NewsSource    string
+	NewsTime      string
+	NewsThumbnail string
+
+	// Video search (/videos/search).
+	VideoResults   string
+	VideoData      string
+	VideoTitle     string
+	VideoDuration  string
+	VideoChannel   string
+	VideoMetaRow   string
+	VideoThumbnail string
+
+	// Shopping search (/shop) and inline product ads.
+	ShoppingResults     string
+	ShoppingCard        core.ProductCardSelectors
+	ProductCarousel     string
+	ProductCarouselItem string
+	ProductCarouselCard core.ProductCardSelectors
+
+	// Local pack on regular result pages.
+	LocalPack    string
+	LocalResults string
+	LocalCard    core.LocalCardSelectors
+
+	// Entity card in the right-hand column.
+	KnowledgePanel core.KnowledgePanelSelectors
 }{
 	Captcha: []string{"div.captcha", "div.captcha_header"},
 	// CaptchaMarkers/NoResultsMarkers are checked against lowercased page text
@@ -44,4 +91,116 @@ var Selectors = struct {
 	DescFallback:   "div.b_caption div",
 	DescAny:        "p",
 	AdTitle:        "h2 a",
+	// ResultCount reads "About 1,230,000 results". SpellCorrected is the
+	// "Including results for" link Bing shows after correcting a typo.
+	ResultCount:    "span.sb_count",
+	SpellCorrected: "#sp_requery a",
+	SearchBox:      "#sb_form_q",
+
+	// CopilotAnswer streams into b_cnt_resp, whose children are the answer's
+	// paragraphs, code blocks, lists and h2/h3 headings. Inline citations are
+	// numbered badges whose aria-label holds the cited page title; the answer
+	// ends with a paragraph of "1-Mozilla.org" source links in the same
+	// order. b_crtrm_cnt gets b_show once the answer is complete.
+	CopilotAnswer: core.AISummarySelectors{
+		Container: "#copans_container:has(div.b_cnt_resp)",
+		Body:      "div.b_cnt_resp",
+		Block:     "div.b_cnt_resp > *",
+		Heading:   "h2, h3",
+		Chip:      "span.b_crt_citation_wrapper, div.b_crtrm_code_header, p:has(a.b_crt_regular_link)",
+		Source:    "div.b_cnt_resp a.b_crt_regular_link",
+		Loading:   "div.b_crtrm_cnt:not(.b_show)",
+		Pending:   "#copans_container",
+	},
+	CopilotCitation:      "span.b_crt_citation_badge",
+	CopilotCitationIndex: "span.b_crt_citation_num",
+
+	// Extras: deep links sit in b_deepdesk below the caption, each a
+	// deeplink_title heading plus a one-line snippet; the trailing "See
+	// results only from" link is not one of them. The caption starts with a
+	// news_dt date, and b_factrow carries "Rating: 4.5/5 · 120 reviews".
+	Extras: core.ResultExtrasSelectors{
+		Sitelink:        "div.b_deepdesk li, ul.b_deeplinks_block_item li",
+		SitelinkLink:    "h3 a, a",
+		SitelinkSnippet: "p",
+		Breadcrumb:      "div.b_attribution cite",
+		RichLine:        "div.b_factrow",
+		Date:            "div.b_caption span.news_dt",
+	},
+
+	// NewsResults selects one story card. Cards carry the canonical URL,
+	// headline and publisher in url/data-title/data-author attributes; the
+	// child selectors are fallbacks for cards rendered without them.
+	NewsResults: "div.news-card",
+	NewsTitle:   "a.title",
+	NewsSnippet: "div.snippet",
+	NewsSource:  "div.source a",
+	// NewsTime is the age badge; its aria-label, when present, holds the long
+	// form ("3 hours ago") while the text is abbreviated ("3h").
+	NewsTime:      "div.source span",
+	NewsThumbnail: "img.rms_img, div.image img",
+
+	// VideoResults selects one clip tile. VideoData holds the vrhm JSON
+	// attribute with the title, duration and page URL; the remaining
+	// selectors cover the visible tile text.
+	VideoResults:   "div.mc_vtvc",
+	VideoData:      "div.vrhdata[vrhm]",
+	VideoTitle:     "div.mc_vtvc_title",
+	VideoDuration:  "div.mc_bc_rc",
+	VideoChannel:   "div.mc_vtvc_meta_row_channel",
+	VideoMetaRow:   "div.mc_vtvc_meta_row span",
+	VideoThumbnail: "img.rms_img",
+
+	// ShoppingResults selects one offer card on the shopping tab. Ratings
+	// are star images whose aria-label reads "4.5 out of 5 stars".
+	ShoppingResults: "li.br-item",
+	ShoppingCard: core.ProductCardSelectors{
+		Link:      "a.br-titlelink, a.br-offLink",
+		Title:     ".br-title",
+		Price:     ".br-price, .br-offPrice",
+		Merchant:  ".br-seller, .br-offSlrTxt",
+		Rating:    ".br-starRating, .b_starRating",
+		Reviews:   ".br-reviewCount, .br-starRatingCount",
+		Thumbnail: "img",
+	},
+	// ProductCarousel is the shopping ad strip on regular result pages.
+	ProductCarousel:     "div#pa_carousel, div.pa_carousel",
+	ProductCarouselItem: "div.pa_item, li.pa_item",
+	ProductCarouselCard: core.ProductCardSelectors{
+		Link:      "a.pa_title, a[href]",
+		Title:     ".pa_title",
+		Price:     ".pa_price, .b_price",
+		Merchant:  ".pa_seller, .b_sellerName",
+		Rating:    ".b_starRating",
+		Reviews:   ".pa_reviews",
+		Thumbnail: "img",
+	},
+
+	// LocalResults selects one business in the local answer. Its b_factrow
+	// lines hold the category, address, hours and phone.
+	LocalPack:    "div.b_localA, li.b_ans:has(div.lc_content)",
+	LocalResults: "div.lc_content",
+	LocalCard: core.LocalCardSelectors{
+		Name:    "h2, .lc_title",
+		Rating:  ".csrc",
+		Reviews: ".b_reviewcount",
+		Details: "div.b_factrow",
+		Website: "a[aria-label='Website'], a.lc_website",
+		MapLink: "a[href*='/maps?']",
+	},
+
+	// KnowledgePanel facts are b_factrow lines of "Label: value" whose label
+	// sits in a demoted span.
+	KnowledgePanel: core.KnowledgePanelSelectors{
+		Container:   "#b_context li.b_ans:has(.b_entityTitle), div.b_entityTP",
+		Title:       ".b_entityTitle",
+		EntityType:  ".b_entitySubTitle",
+		Description: ".b_entityDescription, .b_snippet",
+		Source:      ".b_entityDescription a[href], .b_snippet a.b_attribution[href]",
+		Attribute:   ".b_vList .b_factrow, .b_entityFacts .b_factrow",
+		Label:       "span.b_demoteText",
+		Image:       ".b_entityImage img, .b_imgSet img",
+		Website:     "a.b_officialSite[href]",
+		Profile:     ".b_socialIcons a[href], .b_entityProfiles a[href]",
+	},
 }
@@ -3,9 +3,11 @@ package bing
 import (
 	"bytes"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/karust/openserp/core"
 )
 
@@ -76,6 +78,47 @@ func TestParseHTMLExtractsSerpFeatures(t *testing.T) {
 	assertFeatureType(t, results, core.ResultTypeRelatedQuestions)
 }
 
+func TestParseHTMLExtractsLocalPack(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<ol id="b_results">
+  <li class="b_ans">
+    <div class="b_localA">
+      <div class="lc_content">
+        <h2>Blue Bottle Coffee</h2>
+        <span class="csrc" aria-label="Star Rating: 4.5 out of 5."></span><span class="b_reviewcount">(312)</span>
+        <div class="b_factrow">Coffee shop · 1 Ferry Building #7</div>
+        <div class="b_factrow">Open · Closes 7 PM</div>
+        <div class="b_factrow">(415) 555-0100</div>
+        <a aria-label="Website" href="https://bluebottlecoffee.com/">Website</a>
+        <a href="/maps?osid=abc&amp;cp=37.79~-122.39">Directions</a>
+      </div>
+    </div>
+  </li>
+  <li class="b_algo">
+    <h2><a href="https://example.com/result">Organic result</a></h2>
+    <div class="b_caption"><p>Snippet</p></div>
+  </li>
+</ol>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	pack := findFeature(results, core.ResultTypeLocal)
+	if pack == nil || len(pack.Items) != 1 {
+		t.Fatalf("expected local pack with one business, got %#v", pack)
+	}
+	local := pack.Items[0].Local
+	if local == nil || local.Rating != 4.5 || local.ReviewCount != 312 || local.Category != "Coffee shop" || local.Address != "1 Ferry Building #7" {
+		t.Fatalf("unexpected business: %+v", local)
+	}
+	if local.Phone != "(415) 555-0100" || local.Hours != "Open · Closes 7 PM" || local.MapURL != "https://www.bing.com/maps?osid=abc&cp=37.79~-122.39" || pack.Items[0].Link != "https://bluebottlecoffee.com/" {
+		t.Fatalf("unexpected business contacts: %+v", local)
+	}
+}
+
 // TestParseHTMLExtractsRelatedSearchesFromBrsContainer guards the classic footer.
 func TestParseHTMLExtractsRelatedSearchesFromBrsContainer(t *testing.T) {
 	t.Parallel()
@@ -191,3 +234,214 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsKnowledgePanel(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/knowledge_panel.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 organic results, got %d", len(results))
+	}
+	if answer := findFeature(results, core.ResultTypeAnswerBox); answer != nil {
+		t.Fatalf("expected the entity card not to double as an answer box, got %#v", answer)
+	}
+	panel := findFeature(results, core.ResultTypeKnowledgePanel)
+	if panel == nil || panel.Knowledge == nil {
+		t.Fatalf("expected knowledge panel feature, got %#v", panel)
+	}
+	kp := panel.Knowledge
+	if panel.Title != "Marie Curie" || kp.EntityType != "Physicist and chemist" || !strings.HasSuffix(panel.Text, "pioneering research on radioactivity.") {
+		t.Fatalf("unexpected panel header: %q %q %q", panel.Title, kp.EntityType, panel.Text)
+	}
+	if kp.DescriptionSource != "Wikipedia" || kp.DescriptionURL != "https://en.wikipedia.org/wiki/Marie_Curie" {
+		t.Fatalf("unexpected description source: %+v", kp)
+	}
+	want := []core.KnowledgeAttribute{
+		{Label: "Born", Value: "November 7, 1867 · Warsaw, Poland"},
+		{Label: "Died", Value: "July 4, 1934 · Passy, France"},
+		{Label: "Spouse", Value: "Pierre Curie (m. 1895–1906)"},
+		{Label: "Awards", Value: "Nobel Prize in Physics (1903), Nobel Prize in Chemistry (1911)"},
+	}
+	if len(kp.Attributes) != len(want) {
+		t.Fatalf("expected %d attributes, got %+v", len(want), kp.Attributes)
+	}
+	for i := range want {
+		if kp.Attributes[i] != want[i] {
+			t.Fatalf("attribute %d = %+v, want %+v", i, kp.Attributes[i], want[i])
+		}
+	}
+	if len(kp.Images) != 2 || kp.Images[1] != "https://www.bing.com/th?id=OSK.mariecurie.lab" {
+		t.Fatalf("unexpected images: %v", kp.Images)
+	}
+	if kp.Website != "https://www.mariecurie.org.uk/" {
+		t.Fatalf("unexpected website: %q", kp.Website)
+	}
+	if len(kp.Profiles) != 2 || kp.Profiles[0].Network != "Facebook" || kp.Profiles[1].Network != "Instagram" {
+		t.Fatalf("unexpected profiles: %+v", kp.Profiles)
+	}
+}
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "fixture",
+			html:            string(fixture),
+			wantBreadcrumbs: []string{"http://test.test", "javascript", "how-to-read-a-local-text-file..."},
+			wantRich:        &core.RichSnippet{Date: "Jul 12, 2025", PublishedAt: "2025-07-12T00:00:00Z"},
+		},
+		{
+			name: "deep links and fact row",
+			html: `
+<ol id="b_results">
+  <li class="b_algo">
+    <h2><a href="https://go.dev/">The Go Programming Language</a></h2>
+    <div class="b_caption"><p>Build simple, secure, scalable systems with Go.</p></div>
+    <div class="b_factrow">Rating: 4.5/5 · 320 reviews</div>
+    <div class="b_deepdesk"><ul class="b_vList">
+      <li><h3 class="deeplink_title"><a href="https://go.dev/doc/">Documentation</a></h3><p>Tutorials and references.</p></li>
+      <li><h3 class="deeplink_title"><a href="https://go.dev/dl/">Download</a></h3><p>Installers for every platform.</p></li>
+    </ul></div>
+    <a class="b_deep b_moreLink" href="https://www.bing.com/search?q=site%3ago.dev">See results only from go.dev</a>
+  </li>
+</ol>`,
+			wantSitelinks: []core.Sitelink{
+				{Title: "Documentation", URL: "https://go.dev/doc/", Snippet: "Tutorials and references."},
+				{Title: "Download", URL: "https://go.dev/dl/", Snippet: "Installers for every platform."},
+			},
+			wantRich: &core.RichSnippet{Rating: 4.5, ReviewCount: 320},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if !results[i].Ad {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected an organic result, got %+v", results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
+
+func TestParseHTMLFixtureCopilotAnswerSectionsAndCitations(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if results[0].SerpMeta == nil || results[0].SerpMeta.AISummary != core.AISummaryPresent {
+		t.Fatalf("expected the Copilot answer to be reported present, got %+v", results[0].SerpMeta)
+	}
+	var answer *core.SerpFeature
+	summaries := 0
+	for i := range results[0].Features {
+		if feature := &results[0].Features[i]; feature.Type == core.ResultTypeAISummary {
+			summaries++
+			if feature.Title == "Copilot" {
+				answer = feature
+			}
+		}
+	}
+	// The developer answer card is a separate module; the Copilot answer
+	// must not also come back from the generic selector.
+	if answer == nil || summaries != 2 {
+		t.Fatalf("expected the Copilot answer beside the developer card, got %d ai_summary features", summaries)
+	}
+	if strings.Contains(answer.Text, "1-Mozilla.org") || strings.Contains(answer.Text, "Copy code") || strings.Contains(answer.Text, "reasons123") {
+		t.Fatalf("Copilot text must leave out citation badges, code headers and the source row: %q", answer.Text)
+	}
+
+	var headings []string
+	for _, item := range answer.Items {
+		if item.Title != "" {
+			headings = append(headings, item.Title)
+		}
+	}
+	if strings.Join(headings, "|") != "Example: Read a text file in the browser|How it works|Other reading methods" {
+		t.Fatalf("unexpected sections: %q", headings)
+	}
+	steps := strings.Split(answer.Items[2].Text, "\n")
+	if len(steps) != 4 || !strings.HasPrefix(steps[3], "Handle events") || !strings.Contains(steps[3], "onerror") {
+		t.Fatalf("nested list items must stay on their parent's line, got %q", steps)
+	}
+
+	if len(answer.Links) != 5 {
+		t.Fatalf("expected 5 cited sources, got %d: %+v", len(answer.Links), answer.Links)
+	}
+	first, last := answer.Links[0], answer.Links[4]
+	if first.Title != "Using files from web applications - Web APIs | MDN" || first.Source != "Mozilla.org" || first.Position != 1 {
+		t.Fatalf("unexpected first citation: %+v", first)
+	}
+	if last.Title != "Read files in JavaScript | Articles | web.dev" || last.Source != "Web.dev" || last.Position != 5 {
+		t.Fatalf("unexpected last citation: %+v", last)
+	}
+}
+
+func TestExtractBingCopilotAnswerStatus(t *testing.T) {
+	t.Parallel()
+
+	doc := func(html string) *goquery.Document {
+		d, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+		if err != nil {
+			t.Fatalf("parse html: %v", err)
+		}
+		return d
+	}
+
+	streaming := `<div id="copans_container"><div class="b_crtrm_cnt"><div class="b_cnt_resp"><p>You can read files</p></div></div></div>`
+	answer, status := extractBingCopilotAnswer(doc(streaming))
+	if status != core.AISummaryLoading || answer == nil || answer.Text != "You can read files" {
+		t.Fatalf("streaming answer: status = %q, feature = %+v", status, answer)
+	}
+
+	if answer, status := extractBingCopilotAnswer(doc(`<div id="copans_container"></div>`)); status != core.AISummaryLoading || answer != nil {
+		t.Fatalf("empty shell: status = %q, feature = %+v", status, answer)
+	}
+
+	if _, status := extractBingCopilotAnswer(doc(`<li class="b_algo">Organic</li>`)); status != core.AISummaryAbsent {
+		t.Fatalf("no answer: status = %q", status)
+	}
+}
@@ -0,0 +1,19 @@
+package bing
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseBingSerpMeta reads the result estimate, spelling correction, Copilot
+// answer status and locale. Bing does not print a search time.
+func parseBingSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		TotalResults:   core.ParseResultCount(doc.Find(Selectors.ResultCount).First().Text()),
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+	}
+	_, meta.AISummary = extractBingCopilotAnswer(doc)
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
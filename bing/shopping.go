@@ -0,0 +1,108 @@
+package bing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseShoppingHTML parses a Bing Shopping HTML document.
+func ParseShoppingHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyBingDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseBingShoppingDocument(doc, 0), nil
+}
+
+func parseBingShoppingDocument(doc *goquery.Document, start int) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.ShoppingResults).Each(func(_ int, card *goquery.Selection) {
+		title, link, product, ok := core.ParseProductCard(card, Selectors.ShoppingCard, bingAbsoluteHref)
+		if !ok {
+			return
+		}
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          link,
+			Title:        title,
+			Product:      product,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// bingAbsoluteHref makes Bing's relative product comparison and map links
+// absolute.
+func bingAbsoluteHref(href string) string {
+	if strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "//") {
+		return "https://www.bing.com" + href
+	}
+	return href
+}
+
+// extractBingProductCarousel captures the shopping ad strip shown on regular
+// result pages for commercial queries.
+func extractBingProductCarousel(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractProductCarousel(doc, Selectors.ProductCarousel, Selectors.ProductCarouselItem, Selectors.ProductCarouselCard, bingAbsoluteHref)
+}
+
+// SearchShopping executes a Bing Shopping search in the browser.
+func (bing *Bing) SearchShopping(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, bing.Name(), false)
+	scoped := *bing
+	scoped.logger = bing.logger.WithRequest(ctx)
+	bing = &scoped
+
+	bing.logger.Debug("Starting shopping search, query: %+v", query)
+	u, err := BuildShoppingURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := bing.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &bing.Browser)()
+
+	if err := bing.acceptCookies(ctx, page); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := core.WaitForElements(ctx, page, []string{Selectors.ShoppingResults}, bing.GetSelectorTimeout()); err != nil {
+		if pageErr := core.ClassifyFromPage(page, classifyBingDocument); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			bing.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	results := parseBingShoppingDocument(doc, query.Start)
+	bing.logger.Info("Shopping search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
@@ -0,0 +1,50 @@
+package bing
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseBingShoppingDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "shopping_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseBingShoppingDocument(doc, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 shopping results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	want := core.ProductMeta{Price: 499, Currency: "USD", Merchant: "Best Buy", Rating: 4.5, ReviewCount: 1200, Thumbnail: "https://th.bing.com/th?id=OP.pixel8"}
+	if first.Title != "Google Pixel 8 128GB Obsidian" || *first.Product != want {
+		t.Fatalf("unexpected first result: %+v %+v", first, first.Product)
+	}
+	second := results[1]
+	if second.URL != "https://www.bing.com/shop/productpage?q=pixel+8&productpage=true&pid=42" || second.Product.Price != 799.99 {
+		t.Fatalf("expected absolute comparison link and lower bound price, got %+v %+v", second, second.Product)
+	}
+}
+
+func TestBuildShoppingURL(t *testing.T) {
+	u, err := BuildShoppingURL(core.Query{Text: "pixel 8", LangCode: "en", Region: "US", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildShoppingURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Path != "/shop" || params.Get("q") != "pixel 8" || params.Get("first") != "11" || params.Get("FORM") != "SHOPTB" {
+		t.Fatalf("unexpected shopping URL: %s", u)
+	}
+	if _, err := BuildShoppingURL(core.Query{Site: "bestbuy.com"}); err == nil {
+		t.Fatal("expected error for a query without text")
+	}
+}
@@ -0,0 +1,21 @@
+package bing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches Bing's query completions for query.Text in the requested
+// market. Unlike Bing search it needs no browser.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "bing", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("Bing suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, core.ParseOpenSearchSuggestions)
+}
@@ -0,0 +1,22 @@
+package bing
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildSuggestURL(t *testing.T) {
+	u, err := BuildSuggestURL(core.Query{Text: "golang", LangCode: "en", Region: "GB"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	if parsed.Path != "/osjson.aspx" || parsed.Query().Get("query") != "golang" || parsed.Query().Get("market") != "en-GB" {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+}
@@ -36,6 +36,41 @@ var defaultBingCountryByLanguage = map[string]string{
 	"ar": "SA",
 }
 
+// Operators is Bing's spelling of the structured query operators. Bing
+// retired inurl:, so it is reported rather than sent.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	Or:          " OR ",
+}
+
+// SupportsSafeSearch reports whether Bing URLs can carry level. Bing's adlt=
+// parameter takes off, moderate and strict as-is.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	switch level {
+	case core.SafeSearchOff, core.SafeSearchModerate, core.SafeSearchStrict:
+		return true
+	}
+	return false
+}
+
+// SupportsVerbatim reports whether Bing can run a verbatim query on vertical.
+// Every bing.com tab reads qs=n.
+func SupportsVerbatim(core.Vertical) bool {
+	return true
+}
+
+// addVerbatim sends qs=n for verbatim queries. It is what Bing's "Do you
+// want results only for …" link sends: the query runs as typed, without
+// spelling correction or suggestions.
+func addVerbatim(params url.Values, q core.Query) {
+	if q.Verbatim {
+		params.Add("qs", "n")
+	}
+}
+
 // BuildURL builds a Bing web search URL from Query fields.
 // It returns an error when query text or date parameters are invalid.
 func BuildURL(q core.Query) (string, error) {
@@ -48,7 +83,7 @@ func BuildURL(q core.Query) (string, error) {
 	params := url.Values{}
 
 	// Set search query text with operators
-	if q.Text != "" || q.Site != "" || q.Filetype != "" {
+	if !q.IsEmpty() {
 		text, textDateInterval, err := normalizeBingQueryText(q.Text)
 		if err != nil {
 			return "", err
@@ -62,6 +97,8 @@ func BuildURL(q core.Query) (string, error) {
 		if q.Filetype != "" {
 			text += " filetype:" + q.Filetype
 		}
+		operators, _ := Operators.Compile(q)
+		text += operators
 
 		logrus.WithField("query_hash", core.QueryHash(text)).Trace(fmt.Sprintf("Query text: %s", text))
 		params.Add("q", text)
@@ -80,6 +117,10 @@ func BuildURL(q core.Query) (string, error) {
 		}
 		params.Add("cc", locale.country)
 	}
+	if q.SafeSearch != "" {
+		params.Add("adlt", string(q.SafeSearch))
+	}
+	addVerbatim(params, q)
 
 	// Set result offset (pagination) - Bing uses "first" parameter.
 	// When first is present, Bing may ignore custom count and return default page size.
@@ -240,6 +281,10 @@ func BuildImageURL(q core.Query) (string, error) {
 		}
 		params.Add("cc", locale.country)
 	}
+	if q.SafeSearch != "" {
+		params.Add("adlt", string(q.SafeSearch))
+	}
+	addVerbatim(params, q)
 
 	// Image-specific parameters
 	params.Add("form", "HDRSC2")
@@ -249,3 +294,214 @@ func BuildImageURL(q core.Query) (string, error) {
 	base.RawQuery = params.Encode()
 	return base.String(), nil
 }
+
+// BuildNewsURL builds a Bing News search URL from Query fields. Bing News only
+// offers coarse age buckets, so DateInterval selects the narrowest bucket that
+// still covers its start day; callers trim the rest with core.FilterNewsByDate.
+func BuildNewsURL(q core.Query) (string, error) {
+	base, err := url.Parse("https://www.bing.com")
+	if err != nil {
+		return "", err
+	}
+
+	base.Path += "news/search"
+	params := url.Values{}
+
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("q", text)
+	}
+
+	if len(params.Get("q")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	if locale, ok := bingLocale(q.LangCode, q.Region); ok {
+		if locale.market != "" {
+			params.Add("mkt", locale.market)
+		}
+		if locale.language != "" {
+			params.Add("setlang", locale.language)
+		}
+		params.Add("cc", locale.country)
+	}
+	if q.SafeSearch != "" {
+		params.Add("adlt", string(q.SafeSearch))
+	}
+	addVerbatim(params, q)
+
+	if q.Start < 0 {
+		return "", errors.New("incorrect start provided")
+	}
+	if q.Start > 0 {
+		params.Add("first", strconv.Itoa(q.Start+1))
+	}
+
+	if q.DateInterval != "" {
+		interval, err := bingNewsInterval(q.DateInterval, time.Now())
+		if err != nil {
+			return "", err
+		}
+		if interval != "" {
+			params.Add("qft", fmt.Sprintf(`interval="%s"`, interval))
+		}
+	}
+	params.Add("form", "PTFTNR")
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// bingNewsInterval maps a YYYYMMDD..YYYYMMDD range to Bing News' qft interval
+// codes: 7 = past 24 hours, 8 = past week, 9 = past month. Ranges reaching
+// further back get no bucket.
+func bingNewsInterval(dateInterval string, now time.Time) (string, error) {
+	// Reuse the web filter's validation so both endpoints reject the same input.
+	if _, err := buildBingDateFilter(dateInterval); err != nil {
+		return "", err
+	}
+	start, _ := time.Parse("20060102", strings.Split(dateInterval, "..")[0])
+	switch age := now.Sub(start); {
+	case age <= 24*time.Hour:
+		return "7", nil
+	case age <= 7*24*time.Hour:
+		return "8", nil
+	case age <= 30*24*time.Hour:
+		return "9", nil
+	default:
+		return "", nil
+	}
+}
+
+// BuildVideoURL builds a Bing video search URL from Query fields. Like news,
+// video search filters by age bucket only, so DateInterval picks the
+// narrowest videoage bucket that still covers its start day.
+func BuildVideoURL(q core.Query) (string, error) {
+	base, err := url.Parse("https://www.bing.com")
+	if err != nil {
+		return "", err
+	}
+
+	base.Path += "videos/search"
+	params := url.Values{}
+
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("q", text)
+	}
+
+	if len(params.Get("q")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	if locale, ok := bingLocale(q.LangCode, q.Region); ok {
+		if locale.market != "" {
+			params.Add("mkt", locale.market)
+		}
+		if locale.language != "" {
+			params.Add("setlang", locale.language)
+		}
+		params.Add("cc", locale.country)
+	}
+	if q.SafeSearch != "" {
+		params.Add("adlt", string(q.SafeSearch))
+	}
+	addVerbatim(params, q)
+
+	if q.Start < 0 {
+		return "", errors.New("incorrect start provided")
+	}
+	if q.Start > 0 {
+		params.Add("first", strconv.Itoa(q.Start+1))
+	}
+
+	if q.DateInterval != "" {
+		minutes, err := bingVideoAge(q.DateInterval, time.Now())
+		if err != nil {
+			return "", err
+		}
+		if minutes > 0 {
+			params.Add("qft", fmt.Sprintf("+filterui:videoage-lt%d", minutes))
+		}
+	}
+	params.Add("form", "HDRSC3")
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// bingVideoAge maps a YYYYMMDD..YYYYMMDD range to a videoage filter in
+// minutes: past day, week, month or year. Older ranges get no filter.
+func bingVideoAge(dateInterval string, now time.Time) (int, error) {
+	if _, err := buildBingDateFilter(dateInterval); err != nil {
+		return 0, err
+	}
+	start, _ := time.Parse("20060102", strings.Split(dateInterval, "..")[0])
+	for _, bucket := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour, 365 * 24 * time.Hour} {
+		if now.Sub(start) <= bucket {
+			return int(bucket / time.Minute), nil
+		}
+	}
+	return 0, nil
+}
+
+// BuildShoppingURL builds a Bing Shopping search URL from Query fields. The
+// shopping tab has no date or site filters, so those fields are ignored.
+func BuildShoppingURL(q core.Query) (string, error) {
+	base, err := url.Parse("https://www.bing.com")
+	if err != nil {
+		return "", err
+	}
+
+	base.Path += "shop"
+	params := url.Values{}
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params.Add("q", q.Text)
+
+	if locale, ok := bingLocale(q.LangCode, q.Region); ok {
+		if locale.market != "" {
+			params.Add("mkt", locale.market)
+		}
+		if locale.language != "" {
+			params.Add("setlang", locale.language)
+		}
+		params.Add("cc", locale.country)
+	}
+	if q.SafeSearch != "" {
+		params.Add("adlt", string(q.SafeSearch))
+	}
+	addVerbatim(params, q)
+
+	if q.Start < 0 {
+		return "", errors.New("incorrect start provided")
+	}
+	if q.Start > 0 {
+		params.Add("first", strconv.Itoa(q.Start+1))
+	}
+	params.Add("FORM", "SHOPTB")
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildSuggestURL builds a Bing autocomplete URL for q.Text. osjson.aspx
+// answers in the OpenSearch suggestions format.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params := url.Values{}
+	params.Add("query", q.Text)
+	if locale, ok := bingLocale(q.LangCode, q.Region); ok && locale.market != "" {
+		params.Add("market", locale.market)
+	}
+	return "https://api.bing.com/osjson.aspx?" + params.Encode(), nil
+}
@@ -0,0 +1,140 @@
+package bing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// bingVideoData is the metadata encoded in a video tile's vrhm attribute.
+type bingVideoData struct {
+	VT    string `json:"vt"`    // Title
+	DU    string `json:"du"`    // Duration badge
+	PGURL string `json:"pgurl"` // Watch page URL
+	MURL  string `json:"murl"`  // Media URL, usually the same watch page
+}
+
+// ParseVideosHTML parses a Bing video search HTML document.
+func ParseVideosHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyBingDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseBingVideoDocument(doc, 0, time.Now()), nil
+}
+
+func parseBingVideoDocument(doc *goquery.Document, start int, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+	// Bing repeats popular clips in its "more videos" rows; skip them before
+	// ranking so ranks stay sequential.
+	seen := map[string]bool{}
+
+	doc.Find(Selectors.VideoResults).Each(func(_ int, tile *goquery.Selection) {
+		var data bingVideoData
+		if raw := tile.Find(Selectors.VideoData).First().AttrOr("vrhm", ""); raw != "" {
+			// A malformed attribute leaves data empty and the DOM fallbacks apply.
+			_ = json.Unmarshal([]byte(raw), &data)
+		}
+		href := data.PGURL
+		if href == "" {
+			href = data.MURL
+		}
+		title := core.NormalizeWhitespace(data.VT)
+		if title == "" {
+			title = core.NormalizeWhitespace(tile.Find(Selectors.VideoTitle).First().Text())
+		}
+		if !strings.HasPrefix(href, "http") || title == "" || seen[href] {
+			return
+		}
+		seen[href] = true
+
+		meta := &core.VideoMeta{
+			Channel:   core.NormalizeWhitespace(tile.Find(Selectors.VideoChannel).First().Text()),
+			Thumbnail: bingNewsThumbnail(tile.Find(Selectors.VideoThumbnail).First()),
+		}
+		duration := data.DU
+		if duration == "" {
+			duration = tile.Find(Selectors.VideoDuration).First().Text()
+		}
+		if d, ok := core.ParseVideoDuration(duration); ok {
+			meta.Duration = d
+		}
+		// The meta row mixes view counts and upload age; keep the first age.
+		tile.Find(Selectors.VideoMetaRow).EachWithBreak(func(_ int, span *goquery.Selection) bool {
+			published, ok := core.ParsePublishedTime(span.Text(), now)
+			if ok {
+				meta.PublishedAt = published
+			}
+			return !ok
+		})
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Video:        meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// SearchVideos executes a Bing video search in the browser.
+func (bing *Bing) SearchVideos(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, bing.Name(), false)
+	scoped := *bing
+	scoped.logger = bing.logger.WithRequest(ctx)
+	bing = &scoped
+
+	bing.logger.Debug("Starting video search, query: %+v", query)
+	u, err := BuildVideoURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := bing.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &bing.Browser)()
+
+	if err := bing.acceptCookies(ctx, page); err != nil {
+		return nil, err
+	}
+
+	if _, _, err := core.WaitForElements(ctx, page, []string{Selectors.VideoResults}, bing.GetSelectorTimeout()); err != nil {
+		if pageErr := core.ClassifyFromPage(page, classifyBingDocument); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			bing.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	results := parseBingVideoDocument(doc, query.Start, time.Now())
+	bing.logger.Info("Video search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
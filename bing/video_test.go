@@ -0,0 +1,76 @@
+package bing
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseBingVideoDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "video_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseBingVideoDocument(doc, 0, now)
+	if len(results) != 2 {
+		t.Fatalf("expected duplicate and URL-less tiles to be dropped, got %d results", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	if first.URL != "https://www.youtube.com/watch?v=f6kdp27TYZs" || first.Title != "Google I/O 2012 - Go Concurrency Patterns" {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if first.Video.Duration != 51*time.Minute+27*time.Second || first.Video.Channel != "Google for Developers" {
+		t.Fatalf("unexpected video metadata: %+v", first.Video)
+	}
+	if !first.Video.PublishedAt.Equal(now.AddDate(0, 0, -21)) {
+		t.Fatalf("expected view count to be skipped for the upload age, got %s", first.Video.PublishedAt)
+	}
+	if !strings.HasPrefix(first.Video.Thumbnail, "https://www.bing.com/th?id=OVP.abc123") {
+		t.Fatalf("expected absolute lazy-loaded thumbnail, got %q", first.Video.Thumbnail)
+	}
+
+	second := results[1]
+	if second.Video.Duration != 12*time.Minute+5*time.Second {
+		t.Fatalf("expected duration badge fallback, got %s", second.Video.Duration)
+	}
+}
+
+func TestBuildVideoURL(t *testing.T) {
+	u, err := BuildVideoURL(core.Query{Text: "golang", LangCode: "en", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildVideoURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Path != "/videos/search" || params.Get("q") != "golang" || params.Get("first") != "11" {
+		t.Fatalf("unexpected video URL: %s", u)
+	}
+}
+
+func TestBingVideoAge(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	tests := map[string]int{
+		"20240310..20240310": 1440,
+		"20240305..20240310": 10080,
+		"20240215..20240310": 43200,
+		"20230601..20240310": 525600,
+		"20200101..20240310": 0,
+	}
+	for interval, want := range tests {
+		got, err := bingVideoAge(interval, now)
+		if err != nil || got != want {
+			t.Fatalf("bingVideoAge(%q) = %d, %v; want %d", interval, got, err, want)
+		}
+	}
+}
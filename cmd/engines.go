@@ -10,35 +10,98 @@ import (
 	"github.com/karust/openserp/duckduckgo"
 	"github.com/karust/openserp/ecosia"
 	"github.com/karust/openserp/google"
+	"github.com/karust/openserp/mojeek"
+	"github.com/karust/openserp/qwant"
+	"github.com/karust/openserp/searxng"
+	"github.com/karust/openserp/seznam"
+	"github.com/karust/openserp/so360"
+	"github.com/karust/openserp/sogou"
+	"github.com/karust/openserp/startpage"
+	"github.com/karust/openserp/yahoojp"
 	"github.com/karust/openserp/yandex"
 )
 
 // engineSpec is the single registry row for a search engine, driving CLI search,
 // raw dispatch, serve's browserEngineSpecs, and the alias/validation strings.
 // cfg points into the live config global; rawSearchFn is nil when an engine has
-// no browserless mode.
+// no browserless mode, rawNewsFn, rawVideoFn, rawShopFn, rawLocalFn and
+// rawScholarFn when it has no browserless news, video, shopping, local or
+// scholar tab. suggestFn is always raw HTTP, so it serves both runtimes.
+// safeSearchFn reports which SafeSearch levels the engine honours,
+// verbatimFn on which verticals it can turn off spelling correction and
+// deviceFn which non-desktop layouts it parses (nil: desktop only);
+// operators is the engine's structured query operator syntax.
 type engineSpec struct {
-	name        string
-	aliases     []string
-	factory     func(core.Browser, core.SearchEngineOptions) core.SearchEngine
-	rawSearchFn func(context.Context, core.Query) ([]core.SearchResult, error)
-	parseHTMLFn func(io.Reader) ([]core.SearchResult, error)
-	cfg         *EngineConfig
+	name         string
+	aliases      []string
+	factory      func(core.Browser, core.SearchEngineOptions) core.SearchEngine
+	rawSearchFn  func(context.Context, core.Query) ([]core.SearchResult, error)
+	rawNewsFn    func(context.Context, core.Query) ([]core.SearchResult, error)
+	rawVideoFn   func(context.Context, core.Query) ([]core.SearchResult, error)
+	rawShopFn    func(context.Context, core.Query) ([]core.SearchResult, error)
+	rawLocalFn   func(context.Context, core.Query) ([]core.SearchResult, error)
+	rawScholarFn func(context.Context, core.Query) ([]core.SearchResult, error)
+	suggestFn    func(context.Context, core.Query) ([]core.SearchResult, error)
+	parseHTMLFn  func(io.Reader) ([]core.SearchResult, error)
+	safeSearchFn func(core.SafeSearch) bool
+	verbatimFn   func(core.Vertical) bool
+	deviceFn     func(core.Device, core.Vertical) bool
+	operators    core.OperatorSyntax
+	cfg          *EngineConfig
 }
 
 func (s engineSpec) opts() core.SearchEngineOptions {
 	return s.cfg.SearchEngineOptions
 }
 
+// rawFn returns the browserless function serving vertical, or nil when the
+// engine has none. Image search has no raw mode.
+func (s engineSpec) rawFn(vertical core.Vertical) func(context.Context, core.Query) ([]core.SearchResult, error) {
+	switch vertical {
+	case core.VerticalWeb:
+		return s.rawSearchFn
+	case core.VerticalNews:
+		return s.rawNewsFn
+	case core.VerticalVideo:
+		return s.rawVideoFn
+	case core.VerticalShopping:
+		return s.rawShopFn
+	case core.VerticalLocal:
+		return s.rawLocalFn
+	case core.VerticalScholar:
+		return s.rawScholarFn
+	case core.VerticalSuggest:
+		return s.suggestFn
+	}
+	return nil
+}
+
 func engineSpecs() []engineSpec {
 	return []engineSpec{
-		{name: "google", factory: newEngine(google.New), rawSearchFn: google.Search, parseHTMLFn: google.ParseHTML, cfg: &config.GoogleConfig},
-		{name: "yandex", factory: newEngine(yandex.New), rawSearchFn: yandex.Search, parseHTMLFn: yandex.ParseHTML, cfg: &config.YandexConfig},
-		{name: "baidu", factory: newEngine(baidu.New), rawSearchFn: baidu.Search, parseHTMLFn: baidu.ParseHTML, cfg: &config.BaiduConfig},
-		{name: "bing", factory: newEngine(bing.New), parseHTMLFn: bing.ParseHTML, cfg: &config.BingConfig},
-		{name: "duckduckgo", aliases: []string{"duck", "ddg"}, factory: newEngine(duckduckgo.New), parseHTMLFn: duckduckgo.ParseHTML, cfg: &config.DuckDuckGoConfig},
-		{name: "ecosia", factory: newEngine(ecosia.New), rawSearchFn: ecosia.Search, parseHTMLFn: ecosia.ParseHTML, cfg: &config.EcosiaConfig},
+		{name: "google", factory: newEngine(google.New), rawSearchFn: google.Search, rawNewsFn: google.SearchNews, rawVideoFn: google.SearchVideos, rawShopFn: google.SearchShopping, rawLocalFn: google.SearchLocal, rawScholarFn: google.SearchScholar, suggestFn: google.Suggest, parseHTMLFn: google.ParseHTML, operators: google.Operators, safeSearchFn: google.SupportsSafeSearch, verbatimFn: google.SupportsVerbatim, deviceFn: google.SupportsDevice, cfg: &config.GoogleConfig},
+		{name: "yandex", factory: newEngine(yandex.New), rawSearchFn: yandex.Search, rawNewsFn: yandex.SearchNews, rawVideoFn: yandex.SearchVideos, rawShopFn: yandex.SearchShopping, suggestFn: yandex.Suggest, parseHTMLFn: yandex.ParseHTML, operators: yandex.Operators, safeSearchFn: yandex.SupportsSafeSearch, verbatimFn: yandex.SupportsVerbatim, cfg: &config.YandexConfig},
+		{name: "baidu", factory: newEngine(baidu.New), rawSearchFn: baidu.Search, rawNewsFn: baidu.SearchNews, rawScholarFn: baidu.SearchScholar, suggestFn: baidu.Suggest, parseHTMLFn: baidu.ParseHTML, operators: baidu.Operators, safeSearchFn: baidu.SupportsSafeSearch, deviceFn: baidu.SupportsDevice, cfg: &config.BaiduConfig},
+		{name: "bing", factory: newEngine(bing.New), suggestFn: bing.Suggest, parseHTMLFn: bing.ParseHTML, operators: bing.Operators, safeSearchFn: bing.SupportsSafeSearch, verbatimFn: bing.SupportsVerbatim, cfg: &config.BingConfig},
+		{name: "duckduckgo", aliases: []string{"duck", "ddg"}, factory: newEngine(duckduckgo.New), suggestFn: duckduckgo.Suggest, parseHTMLFn: duckduckgo.ParseHTML, operators: duckduckgo.Operators, safeSearchFn: duckduckgo.SupportsSafeSearch, cfg: &config.DuckDuckGoConfig},
+		{name: "ecosia", factory: newEngine(ecosia.New), rawSearchFn: ecosia.Search, parseHTMLFn: ecosia.ParseHTML, operators: ecosia.Operators, safeSearchFn: ecosia.SupportsSafeSearch, cfg: &config.EcosiaConfig},
+		{name: "yahoojp", factory: newEngine(yahoojp.New), rawSearchFn: yahoojp.Search, parseHTMLFn: yahoojp.ParseHTML, operators: yahoojp.Operators, safeSearchFn: yahoojp.SupportsSafeSearch, cfg: &config.YahooJPConfig},
+		{name: "startpage", factory: newEngine(startpage.New), rawSearchFn: startpage.Search, parseHTMLFn: startpage.ParseHTML, operators: startpage.Operators, safeSearchFn: startpage.SupportsSafeSearch, cfg: &config.StartpageConfig},
+		{name: "mojeek", factory: newEngine(mojeek.New), rawSearchFn: mojeek.Search, parseHTMLFn: mojeek.ParseHTML, operators: mojeek.Operators, safeSearchFn: mojeek.SupportsSafeSearch, cfg: &config.MojeekConfig},
+		{name: "sogou", factory: newEngine(sogou.New), rawSearchFn: sogou.Search, parseHTMLFn: sogou.ParseHTML, operators: sogou.Operators, safeSearchFn: sogou.SupportsSafeSearch, deviceFn: sogou.SupportsDevice, cfg: &config.SogouConfig},
+		{name: "so360", factory: newEngine(so360.New), rawSearchFn: so360.Search, parseHTMLFn: so360.ParseHTML, operators: so360.Operators, safeSearchFn: so360.SupportsSafeSearch, cfg: &config.So360Config},
+		{name: "seznam", factory: newEngine(seznam.New), rawSearchFn: seznam.Search, parseHTMLFn: seznam.ParseHTML, operators: seznam.Operators, safeSearchFn: seznam.SupportsSafeSearch, cfg: &config.SeznamConfig},
+		{name: "qwant", factory: newEngine(qwant.New), rawSearchFn: qwant.Search, suggestFn: qwant.Suggest, parseHTMLFn: qwant.ParseHTML, operators: qwant.Operators, safeSearchFn: qwant.SupportsSafeSearch, cfg: &config.QwantConfig},
+	}
+}
+
+// configuredEngines returns engines that exist only when configured and need
+// no browser, so they sit outside the registry and serve both runtimes.
+func configuredEngines() []core.SearchEngine {
+	var engines []core.SearchEngine
+	if config.SearXNGConfig.Enabled() {
+		engines = append(engines, searxng.New(config.SearXNGConfig.Config, config.SearXNGConfig.SearchEngineOptions))
 	}
+	return engines
 }
 
 // newEngine adapts a concrete pkg.New (returning *Engine) to the
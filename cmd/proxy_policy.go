@@ -14,6 +14,14 @@ func buildEngineProxyPolicyMap() map[string]string {
 		"bing":       config.BingConfig.Proxy,
 		"duckduckgo": config.DuckDuckGoConfig.Proxy,
 		"ecosia":     config.EcosiaConfig.Proxy,
+		"yahoojp":    config.YahooJPConfig.Proxy,
+		"startpage":  config.StartpageConfig.Proxy,
+		"mojeek":     config.MojeekConfig.Proxy,
+		"sogou":      config.SogouConfig.Proxy,
+		"so360":      config.So360Config.Proxy,
+		"seznam":     config.SeznamConfig.Proxy,
+		"qwant":      config.QwantConfig.Proxy,
+		"searxng":    config.SearXNGConfig.Proxy,
 	}
 }
 
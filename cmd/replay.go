@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/karust/openserp/core"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// replayFlags holds the per-invocation CLI flags for the replay command.
+type replayFlags struct {
+	format string
+	full   bool
+}
+
+var replayOpts replayFlags
+
+var replayCMD = &cobra.Command{
+	Use:   "replay [request_id]",
+	Short: "Re-run the current HTML parsers on the SERP pages archived for a request (archive.mode)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  replay,
+}
+
+func replay(cmd *cobra.Command, args []string) error {
+	format, err := normalizeSearchFormat(replayOpts.format)
+	if err != nil {
+		return err
+	}
+
+	archive := core.NewLocalPageArchive(config.Archive.Dir, config.Archive.TTL)
+	archived, err := archive.Load(context.Background(), args[0])
+	if errors.Is(err, core.ErrArchiveNotFound) {
+		return fmt.Errorf("no archived pages for request %q in %s", args[0], config.Archive.Dir)
+	}
+	if err != nil {
+		return err
+	}
+
+	env := core.ReplayArchivedPages(archived, specParseFunc, uuid.NewString(), time.Now())
+	logrus.WithFields(logrus.Fields{
+		"archived_request_id": args[0],
+		"pages":               len(archived.Pages),
+		"results_count":       len(env.Results),
+	}).Info("Replay completed")
+
+	payload := renderCLIEnvelope(env, format, replayOpts.full)
+	fmt.Println(strings.TrimRight(string(payload), "\n"))
+	return nil
+}
+
+// specParseFunc resolves an archived page's engine to its registry parser.
+func specParseFunc(engine string) (core.HTMLParseFunc, bool) {
+	spec, ok := resolveEngineSpec(normalizeEngineArg(engine))
+	if !ok || spec.parseHTMLFn == nil {
+		return nil, false
+	}
+	return spec.parseHTMLFn, true
+}
+
+func init() {
+	replayCMD.Flags().StringVar(&replayOpts.format, "format", "json", "Output format: json, text, markdown, ndjson")
+	replayCMD.Flags().BoolVar(&replayOpts.full, "full", false, "Include SERP features in text/markdown output")
+	RootCmd.AddCommand(replayCMD)
+}
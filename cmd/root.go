@@ -10,6 +10,7 @@ import (
 	"github.com/karust/openserp/core"
 	browserprofile "github.com/karust/openserp/core/browser"
 	extractpkg "github.com/karust/openserp/extract"
+	"github.com/karust/openserp/searxng"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -27,6 +28,8 @@ type Config struct {
 	App              AppConfig            `mapstructure:"app"`
 	Proxies          core.ProxiesConfig   `mapstructure:"proxies"`
 	Cache            CacheConfig          `mapstructure:"cache"`
+	Artifacts        ArtifactsConfig      `mapstructure:"artifacts"`
+	Archive          ArchiveConfig        `mapstructure:"archive"`
 	Extract          extractpkg.Config    `mapstructure:"extract"`
 	Resilience       ResilienceConfig     `mapstructure:"resilience"`
 	CircuitBreaker   CircuitBreakerConfig `mapstructure:"circuit_breaker"`
@@ -39,6 +42,14 @@ type Config struct {
 	BingConfig       EngineConfig         `mapstructure:"bing"`
 	DuckDuckGoConfig EngineConfig         `mapstructure:"duckduckgo"`
 	EcosiaConfig     EngineConfig         `mapstructure:"ecosia"`
+	YahooJPConfig    EngineConfig         `mapstructure:"yahoojp"`
+	StartpageConfig  EngineConfig         `mapstructure:"startpage"`
+	MojeekConfig     EngineConfig         `mapstructure:"mojeek"`
+	SogouConfig      EngineConfig         `mapstructure:"sogou"`
+	So360Config      EngineConfig         `mapstructure:"so360"`
+	SeznamConfig     EngineConfig         `mapstructure:"seznam"`
+	QwantConfig      EngineConfig         `mapstructure:"qwant"`
+	SearXNGConfig    SearXNGConfig        `mapstructure:"searxng"`
 }
 
 type Config2Captcha struct {
@@ -77,11 +88,32 @@ type EngineConfig struct {
 	Proxy                    string `mapstructure:"proxy"`
 }
 
+// SearXNGConfig is an EngineConfig plus the instance settings. The engine is
+// only registered when base_url is set.
+type SearXNGConfig struct {
+	EngineConfig   `mapstructure:",squash"`
+	searxng.Config `mapstructure:",squash"`
+}
+
 type CacheConfig struct {
 	TTLSeconds int `mapstructure:"ttl_seconds"`
 	MaxSize    int `mapstructure:"max_size"`
 }
 
+// ArtifactsConfig locates the local store for screenshot= captures.
+type ArtifactsConfig struct {
+	Dir string        `mapstructure:"dir"`
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// ArchiveConfig selects which web searches keep their SERP documents for
+// POST /replay/{request_id} and openserp replay, and where they are stored.
+type ArchiveConfig struct {
+	Mode core.ArchiveMode `mapstructure:"mode"`
+	Dir  string           `mapstructure:"dir"`
+	TTL  time.Duration    `mapstructure:"ttl"`
+}
+
 type ResilienceConfig struct {
 	MaxRetries            int  `mapstructure:"max_retries"`
 	AllowEndpointFallback bool `mapstructure:"allow_endpoint_fallback"`
@@ -205,6 +237,15 @@ func sanitizedConfigForLog(cfg Config) map[string]interface{} {
 		"circuit_breaker": cfg.CircuitBreaker,
 		"cors":            cfg.CORS,
 		"captcha":         cfg.Captcha,
+		"artifacts": map[string]interface{}{
+			"dir": cfg.Artifacts.Dir,
+			"ttl": cfg.Artifacts.TTL.String(),
+		},
+		"archive": map[string]interface{}{
+			"mode": cfg.Archive.Mode,
+			"dir":  cfg.Archive.Dir,
+			"ttl":  cfg.Archive.TTL.String(),
+		},
 		"2captcha": map[string]interface{}{
 			"apikey_configured": strings.TrimSpace(cfg.Config2Capcha.ApiKey) != "",
 		},
@@ -214,6 +255,19 @@ func sanitizedConfigForLog(cfg Config) map[string]interface{} {
 		"bing":       cfg.BingConfig,
 		"duckduckgo": cfg.DuckDuckGoConfig,
 		"ecosia":     cfg.EcosiaConfig,
+		"yahoojp":    cfg.YahooJPConfig,
+		"startpage":  cfg.StartpageConfig,
+		"mojeek":     cfg.MojeekConfig,
+		"sogou":      cfg.SogouConfig,
+		"so360":      cfg.So360Config,
+		"seznam":     cfg.SeznamConfig,
+		"qwant":      cfg.QwantConfig,
+		"searxng": map[string]interface{}{
+			"base_url":   maskedProxyForLog(cfg.SearXNGConfig.BaseURL),
+			"categories": cfg.SearXNGConfig.Categories,
+			"engines":    cfg.SearXNGConfig.Engines,
+			"proxy":      cfg.SearXNGConfig.Proxy,
+		},
 	}
 }
 
@@ -325,6 +379,11 @@ func initializeConfig(cmd *cobra.Command) error {
 		return fmt.Errorf("invalid app.block_resources: %w", err)
 	}
 
+	config.Archive.Mode, err = core.ParseArchiveMode(string(config.Archive.Mode))
+	if err != nil {
+		return fmt.Errorf("invalid archive.mode: %w", err)
+	}
+
 	config.Proxies, err = core.NormalizeProxiesConfig(config.Proxies)
 	if err != nil {
 		return fmt.Errorf("invalid proxies config: %w", err)
@@ -334,7 +393,7 @@ func initializeConfig(cmd *cobra.Command) error {
 }
 
 func validateEngineProxyTags(v *viper.Viper) error {
-	for _, engineName := range []string{"google", "yandex", "baidu", "bing", "duckduckgo", "ecosia"} {
+	for _, engineName := range []string{"google", "yandex", "baidu", "bing", "duckduckgo", "ecosia", "yahoojp", "startpage", "mojeek", "sogou", "so360", "seznam", "qwant", "searxng"} {
 		key := engineName + ".proxy"
 		if !v.IsSet(key) {
 			continue
@@ -422,6 +481,11 @@ func setConfigDefaults(v *viper.Viper) {
 
 	v.SetDefault("cache.ttl_seconds", 300)
 	v.SetDefault("cache.max_size", 1000)
+	v.SetDefault("artifacts.dir", core.DefaultArtifactDir)
+	v.SetDefault("artifacts.ttl", core.DefaultArtifactTTL.String())
+	v.SetDefault("archive.mode", "off")
+	v.SetDefault("archive.dir", core.DefaultArchiveDir)
+	v.SetDefault("archive.ttl", core.DefaultArchiveTTL.String())
 	v.SetDefault("extract.enabled", true)
 	v.SetDefault("extract.default_mode", "auto")
 	v.SetDefault("extract.timeout", "20s")
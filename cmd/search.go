@@ -24,9 +24,21 @@ type searchFlags struct {
 	start    int
 	site     string
 	filetype string
+	exact    string
+	exclude  []string
+	noSites  []string
+	intitle  string
+	inurl    string
+	orTerms  []string
 	format   string
 	full     bool
 	features bool
+	aiOnly   bool
+	paaDepth int
+	safe     string
+	device   string
+	verbatim bool
+	layout   bool
 	extract  int
 	timeout  int
 }
@@ -36,7 +48,7 @@ var searchOpts searchFlags
 var searchCMD = &cobra.Command{
 	Use:     "search [engine] [query]",
 	Aliases: []string{"find"},
-	Short:   "Search results using chosen web search engine (google, yandex, baidu, bing, duckduckgo, ecosia)",
+	Short:   "Search results using chosen web search engine (google, yandex, baidu, bing, duckduckgo, ecosia, yahoojp, startpage, mojeek, sogou, so360, seznam, qwant)",
 	// Validate the engine ourselves; cobra.OnlyValidArgs would also reject the
 	// query arg. ValidArgs still feeds shell completion.
 	Args:      cobra.MatchAll(cobra.ExactArgs(2), validateEngineArg),
@@ -70,17 +82,52 @@ func search(cmd *cobra.Command, args []string) error {
 	if limit <= 0 {
 		limit = 10
 	}
+	if searchOpts.paaDepth < 0 || searchOpts.paaDepth > core.MaxPAADepth {
+		return fmt.Errorf("--paa-depth must be between 0 and %d", core.MaxPAADepth)
+	}
+	safe, err := core.ParseSafeSearch(strings.ToLower(strings.TrimSpace(searchOpts.safe)))
+	if err != nil {
+		return fmt.Errorf("--safe: %w", err)
+	}
+	if safe != "" && (spec.safeSearchFn == nil || !spec.safeSearchFn(safe)) {
+		logrus.Warnf("%s cannot apply safe=%s; it will use its default filter", spec.name, safe)
+	}
+	if searchOpts.verbatim && (spec.verbatimFn == nil || !spec.verbatimFn(core.VerticalWeb)) {
+		logrus.Warnf("%s has no verbatim switch; it may still correct the query", spec.name)
+	}
+	device, err := core.ParseDevice(strings.ToLower(strings.TrimSpace(searchOpts.device)))
+	if err != nil {
+		return fmt.Errorf("--device: %w", err)
+	}
+	if device == core.DeviceMobile && (spec.deviceFn == nil || !spec.deviceFn(device, core.VerticalWeb)) {
+		return fmt.Errorf("--device: %s has no mobile result layout", spec.name)
+	}
 	query := core.Query{
-		Text:     args[1],
-		LangCode: searchOpts.lang,
-		Region:   searchOpts.region,
-		Site:     searchOpts.site,
-		Filetype: searchOpts.filetype,
-		Limit:    limit,
-		Start:    searchOpts.start,
-		Filter:   true,
-		Features: searchOpts.features,
-		Insecure: config.Server.Insecure,
+		Text:         args[1],
+		LangCode:     searchOpts.lang,
+		Region:       searchOpts.region,
+		Site:         searchOpts.site,
+		Filetype:     searchOpts.filetype,
+		Exact:        searchOpts.exact,
+		ExcludeTerms: searchOpts.exclude,
+		ExcludeSites: searchOpts.noSites,
+		InTitle:      searchOpts.intitle,
+		InURL:        searchOpts.inurl,
+		OrTerms:      searchOpts.orTerms,
+		Limit:        limit,
+		Start:        searchOpts.start,
+		Filter:       true,
+		Features:     searchOpts.features || searchOpts.aiOnly,
+		AIOnly:       searchOpts.aiOnly,
+		PAADepth:     searchOpts.paaDepth,
+		SafeSearch:   safe,
+		Device:       device,
+		Verbatim:     searchOpts.verbatim,
+		Layout:       searchOpts.layout,
+		Insecure:     config.Server.Insecure,
+	}
+	if _, unsupported := spec.operators.Compile(query); len(unsupported) > 0 {
+		logrus.Warnf("%s has no syntax for %s; searching without them", spec.name, strings.Join(unsupported, ", "))
 	}
 	if err := applyCLIExtractFlag(&query, searchOpts.extract); err != nil {
 		return err
@@ -150,7 +197,7 @@ func search(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("extract search results: %w", err)
 		}
 	}
-	payload := renderCLIEnvelope(env, format, searchOpts.full)
+	payload := renderCLIEnvelope(env, format, searchOpts.full || searchOpts.aiOnly)
 	fmt.Println(strings.TrimRight(string(payload), "\n"))
 	return nil
 }
@@ -427,9 +474,21 @@ func init() {
 	searchCMD.Flags().IntVar(&searchOpts.start, "start", 0, "Pagination start offset")
 	searchCMD.Flags().StringVar(&searchOpts.site, "site", "", "Restrict results to a domain (e.g. github.com)")
 	searchCMD.Flags().StringVar(&searchOpts.filetype, "file", "", "File type filter (e.g. pdf)")
+	searchCMD.Flags().StringVar(&searchOpts.exact, "exact", "", "Exact phrase that must appear in results")
+	searchCMD.Flags().StringSliceVar(&searchOpts.exclude, "exclude", nil, "Terms to exclude (repeat or comma-separate)")
+	searchCMD.Flags().StringSliceVar(&searchOpts.noSites, "exclude-site", nil, "Domains to exclude (repeat or comma-separate)")
+	searchCMD.Flags().StringVar(&searchOpts.intitle, "intitle", "", "Term that must appear in the page title")
+	searchCMD.Flags().StringVar(&searchOpts.inurl, "inurl", "", "Term that must appear in the page URL")
+	searchCMD.Flags().StringSliceVar(&searchOpts.orTerms, "or", nil, "Require at least one of these terms (repeat or comma-separate)")
 	searchCMD.Flags().StringVar(&searchOpts.format, "format", "json", "Output format: json, text, markdown, ndjson")
 	searchCMD.Flags().BoolVar(&searchOpts.full, "full", false, "Include SERP features in text/markdown output")
 	searchCMD.Flags().BoolVar(&searchOpts.features, "features", false, "Parse SERP feature modules (browser mode)")
+	searchCMD.Flags().BoolVar(&searchOpts.aiOnly, "ai-only", false, "Return only the AI summary, as soon as it resolves (implies --features, browser mode)")
+	searchCMD.Flags().IntVar(&searchOpts.paaDepth, "paa-depth", 0, "Expand Google's people-also-ask box this many levels deep (with --features, browser mode)")
+	searchCMD.Flags().StringVar(&searchOpts.safe, "safe", "", "SafeSearch level: off, moderate, strict (default: engine default)")
+	searchCMD.Flags().StringVar(&searchOpts.device, "device", "", "Browser profile form factor: desktop, mobile (default: desktop)")
+	searchCMD.Flags().BoolVar(&searchOpts.verbatim, "verbatim", false, "Run the query as typed, without the engine's spelling correction")
+	searchCMD.Flags().BoolVar(&searchOpts.layout, "layout", false, "Measure where results and features were drawn on the page (browser mode)")
 	searchCMD.Flags().IntVar(&searchOpts.extract, "extract", 0, "Extract clean content from the top N results using auto mode (1-5)")
 	searchCMD.Flags().IntVar(&searchOpts.timeout, "search-timeout", 60, "Overall search timeout in seconds")
 	RootCmd.AddCommand(searchCMD)
@@ -40,6 +40,91 @@ func (r *rawEngine) SearchImage(_ context.Context, _ core.Query) ([]core.SearchR
 	return nil, fmt.Errorf("image search is not supported in raw mode for %s", r.name)
 }
 
+func (r *rawEngine) SearchNews(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalNews)
+}
+
+func (r *rawEngine) SearchVideos(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalVideo)
+}
+
+func (r *rawEngine) SearchShopping(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalShopping)
+}
+
+func (r *rawEngine) SearchLocal(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalLocal)
+}
+
+func (r *rawEngine) SearchScholar(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalScholar)
+}
+
+func (r *rawEngine) Suggest(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return r.searchVertical(ctx, q, core.VerticalSuggest)
+}
+
+// searchVertical runs the engine's raw function for an optional tab.
+func (r *rawEngine) searchVertical(ctx context.Context, q core.Query, vertical core.Vertical) ([]core.SearchResult, error) {
+	q.Insecure = config.Server.Insecure
+
+	spec, ok := resolveEngineSpec(r.name)
+	if !ok || spec.rawFn(vertical) == nil {
+		return nil, fmt.Errorf("%w: %s is not supported in raw mode for %s", core.ErrUnsupportedVertical, vertical, r.name)
+	}
+	return spec.rawFn(vertical)(ctx, q)
+}
+
+// SupportsVertical reports news, videos, shopping, local, scholar and suggest only for
+// engines with a raw function for that tab, so the server skips routes the raw
+// runtime can't serve.
+func (r *rawEngine) SupportsVertical(v core.Vertical) bool {
+	switch v {
+	case core.VerticalWeb, core.VerticalImage:
+		return true
+	}
+	spec, ok := resolveEngineSpec(r.name)
+	return ok && spec.rawFn(v) != nil
+}
+
+// SupportsSafeSearch defers to the engine's own SafeSearch mapping; engines
+// without one honour only their default.
+func (r *rawEngine) SupportsSafeSearch(level core.SafeSearch) bool {
+	spec, ok := resolveEngineSpec(r.name)
+	return ok && spec.safeSearchFn != nil && spec.safeSearchFn(level)
+}
+
+// SupportsVerbatim defers to the engine's own verbatim mapping; engines
+// without one always correct the query their own way.
+func (r *rawEngine) SupportsVerbatim(v core.Vertical) bool {
+	spec, ok := resolveEngineSpec(r.name)
+	return ok && spec.verbatimFn != nil && spec.verbatimFn(v)
+}
+
+// SupportsDevice defers to the engine's own layout selectors; engines
+// without them parse desktop pages only.
+func (r *rawEngine) SupportsDevice(device core.Device, v core.Vertical) bool {
+	spec, ok := resolveEngineSpec(r.name)
+	return ok && spec.deviceFn != nil && spec.deviceFn(device, v)
+}
+
+// QueryOperators returns the engine's structured operator syntax, so
+// unsupported operators are reported the same in raw and browser mode.
+func (r *rawEngine) QueryOperators() core.OperatorSyntax {
+	spec, _ := resolveEngineSpec(r.name)
+	return spec.operators
+}
+
+// ParseHTML runs the engine's HTML parser, so raw-mode servers serve
+// /{engine}/parse and can replay archived pages like browser mode.
+func (r *rawEngine) ParseHTML(body io.Reader) ([]core.SearchResult, error) {
+	spec, ok := resolveEngineSpec(r.name)
+	if !ok || spec.parseHTMLFn == nil {
+		return nil, fmt.Errorf("unsupported engine: %s", r.name)
+	}
+	return spec.parseHTMLFn(body)
+}
+
 func (r *rawEngine) Name() string {
 	return r.name
 }
@@ -95,12 +180,21 @@ func serve(cmd *cobra.Command, args []string) {
 	if config.Server.IsRawRequests {
 		logrus.Warn("Browserless results are very inconsistent or may not even work!")
 		serverOpts := buildServerOptions(corsCfg, proxyCfg, fingerprintBrowserOpts)
-		serv := core.NewServerWithOptions(config.Server.Host, config.Server.Port, serverOpts,
+		engines := []core.SearchEngine{
 			&rawEngine{name: "google"},
 			&rawEngine{name: "yandex"},
 			&rawEngine{name: "baidu"},
 			&rawEngine{name: "ecosia"},
-		)
+			&rawEngine{name: "yahoojp"},
+			&rawEngine{name: "startpage"},
+			&rawEngine{name: "mojeek"},
+			&rawEngine{name: "sogou"},
+			&rawEngine{name: "so360"},
+			&rawEngine{name: "seznam"},
+			&rawEngine{name: "qwant"},
+		}
+		engines = append(engines, configuredEngines()...)
+		serv := core.NewServerWithOptions(config.Server.Host, config.Server.Port, serverOpts, engines...)
 		if err := listenWithGracefulShutdown(serv, nil); err != nil {
 			logrus.Error(err)
 		}
@@ -118,6 +212,8 @@ func serve(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	engines = append(engines, configuredEngines()...)
+
 	serverOpts := buildServerOptions(corsCfg, proxyCfg, fingerprintBrowserOpts)
 	serverOpts.BrowserResolver = browserResolver
 	serv := core.NewServerWithOptions(config.Server.Host, config.Server.Port, serverOpts, engines...)
@@ -165,6 +261,9 @@ func buildServerOptions(corsCfg core.CORSConfig, proxyCfg core.ProxyConfig, fing
 		MegaTimeout:            config.App.MegaTimeout,
 		RequestTimeout:         core.RequestTimeoutForRetries(engineTimeout, retryCfg),
 		Extract:                config.Extract,
+		Artifacts:              core.NewLocalArtifactStore(config.Artifacts.Dir, config.Artifacts.TTL),
+		ArchiveMode:            config.Archive.Mode,
+		Archive:                core.NewLocalPageArchive(config.Archive.Dir, config.Archive.TTL),
 		Resilience: core.ResilientConfig{
 			Retry: retryCfg,
 			CircuitBreaker: core.CircuitBreakerConfig{
@@ -533,6 +632,18 @@ type pooledBrowserEngine struct {
 	pool    *browserPool
 
 	reportLaneStats bool
+	// verticals records the optional tabs the engine factory's type
+	// implements; the wrapper itself always has the methods.
+	verticals map[core.Vertical]bool
+	// suggestFn is the engine's raw autocomplete call; suggestions never go
+	// through the browser pool.
+	suggestFn func(context.Context, core.Query) ([]core.SearchResult, error)
+	// safeSearchFn, verbatimFn and deviceFn are the engine's SafeSearch,
+	// verbatim and device layout checks, nil when it has none.
+	safeSearchFn func(core.SafeSearch) bool
+	verbatimFn   func(core.Vertical) bool
+	deviceFn     func(core.Device, core.Vertical) bool
+	operators    core.OperatorSyntax
 }
 
 // parsableEngine wraps pooledBrowserEngine and additionally satisfies
@@ -562,6 +673,69 @@ func (e *pooledBrowserEngine) SearchImage(ctx context.Context, q core.Query) ([]
 	return engine.SearchImage(ctx, q)
 }
 
+func (e *pooledBrowserEngine) SearchNews(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return e.searchVertical(ctx, q, core.VerticalNews)
+}
+
+func (e *pooledBrowserEngine) SearchVideos(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return e.searchVertical(ctx, q, core.VerticalVideo)
+}
+
+func (e *pooledBrowserEngine) SearchShopping(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return e.searchVertical(ctx, q, core.VerticalShopping)
+}
+
+func (e *pooledBrowserEngine) SearchLocal(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return e.searchVertical(ctx, q, core.VerticalLocal)
+}
+
+func (e *pooledBrowserEngine) SearchScholar(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	return e.searchVertical(ctx, q, core.VerticalScholar)
+}
+
+func (e *pooledBrowserEngine) Suggest(ctx context.Context, q core.Query) ([]core.SearchResult, error) {
+	if e.suggestFn == nil {
+		return nil, fmt.Errorf("%w: %s has no suggestions", core.ErrUnsupportedVertical, e.name)
+	}
+	q.Insecure = config.Server.Insecure
+	return e.suggestFn(ctx, q)
+}
+
+// searchVertical runs an optional browser tab on a pool-resolved engine.
+func (e *pooledBrowserEngine) searchVertical(ctx context.Context, q core.Query, vertical core.Vertical) ([]core.SearchResult, error) {
+	engine, err := e.resolveEngine(q)
+	if err != nil {
+		return nil, err
+	}
+	return core.SearchVertical(ctx, engine, q, vertical)
+}
+
+func (e *pooledBrowserEngine) SupportsVertical(v core.Vertical) bool {
+	switch v {
+	case core.VerticalWeb, core.VerticalImage:
+		return true
+	case core.VerticalSuggest:
+		return e.suggestFn != nil
+	}
+	return e.verticals[v]
+}
+
+func (e *pooledBrowserEngine) SupportsSafeSearch(level core.SafeSearch) bool {
+	return e.safeSearchFn != nil && e.safeSearchFn(level)
+}
+
+func (e *pooledBrowserEngine) SupportsVerbatim(v core.Vertical) bool {
+	return e.verbatimFn != nil && e.verbatimFn(v)
+}
+
+func (e *pooledBrowserEngine) SupportsDevice(device core.Device, v core.Vertical) bool {
+	return e.deviceFn != nil && e.deviceFn(device, v)
+}
+
+func (e *pooledBrowserEngine) QueryOperators() core.OperatorSyntax {
+	return e.operators
+}
+
 func (e *pooledBrowserEngine) IsInitialized() bool {
 	return true
 }
@@ -606,10 +780,15 @@ func (e *pooledBrowserEngine) resolveEngine(q core.Query) (core.SearchEngine, er
 }
 
 type browserEngineSpec struct {
-	name        string
-	opts        core.SearchEngineOptions
-	factory     func(core.Browser, core.SearchEngineOptions) core.SearchEngine
-	parseHTMLFn func(io.Reader) ([]core.SearchResult, error)
+	name         string
+	opts         core.SearchEngineOptions
+	factory      func(core.Browser, core.SearchEngineOptions) core.SearchEngine
+	parseHTMLFn  func(io.Reader) ([]core.SearchResult, error)
+	suggestFn    func(context.Context, core.Query) ([]core.SearchResult, error)
+	safeSearchFn func(core.SafeSearch) bool
+	verbatimFn   func(core.Vertical) bool
+	deviceFn     func(core.Device, core.Vertical) bool
+	operators    core.OperatorSyntax
 }
 
 func browserEngineSpecs() []browserEngineSpec {
@@ -617,10 +796,15 @@ func browserEngineSpecs() []browserEngineSpec {
 	out := make([]browserEngineSpec, 0, len(specs))
 	for _, s := range specs {
 		out = append(out, browserEngineSpec{
-			name:        s.name,
-			opts:        s.opts(),
-			factory:     s.factory,
-			parseHTMLFn: s.parseHTMLFn,
+			name:         s.name,
+			opts:         s.opts(),
+			factory:      s.factory,
+			parseHTMLFn:  s.parseHTMLFn,
+			suggestFn:    s.suggestFn,
+			safeSearchFn: s.safeSearchFn,
+			verbatimFn:   s.verbatimFn,
+			deviceFn:     s.deviceFn,
+			operators:    s.operators,
 		})
 	}
 	return out
@@ -655,12 +839,25 @@ func buildBrowserEngines(baseOpts core.BrowserOpts, proxyCfg core.ProxyConfig) (
 
 		opts := spec.opts
 		opts.Init()
+		// Engine constructors don't touch the browser, so a zero Browser is
+		// enough to probe which optional tabs the engine implements.
+		probe := spec.factory(core.Browser{}, opts)
+		verticals := map[core.Vertical]bool{}
+		for _, vertical := range core.Verticals {
+			verticals[vertical] = core.EngineSupportsVertical(probe, vertical)
+		}
 		base := &pooledBrowserEngine{
 			name:            spec.name,
 			opts:            opts,
 			factory:         spec.factory,
 			pool:            pool,
 			reportLaneStats: idx == 0,
+			verticals:       verticals,
+			suggestFn:       spec.suggestFn,
+			safeSearchFn:    spec.safeSearchFn,
+			verbatimFn:      spec.verbatimFn,
+			deviceFn:        spec.deviceFn,
+			operators:       spec.operators,
 		}
 		if spec.parseHTMLFn != nil {
 			engines = append(engines, &parsableEngine{pooledBrowserEngine: base, parseHTMLFn: spec.parseHTMLFn})
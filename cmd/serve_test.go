@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/karust/openserp/core"
+	"github.com/spf13/viper"
 )
 
 func TestRawEngineCachesRateLimiter(t *testing.T) {
@@ -19,6 +20,116 @@ func TestRawEngineCachesRateLimiter(t *testing.T) {
 	}
 }
 
+func TestRawEngineReportsVerticalSupport(t *testing.T) {
+	if !core.EngineSupportsVertical(&rawEngine{name: "google"}, core.VerticalNews) {
+		t.Fatal("expected raw google to serve news")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "ecosia"}, core.VerticalNews) {
+		t.Fatal("expected raw ecosia to have no news route")
+	}
+	if !core.EngineSupportsVertical(&rawEngine{name: "yandex"}, core.VerticalVideo) {
+		t.Fatal("expected raw yandex to serve videos")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "baidu"}, core.VerticalVideo) {
+		t.Fatal("expected raw baidu to have no videos route")
+	}
+	if !core.EngineSupportsVertical(&rawEngine{name: "google"}, core.VerticalShopping) {
+		t.Fatal("expected raw google to serve shopping")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "bing"}, core.VerticalShopping) {
+		t.Fatal("expected raw bing to have no shopping route")
+	}
+	if !core.EngineSupportsVertical(&rawEngine{name: "google"}, core.VerticalLocal) {
+		t.Fatal("expected raw google to serve local")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "yandex"}, core.VerticalLocal) {
+		t.Fatal("expected raw yandex to have no local route")
+	}
+	if !core.EngineSupportsVertical(&rawEngine{name: "baidu"}, core.VerticalScholar) {
+		t.Fatal("expected raw baidu to serve scholar")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "bing"}, core.VerticalScholar) {
+		t.Fatal("expected raw bing to have no scholar route")
+	}
+	if !core.EngineSupportsVertical(&rawEngine{name: "duckduckgo"}, core.VerticalSuggest) {
+		t.Fatal("expected raw duckduckgo to serve suggestions")
+	}
+	if core.EngineSupportsVertical(&rawEngine{name: "mojeek"}, core.VerticalSuggest) {
+		t.Fatal("expected raw mojeek to have no suggest route")
+	}
+}
+
+func TestRawEngineReportsSafeSearchSupport(t *testing.T) {
+	if !core.EngineSupportsSafeSearch(&rawEngine{name: "google"}, core.SafeSearchStrict) {
+		t.Fatal("expected raw google to honour safe=strict")
+	}
+	if core.EngineSupportsSafeSearch(&rawEngine{name: "yandex"}, core.SafeSearchOff) {
+		t.Fatal("expected raw yandex to report safe=off as unsupported")
+	}
+	if core.EngineSupportsSafeSearch(&rawEngine{name: "sogou"}, core.SafeSearchModerate) {
+		t.Fatal("expected raw sogou to have no SafeSearch mapping")
+	}
+}
+
+func TestRawEngineAndReplayResolveHTMLParsers(t *testing.T) {
+	var engine core.SearchEngine = &rawEngine{name: "mojeek"}
+	if _, ok := engine.(core.HTMLParser); !ok {
+		t.Fatal("expected raw engines to serve /{engine}/parse and replay")
+	}
+	for _, name := range []string{"google", "Bing", "duck"} {
+		if _, ok := specParseFunc(name); !ok {
+			t.Fatalf("expected archived %s pages to resolve a parser", name)
+		}
+	}
+	if _, ok := specParseFunc("altavista"); ok {
+		t.Fatal("expected unknown engines to have no parser")
+	}
+}
+
+func TestPooledBrowserEngineReportsVerticalSupport(t *testing.T) {
+	engines, closePool, _, err := buildBrowserEngines(core.BrowserOpts{}, core.ProxyConfig{})
+	if err != nil {
+		t.Fatalf("buildBrowserEngines() error = %v", err)
+	}
+	defer closePool()
+
+	news := map[string]bool{}
+	for _, engine := range engines {
+		news[engine.Name()] = core.EngineSupportsVertical(engine, core.VerticalNews)
+	}
+	for _, name := range []string{"google", "bing", "yandex", "baidu"} {
+		if !news[name] {
+			t.Fatalf("expected browser %s to serve news", name)
+		}
+	}
+	if news["duckduckgo"] {
+		t.Fatal("expected browser duckduckgo to have no news route")
+	}
+
+	videos := map[string]bool{"google": true, "bing": true, "yandex": true}
+	shopping := map[string]bool{"google": true, "bing": true, "yandex": true}
+	local := map[string]bool{"google": true}
+	scholar := map[string]bool{"google": true, "baidu": true}
+	suggest := map[string]bool{"google": true, "bing": true, "yandex": true, "baidu": true, "duckduckgo": true, "qwant": true}
+	for _, engine := range engines {
+		if got := core.EngineSupportsVertical(engine, core.VerticalVideo); got != videos[engine.Name()] {
+			t.Fatalf("browser %s video support = %v, want %v", engine.Name(), got, videos[engine.Name()])
+		}
+		if got := core.EngineSupportsVertical(engine, core.VerticalShopping); got != shopping[engine.Name()] {
+			t.Fatalf("browser %s shopping support = %v, want %v", engine.Name(), got, shopping[engine.Name()])
+		}
+		if got := core.EngineSupportsVertical(engine, core.VerticalLocal); got != local[engine.Name()] {
+			t.Fatalf("browser %s local support = %v, want %v", engine.Name(), got, local[engine.Name()])
+		}
+		if got := core.EngineSupportsVertical(engine, core.VerticalScholar); got != scholar[engine.Name()] {
+			t.Fatalf("browser %s scholar support = %v, want %v", engine.Name(), got, scholar[engine.Name()])
+		}
+		if got := core.EngineSupportsVertical(engine, core.VerticalSuggest); got != suggest[engine.Name()] {
+			t.Fatalf("browser %s suggest support = %v, want %v", engine.Name(), got, suggest[engine.Name()])
+		}
+	}
+}
+
 func TestCommandDefaultsToQuiet(t *testing.T) {
 	if !commandDefaultsToQuiet(searchCMD) {
 		t.Fatal("expected search command to default to quiet")
@@ -337,3 +448,40 @@ func TestBuildFingerprintBrowserOptionsRespectsBlockConfig(t *testing.T) {
 	}
 
 }
+
+func TestConfiguredEnginesDecodesSearXNGBlock(t *testing.T) {
+	orig := config.SearXNGConfig
+	defer func() { config.SearXNGConfig = orig }()
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(`
+searxng:
+  base_url: http://localhost:8888/
+  categories: [general, science]
+  rate_requests: 30
+  proxy: direct
+`)); err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("unmarshal config: %v", err)
+	}
+	if cfg.SearXNGConfig.BaseURL != "http://localhost:8888/" || len(cfg.SearXNGConfig.Categories) != 2 {
+		t.Fatalf("unexpected searxng config: %+v", cfg.SearXNGConfig.Config)
+	}
+	if cfg.SearXNGConfig.RateRequests != 30 || cfg.SearXNGConfig.Proxy != "direct" {
+		t.Fatalf("expected engine options to squash into searxng block, got %+v", cfg.SearXNGConfig.EngineConfig)
+	}
+
+	config.SearXNGConfig = SearXNGConfig{}
+	if engines := configuredEngines(); len(engines) != 0 {
+		t.Fatalf("expected no engines without base_url, got %d", len(engines))
+	}
+	config.SearXNGConfig = cfg.SearXNGConfig
+	engines := configuredEngines()
+	if len(engines) != 1 || engines[0].Name() != "searxng" || !engines[0].IsInitialized() {
+		t.Fatalf("expected initialized searxng engine, got %+v", engines)
+	}
+}
@@ -0,0 +1,197 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// AISummaryStatus reports what became of an engine's generative answer
+// module (Google's AI Overview, Bing's Copilot answer or Yandex's Neuro
+// answer) on a SERP.
+type AISummaryStatus string
+
+const (
+	AISummaryPresent AISummaryStatus = "present"
+	AISummaryAbsent  AISummaryStatus = "absent"
+	// AISummaryLoading means the module was on the page but had not finished
+	// streaming its answer when the page was read. Any text already streamed
+	// is still returned.
+	AISummaryLoading AISummaryStatus = "loading"
+)
+
+// AISummarySelectors locates the parts of a generative answer module. Block
+// matches the answer's paragraphs, lists and headings in reading order; a
+// block that is or holds a Heading starts a new section. Source matches one
+// cited source card; SourceLink, SourceTitle and SourceName are looked up
+// inside it.
+type AISummarySelectors struct {
+	Container string
+	// Body is the answer prose inside Container, without the source list.
+	// The whole Container is read when it is empty or matches nothing.
+	Body    string
+	Block   string
+	Heading string
+	// Chip matches inline citation chips ("MDN Web Docs +2"), which are
+	// dropped from the text.
+	Chip        string
+	Source      string
+	SourceLink  string
+	SourceTitle string
+	SourceName  string
+	// Loading marks an answer still streaming inside Container; Pending is
+	// the shell an engine renders before Container appears at all.
+	Loading string
+	Pending string
+	// ShowMore is the collapsed "Show more" toggle browser mode clicks
+	// before reading the answer.
+	ShowMore string
+}
+
+// ExtractAISummary reads the first module matched by sel.Container into an
+// ai_summary SerpFeature. Its Items are the answer's sections, each headed by
+// Title, and its Links the cited source cards in display order, numbered by
+// Position. resolve turns raw hrefs into absolute URLs and may return "" to
+// drop one. The feature is nil when no answer text was found; status tells an
+// absent module from one still loading.
+func ExtractAISummary(doc *goquery.Document, sel AISummarySelectors, resolve func(string) string) (*SerpFeature, AISummaryStatus) {
+	container := doc.Find(sel.Container).First()
+	if container.Length() == 0 {
+		if sel.Pending != "" && doc.Find(sel.Pending).Length() > 0 {
+			return nil, AISummaryLoading
+		}
+		return nil, AISummaryAbsent
+	}
+	status := AISummaryPresent
+	if sel.Loading != "" && (container.Is(sel.Loading) || container.Find(sel.Loading).Length() > 0) {
+		status = AISummaryLoading
+	}
+
+	body := container
+	if sel.Body != "" {
+		if match := container.Find(sel.Body).First(); match.Length() > 0 {
+			body = match
+		}
+	}
+	body = body.Clone()
+	if sel.Chip != "" {
+		body.Find(sel.Chip).Remove()
+	}
+
+	sections := aiSummarySections(body, sel)
+	var lines []string
+	for _, section := range sections {
+		if section.Title != "" {
+			lines = append(lines, section.Title)
+		}
+		if section.Text != "" {
+			lines = append(lines, section.Text)
+		}
+	}
+	text := strings.Join(lines, "\n")
+	if len(sections) == 0 {
+		text = NormalizeWhitespace(body.Text())
+	}
+	if text == "" {
+		if status == AISummaryLoading {
+			return nil, status
+		}
+		return nil, AISummaryAbsent
+	}
+
+	feature := &SerpFeature{
+		Type:       ResultTypeAISummary,
+		Text:       text,
+		Links:      aiSummaryCitations(container, body, sel, resolve),
+		Confidence: 0.85,
+		Layout:     SelectionLayout(container),
+	}
+	// A single untitled section is plain prose, already in Text.
+	if len(sections) > 1 || (len(sections) == 1 && sections[0].Title != "") {
+		feature.Items = sections
+	}
+	return feature, status
+}
+
+// aiSummarySections groups the body's blocks under the heading before them.
+// Text before the first heading forms an untitled lead section.
+func aiSummarySections(body *goquery.Selection, sel AISummarySelectors) []FeatureItem {
+	if sel.Block == "" {
+		return nil
+	}
+	var sections []FeatureItem
+	body.Find(sel.Block).Each(func(_ int, block *goquery.Selection) {
+		if sel.Heading != "" {
+			heading := block.Filter(sel.Heading).AddSelection(block.Find(sel.Heading)).First()
+			if title := NormalizeWhitespace(heading.Text()); title != "" {
+				sections = append(sections, FeatureItem{Title: title})
+				if heading.IsSelection(block) {
+					return
+				}
+				block = block.Clone()
+				block.Find(sel.Heading).Remove()
+			}
+		}
+		text := aiSummaryBlockText(block)
+		if text == "" {
+			return
+		}
+		if len(sections) == 0 {
+			sections = append(sections, FeatureItem{})
+		}
+		current := &sections[len(sections)-1]
+		current.Text = strings.TrimSpace(current.Text + "\n" + text)
+	})
+	return sections
+}
+
+// aiSummaryBlockText keeps list items on their own lines. A nested list stays
+// on the line of the item holding it.
+func aiSummaryBlockText(block *goquery.Selection) string {
+	items := block.Find("li").FilterFunction(func(_ int, item *goquery.Selection) bool {
+		return item.ParentsUntilSelection(block).Filter("li").Length() == 0
+	})
+	if items.Length() == 0 {
+		return NormalizeWhitespace(block.Text())
+	}
+	var lines []string
+	items.Each(func(_ int, item *goquery.Selection) {
+		if line := NormalizeWhitespace(item.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	})
+	return strings.Join(lines, "\n")
+}
+
+// aiSummaryCitations maps the source cards to links numbered in display
+// order. Cards repeated in an inline group and the full source list count
+// once. Without cards, the links inside the answer text are its sources.
+func aiSummaryCitations(container, body *goquery.Selection, sel AISummarySelectors, resolve func(string) string) []FeatureLink {
+	var links []FeatureLink
+	seen := map[string]bool{}
+	add := func(link *goquery.Selection, title, source string) {
+		href := knowledgeHref(link.AttrOr("href", ""), resolve)
+		title = firstNonEmpty(title, NormalizeWhitespace(link.Text()), NormalizeWhitespace(link.AttrOr("aria-label", "")))
+		key := href + "\n" + title
+		if href == "" || title == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		links = append(links, FeatureLink{Title: title, URL: href, Source: source, Position: len(links) + 1})
+	}
+	if sel.Source != "" {
+		container.Find(sel.Source).Each(func(_ int, card *goquery.Selection) {
+			link := card
+			if sel.SourceLink != "" && !card.Is("a") {
+				link = card.Find(sel.SourceLink).First()
+			}
+			add(link, knowledgeText(card, sel.SourceTitle), knowledgeText(card, sel.SourceName))
+		})
+	}
+	if len(links) == 0 {
+		body.Find("a[href]").Each(func(_ int, link *goquery.Selection) {
+			add(link, "", "")
+		})
+	}
+	return links
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var testAISummarySelectors = AISummarySelectors{
+	Container:   "div.overview",
+	Body:        "div.answer",
+	Block:       "div.answer > div",
+	Heading:     "h3",
+	Chip:        "span.chip",
+	Source:      "div.card",
+	SourceLink:  "a",
+	SourceTitle: "div.title",
+	SourceName:  "div.site",
+	Loading:     "div.answer[data-streaming]",
+	Pending:     "div.shell",
+}
+
+func mustAISummaryDoc(t *testing.T, html string) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	return doc
+}
+
+func TestExtractAISummarySectionsAndCitations(t *testing.T) {
+	t.Parallel()
+
+	doc := mustAISummaryDoc(t, `<div class="overview">
+<div class="answer">
+  <div>Fetch starts a request. <span class="chip">MDN +2</span></div>
+  <div><h3>Basic GET</h3></div>
+  <div>By default it sends GET.</div>
+  <div><h3>Key parts</h3><ul><li>fetch(url): starts it.</li><li>response.ok: status check. <ul><li>true for 2xx.</li></ul></li></ul></div>
+</div>
+<ul class="inline"><li><div class="card"><a href="https://mdn.example/fetch"></a><div class="title">Using Fetch</div><div class="site">MDN</div></div></li></ul>
+<ul class="all">
+  <li><div class="card"><a href="https://mdn.example/fetch"></a><div class="title">Using Fetch</div><div class="site">MDN</div></div></li>
+  <li><div class="card"><a href="/watch?v=1" aria-label="Fetch video. Opens in a new tab."></a><div class="site">YouTube</div></div></li>
+  <li><div class="card"><a href="javascript:void(0)"></a><div class="title">Broken</div></div></li>
+</ul>
+</div>`)
+	feature, status := ExtractAISummary(doc, testAISummarySelectors, func(href string) string {
+		if strings.HasPrefix(href, "/") {
+			return "https://video.example" + href
+		}
+		return href
+	})
+	if status != AISummaryPresent || feature == nil {
+		t.Fatalf("status = %q, feature = %+v", status, feature)
+	}
+	if feature.Type != ResultTypeAISummary || strings.Contains(feature.Text, "MDN +2") {
+		t.Fatalf("expected ai_summary text without citation chips, got %+v", feature)
+	}
+	wantText := "Fetch starts a request.\nBasic GET\nBy default it sends GET.\nKey parts\nfetch(url): starts it.\nresponse.ok: status check. true for 2xx."
+	if feature.Text != wantText {
+		t.Fatalf("Text = %q, want %q", feature.Text, wantText)
+	}
+	wantSections := []FeatureItem{
+		{Text: "Fetch starts a request."},
+		{Title: "Basic GET", Text: "By default it sends GET."},
+		{Title: "Key parts", Text: "fetch(url): starts it.\nresponse.ok: status check. true for 2xx."},
+	}
+	if len(feature.Items) != len(wantSections) {
+		t.Fatalf("sections = %+v", feature.Items)
+	}
+	for i, want := range wantSections {
+		if feature.Items[i].Title != want.Title || feature.Items[i].Text != want.Text {
+			t.Fatalf("section %d = %+v, want %+v", i, feature.Items[i], want)
+		}
+	}
+
+	wantLinks := []FeatureLink{
+		{Title: "Using Fetch", URL: "https://mdn.example/fetch", Source: "MDN", Position: 1},
+		{Title: "Fetch video. Opens in a new tab.", URL: "https://video.example/watch?v=1", Source: "YouTube", Position: 2},
+	}
+	if len(feature.Links) != len(wantLinks) {
+		t.Fatalf("citations must count repeated cards once and skip script links, got %+v", feature.Links)
+	}
+	for i, want := range wantLinks {
+		if feature.Links[i] != want {
+			t.Fatalf("citation %d = %+v, want %+v", i, feature.Links[i], want)
+		}
+	}
+}
+
+func TestExtractAISummaryStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		html        string
+		wantStatus  AISummaryStatus
+		wantFeature bool
+	}{
+		"absent":  {`<div class="g">Organic</div>`, AISummaryAbsent, false},
+		"pending": {`<div class="shell"></div>`, AISummaryLoading, false},
+		"streaming": {
+			`<div class="overview"><div class="answer" data-streaming><div>Fetch starts</div></div></div>`,
+			AISummaryLoading, true,
+		},
+		"empty": {`<div class="overview"><div class="answer"></div></div>`, AISummaryAbsent, false},
+	}
+	for name, tc := range cases {
+		feature, status := ExtractAISummary(mustAISummaryDoc(t, tc.html), testAISummarySelectors, nil)
+		if status != tc.wantStatus || (feature != nil) != tc.wantFeature {
+			t.Fatalf("%s: status = %q, feature = %+v", name, status, feature)
+		}
+	}
+}
+
+func TestExtractAISummaryFallsBackToAnswerLinks(t *testing.T) {
+	t.Parallel()
+
+	doc := mustAISummaryDoc(t, `<div class="overview"><div class="answer">
+<p>See <a href="https://example.com/guide">the guide</a> and <a href="https://example.com/guide">the guide</a>.</p>
+</div></div>`)
+	feature, _ := ExtractAISummary(doc, AISummarySelectors{Container: "div.overview", Body: "div.answer"}, nil)
+	if feature == nil || feature.Text != "See the guide and the guide." || feature.Items != nil {
+		t.Fatalf("unexpected plain summary: %+v", feature)
+	}
+	if len(feature.Links) != 1 || feature.Links[0] != (FeatureLink{Title: "the guide", URL: "https://example.com/guide", Position: 1}) {
+		t.Fatalf("unexpected answer links: %+v", feature.Links)
+	}
+}
+
+func TestEnvelopeFinalizeAIOnlyKeepsAISummaries(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	q := Query{Text: "fetch", Features: true, AIOnly: true}
+	env := NewEnvelope(q, "req", startedAt, []string{"google"})
+	ctx := EnrichContext{Engine: "google", Query: q}
+	AppendEnrichedSearchResult(env, SearchResult{
+		Rank:  1,
+		URL:   "https://example.com/",
+		Title: "Example",
+		Features: []SerpFeature{
+			{Type: ResultTypeAISummary, Text: "Fetch starts a request."},
+			{Type: ResultTypePeopleAlsoAsk, Items: []FeatureItem{{Title: "What is fetch?"}}},
+		},
+		SerpMeta: &SerpMeta{AISummary: AISummaryPresent},
+	}, ctx, startedAt)
+	env.Finalize(startedAt, q)
+
+	if len(env.Results) != 0 {
+		t.Fatalf("ai_only must drop results, got %+v", env.Results)
+	}
+	if len(env.SerpFeatures) != 1 || env.SerpFeatures[0].Type != ResultTypeAISummary || env.SerpFeatures[0].SourceResultIDs != nil {
+		t.Fatalf("ai_only must keep only the AI summary, got %+v", env.SerpFeatures)
+	}
+	if env.SerpMeta["google"].AISummary != AISummaryPresent {
+		t.Fatalf("ai_only must keep the serp meta, got %+v", env.SerpMeta)
+	}
+}
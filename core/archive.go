@@ -0,0 +1,370 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ArchiveMode selects which search requests keep the SERP documents their
+// engines fetched. The zero value archives nothing.
+type ArchiveMode string
+
+const (
+	ArchiveOff    ArchiveMode = ""
+	ArchiveErrors ArchiveMode = "errors"
+	ArchiveAll    ArchiveMode = "all"
+)
+
+// DefaultArchiveDir and DefaultArchiveTTL configure the local page archive
+// when none is configured. The directory is relative to the server's working
+// directory at start time.
+const (
+	DefaultArchiveDir = "archive"
+	DefaultArchiveTTL = 7 * 24 * time.Hour
+)
+
+// ErrArchiveNotFound is returned by PageArchive.Load for request IDs with no
+// archived pages, including expired ones.
+var ErrArchiveNotFound = errors.New("archive not found")
+
+// ErrArchiveExists is returned by PageArchive.Save for a request ID that
+// already has unexpired pages. X-Request-ID is client-supplied, so a reused
+// ID must not replace another request's archive.
+var ErrArchiveExists = errors.New("archive already exists")
+
+// ParseArchiveMode validates an archive.mode value. Empty and "off" disable
+// archiving.
+func ParseArchiveMode(raw string) (ArchiveMode, error) {
+	switch mode := ArchiveMode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case "off":
+		return ArchiveOff, nil
+	case ArchiveOff, ArchiveErrors, ArchiveAll:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown archive mode %q: accepted values are off, errors, all", raw)
+}
+
+// ArchivedPage is one SERP document an engine fetched during a request, as
+// the browser rendered it or as the raw HTTP path received it. Body keeps
+// the bytes as received, so JSON carries it base64-encoded and legacy
+// charsets survive; ContentType is the response header that names the
+// charset. Browser snapshots are UTF-8.
+type ArchivedPage struct {
+	RequestID   string    `json:"request_id"`
+	QueryHash   string    `json:"query_hash,omitempty"`
+	Engine      string    `json:"engine"`
+	URL         string    `json:"url,omitempty"`
+	CapturedAt  time.Time `json:"captured_at"`
+	ContentType string    `json:"content_type,omitempty"`
+	Body        []byte    `json:"body"`
+}
+
+// ArchivedRequest is what a PageArchive keeps for one request: the query it
+// ran, without proxy credentials, and the pages its engines fetched.
+type ArchivedRequest struct {
+	Query Query          `json:"query"`
+	Pages []ArchivedPage `json:"pages"`
+}
+
+type archiveContextKey struct{}
+
+type archiveTracker struct {
+	mu    sync.Mutex
+	query Query
+	pages []ArchivedPage
+}
+
+// WithPageArchive records the SERP documents engines fetch with ctx, and
+// the query q they were fetched for, so the server can archive them once the
+// request's outcome is known.
+func WithPageArchive(ctx context.Context, q Query) context.Context {
+	ctx = EnsureContext(ctx)
+	if archiveTrackerFromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, archiveContextKey{}, &archiveTracker{query: archivableQuery(q)})
+}
+
+// archivableQuery strips what must not be written to disk: the proxy URL
+// carries credentials and the session ID names a sticky upstream lane.
+func archivableQuery(q Query) Query {
+	q.ProxyURL = ""
+	q.ProxySessionID = ""
+	q.ProxyOverride = ""
+	return q
+}
+
+// ArchiveHTML records body fetched from pageURL for the engine in ctx.
+// contentType is the response Content-Type, used to decode body on replay.
+// It is a no-op unless the request is archiving. A later snapshot of the
+// same engine and URL replaces the earlier one, so the archive holds what
+// the parser saw last.
+func ArchiveHTML(ctx context.Context, pageURL, contentType string, body []byte) {
+	tracker := archiveTrackerFromContext(ctx)
+	if tracker == nil || len(body) == 0 {
+		return
+	}
+	page := ArchivedPage{
+		RequestID:   RequestIDFromContext(ctx),
+		QueryHash:   QueryHashFromContext(ctx),
+		Engine:      engineFromContext(ctx),
+		URL:         pageURL,
+		CapturedAt:  time.Now().UTC(),
+		ContentType: contentType,
+		Body:        append([]byte(nil), body...),
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	for i := range tracker.pages {
+		if tracker.pages[i].Engine == page.Engine && tracker.pages[i].URL == page.URL {
+			tracker.pages[i] = page
+			return
+		}
+	}
+	tracker.pages = append(tracker.pages, page)
+}
+
+// ArchivedPagesFromContext returns the pages recorded so far in capture order.
+func ArchivedPagesFromContext(ctx context.Context) []ArchivedPage {
+	tracker := archiveTrackerFromContext(ctx)
+	if tracker == nil {
+		return nil
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return append([]ArchivedPage(nil), tracker.pages...)
+}
+
+// ArchivedRequestFromContext returns the query and the pages recorded so far.
+func ArchivedRequestFromContext(ctx context.Context) ArchivedRequest {
+	tracker := archiveTrackerFromContext(ctx)
+	if tracker == nil {
+		return ArchivedRequest{}
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return ArchivedRequest{Query: tracker.query, Pages: append([]ArchivedPage(nil), tracker.pages...)}
+}
+
+func archiveTrackerFromContext(ctx context.Context) *archiveTracker {
+	if ctx == nil {
+		return nil
+	}
+	tracker, _ := ctx.Value(archiveContextKey{}).(*archiveTracker)
+	return tracker
+}
+
+// PageArchive keeps archived SERP documents by request ID until they expire.
+// Implementations must be safe for concurrent use and must not overwrite an
+// unexpired archive: Save reports ErrArchiveExists instead.
+type PageArchive interface {
+	Save(ctx context.Context, requestID string, archived ArchivedRequest) error
+	Load(ctx context.Context, requestID string) (ArchivedRequest, error)
+}
+
+// LocalPageArchive stores each request's query and pages as one JSON file in
+// dir. Expired files are removed on every Save and Load, so the directory
+// shrinks even when archiving stops and only replays read it.
+type LocalPageArchive struct {
+	mu  sync.Mutex
+	dir string
+	ttl time.Duration
+}
+
+// archiveRequestIDPattern limits the request IDs that become file names.
+// X-Request-ID is client-supplied, so anything else is not archived.
+var archiveRequestIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// NewLocalPageArchive returns an archive writing to dir. A non-positive ttl
+// uses DefaultArchiveTTL. The directory is created on first Save.
+func NewLocalPageArchive(dir string, ttl time.Duration) *LocalPageArchive {
+	if strings.TrimSpace(dir) == "" {
+		dir = DefaultArchiveDir
+	}
+	if ttl <= 0 {
+		ttl = DefaultArchiveTTL
+	}
+	return &LocalPageArchive{dir: dir, ttl: ttl}
+}
+
+func (a *LocalPageArchive) Save(_ context.Context, requestID string, archived ArchivedRequest) error {
+	if !archiveRequestIDPattern.MatchString(requestID) {
+		return fmt.Errorf("request ID %q cannot be archived", requestID)
+	}
+	data, err := json.Marshal(archived)
+	if err != nil {
+		return fmt.Errorf("encode archive %s: %w", requestID, err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pruneExpiredLocked(time.Now())
+	if err := os.MkdirAll(a.dir, 0o755); err != nil {
+		return fmt.Errorf("create archive directory: %w", err)
+	}
+	path := filepath.Join(a.dir, requestID+".json")
+	// O_EXCL: pruning already removed an expired file of the same name, so
+	// one still there belongs to an earlier request with this ID.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("%w: request %s", ErrArchiveExists, requestID)
+	}
+	if err != nil {
+		return fmt.Errorf("write archive %s: %w", requestID, err)
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("write archive %s: %w", requestID, err)
+	}
+	return nil
+}
+
+func (a *LocalPageArchive) Load(_ context.Context, requestID string) (ArchivedRequest, error) {
+	if !archiveRequestIDPattern.MatchString(requestID) {
+		return ArchivedRequest{}, ErrArchiveNotFound
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.pruneExpiredLocked(time.Now())
+	data, err := os.ReadFile(filepath.Join(a.dir, requestID+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return ArchivedRequest{}, ErrArchiveNotFound
+	}
+	if err != nil {
+		return ArchivedRequest{}, fmt.Errorf("read archive %s: %w", requestID, err)
+	}
+	var archived ArchivedRequest
+	if err := json.Unmarshal(data, &archived); err != nil {
+		return ArchivedRequest{}, fmt.Errorf("decode archive %s: %w", requestID, err)
+	}
+	if len(archived.Pages) == 0 {
+		return ArchivedRequest{}, ErrArchiveNotFound
+	}
+	return archived, nil
+}
+
+func (a *LocalPageArchive) pruneExpiredLocked(now time.Time) {
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		// Leave files the archive did not write alone; dir may be shared.
+		if entry.IsDir() || filepath.Ext(name) != ".json" || !archiveRequestIDPattern.MatchString(strings.TrimSuffix(name, ".json")) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || now.Sub(info.ModTime()) <= a.ttl {
+			continue
+		}
+		_ = os.Remove(filepath.Join(a.dir, name))
+	}
+}
+
+// HTMLParseFunc parses one engine's SERP document, like HTMLParser.ParseHTML.
+type HTMLParseFunc func(io.Reader) ([]SearchResult, error)
+
+// ReplayArchivedPages re-runs the current parsers over archived pages with
+// the query the request ran and returns the results as one envelope. Each
+// page is decoded from its archived charset first. An engine's pages are
+// parsed in capture order, ranked on from the query's start offset,
+// deduplicated, limited and stripped of features the way its Search would.
+// parserFor resolves an engine name to its parser; pages whose engine has
+// none, or whose parse fails, are listed in meta.engine_errors instead of
+// failing the replay.
+func ReplayArchivedPages(archived ArchivedRequest, parserFor func(engine string) (HTMLParseFunc, bool), requestID string, startedAt time.Time) *Envelope {
+	var engines []string
+	pagesByEngine := map[string][]ArchivedPage{}
+	for _, page := range archived.Pages {
+		if _, seen := pagesByEngine[page.Engine]; !seen {
+			engines = append(engines, page.Engine)
+		}
+		pagesByEngine[page.Engine] = append(pagesByEngine[page.Engine], page)
+	}
+	sort.Strings(engines)
+
+	q := archived.Query
+	env := NewEnvelope(q, requestID, startedAt, engines)
+	for _, engine := range engines {
+		parse, ok := parserFor(engine)
+		if !ok {
+			env.Meta.EnginesFailed = append(env.Meta.EnginesFailed, engine)
+			env.Meta.EngineErrors = append(env.Meta.EngineErrors, EngineErrorDetail{
+				Engine:  engine,
+				Error:   "unsupported_engine",
+				Message: "engine has no HTML parser",
+			})
+			continue
+		}
+		results, err := replayEnginePages(pagesByEngine[engine], parse, q)
+		if err != nil {
+			env.Meta.EnginesFailed = append(env.Meta.EnginesFailed, engine)
+			env.Meta.EngineErrors = append(env.Meta.EngineErrors, engineErrorDetail(engine, err, q))
+		}
+		ectx := EnrichContext{Engine: engine, Query: q}
+		for _, r := range results {
+			AppendEnrichedSearchResult(env, r, ectx, startedAt)
+		}
+	}
+	env.Finalize(startedAt, q)
+	return env
+}
+
+// replayEnginePages parses one engine's pages in capture order. Parsers rank
+// every page from 1, so ranks are carried on from q.Start across pages. The
+// results parsed before a failing page are returned with its error.
+func replayEnginePages(pages []ArchivedPage, parse HTMLParseFunc, q Query) ([]SearchResult, error) {
+	var all []SearchResult
+	rank, absolute := q.Start, q.Start
+	for _, page := range pages {
+		body, err := DecodeHTMLBody(page.Body, page.ContentType)
+		if err != nil {
+			return all, fmt.Errorf("%w: decode archived page: %v", ErrParser, err)
+		}
+		results, err := parse(bytes.NewReader(body))
+		if err != nil {
+			return all, err
+		}
+		rank, absolute = rebaseRanks(results, rank, absolute)
+		all = append(all, results...)
+	}
+	all = LimitOrganicResults(DeduplicateResults(all), q.Limit)
+	return StripResultFeatures(all, q.Features), nil
+}
+
+// rebaseRanks shifts the positive ranks of one page's results past the
+// previous pages' and returns the last ranks it assigned.
+func rebaseRanks(results []SearchResult, rank, absolute int) (int, int) {
+	lastRank, lastAbsolute := rank, absolute
+	for i := range results {
+		if results[i].Rank > 0 && !results[i].Ad {
+			results[i].Rank += rank
+			lastRank = max(lastRank, results[i].Rank)
+		}
+		if results[i].AbsoluteRank > 0 {
+			results[i].AbsoluteRank += absolute
+			lastAbsolute = max(lastAbsolute, results[i].AbsoluteRank)
+		}
+	}
+	return lastRank, lastAbsolute
+}
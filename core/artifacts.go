@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultArtifactDir and DefaultArtifactTTL configure the local artifact
+// store when none is configured. The directory is relative to the server's
+// working directory at start time.
+const (
+	DefaultArtifactDir = "artifacts"
+	DefaultArtifactTTL = 24 * time.Hour
+)
+
+// ErrArtifactNotFound is returned by ArtifactStore.Open for unknown and
+// expired artifacts.
+var ErrArtifactNotFound = errors.New("artifact not found")
+
+// Artifact describes a stored file attached to a response, such as a SERP
+// screenshot. URL is served by GET /artifacts/{id}.
+type Artifact struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	Engine      string `json:"engine,omitempty"`
+	ContentType string `json:"content_type"`
+	Bytes       int    `json:"bytes"`
+	URL         string `json:"url"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// ArtifactStore keeps response artifacts until they expire. Implementations
+// must be safe for concurrent use and drop expired artifacts on their own;
+// Open reports ErrArtifactNotFound for them.
+type ArtifactStore interface {
+	// Put stores data and returns its descriptor. The caller fills Kind and
+	// Engine; the store sets ID, ContentType, Bytes and ExpiresAt.
+	Put(ctx context.Context, contentType string, data []byte) (Artifact, error)
+	// Open returns the stored bytes and content type of id.
+	Open(ctx context.Context, id string) ([]byte, string, error)
+}
+
+// LocalArtifactStore stores artifacts as files in one directory. Expired
+// files are removed on every Put and Open, so the directory shrinks even
+// when screenshots stop and only downloads read it.
+type LocalArtifactStore struct {
+	mu  sync.Mutex
+	dir string
+	ttl time.Duration
+}
+
+// artifactExtensions maps the content types artifacts are stored with to the
+// file extension the local store uses.
+var artifactExtensions = map[string]string{
+	"image/png": ".png",
+}
+
+// NewLocalArtifactStore returns a store writing to dir. A non-positive ttl
+// uses DefaultArtifactTTL. The directory is created on first Put.
+func NewLocalArtifactStore(dir string, ttl time.Duration) *LocalArtifactStore {
+	if strings.TrimSpace(dir) == "" {
+		dir = DefaultArtifactDir
+	}
+	if ttl <= 0 {
+		ttl = DefaultArtifactTTL
+	}
+	return &LocalArtifactStore{dir: dir, ttl: ttl}
+}
+
+func (s *LocalArtifactStore) Put(_ context.Context, contentType string, data []byte) (Artifact, error) {
+	ext, ok := artifactExtensions[contentType]
+	if !ok {
+		return Artifact{}, fmt.Errorf("unsupported artifact content type %q", contentType)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.pruneExpiredLocked(now)
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return Artifact{}, fmt.Errorf("create artifact directory: %w", err)
+	}
+	id := uuid.NewString()
+	if err := os.WriteFile(filepath.Join(s.dir, id+ext), data, 0o644); err != nil {
+		return Artifact{}, fmt.Errorf("write artifact %s: %w", id, err)
+	}
+	return Artifact{
+		ID:          id,
+		ContentType: contentType,
+		Bytes:       len(data),
+		URL:         ArtifactURL(id),
+		ExpiresAt:   now.Add(s.ttl).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *LocalArtifactStore) Open(_ context.Context, id string) ([]byte, string, error) {
+	// Only IDs this store issued resolve, which also keeps the lookup inside dir.
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, "", ErrArtifactNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneExpiredLocked(time.Now())
+	for contentType, ext := range artifactExtensions {
+		data, err := os.ReadFile(filepath.Join(s.dir, id+ext))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("read artifact %s: %w", id, err)
+		}
+		return data, contentType, nil
+	}
+	return nil, "", ErrArtifactNotFound
+}
+
+func (s *LocalArtifactStore) pruneExpiredLocked(now time.Time) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		// Leave files the store did not write alone; dir may be shared.
+		if entry.IsDir() || !isArtifactFileName(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || now.Sub(info.ModTime()) <= s.ttl {
+			continue
+		}
+		_ = os.Remove(filepath.Join(s.dir, entry.Name()))
+	}
+}
+
+func isArtifactFileName(name string) bool {
+	ext := filepath.Ext(name)
+	known := false
+	for _, artifactExt := range artifactExtensions {
+		known = known || ext == artifactExt
+	}
+	_, err := uuid.Parse(strings.TrimSuffix(name, ext))
+	return known && err == nil
+}
+
+// ArtifactURL is the path GET /artifacts/{id} serves id from.
+func ArtifactURL(id string) string {
+	return "/artifacts/" + id
+}
@@ -648,6 +648,7 @@ func (b *Browser) laneProfile(ctx context.Context, browser *rod.Browser) (browse
 	if region == "" {
 		region = strings.TrimSpace(b.LanguageCode)
 	}
+	mobile := mobileBrowserProfileFromContext(ctx)
 
 	if forcedID := forcedProfileIDFromContext(ctx); forcedID != "" {
 		profile, ok := browserprofile.ProfileByID(forcedID)
@@ -662,19 +663,25 @@ func (b *Browser) laneProfile(ctx context.Context, browser *rod.Browser) (browse
 	}
 
 	if laneKey := proxyLaneKeyFromContext(ctx); !laneKey.Empty() && b.ProxyLaneStore != nil {
-		profile := b.ProxyLaneStore.Profile(laneKey, func() browserprofile.Profile {
-			selected := browserprofile.SelectProfileForSession(engine, region, laneKey.SessionID)
+		selectLaneProfile := func() browserprofile.Profile {
+			selected := browserprofile.SelectDeviceProfileForSession(engine, region, laneKey.SessionID, mobile)
 			selected = applyRuntimeBrowserVersion(selected, browser)
 			selected = applyProfileLanguageHint(selected, region)
 			if overrideUA := strings.TrimSpace(b.UserAgent); overrideUA != "" {
 				selected.UserAgent = overrideUA
 			}
 			return selected
-		})
-		return profile, laneKey.ID()
+		}
+		profile := b.ProxyLaneStore.Profile(laneKey, selectLaneProfile)
+		if profile.Mobile != mobile {
+			// The sticky session was opened on the other device; keep its
+			// stored profile and pick a session-stable one for this request.
+			profile = selectLaneProfile()
+		}
+		return profile, deviceLaneKey(laneKey.ID(), mobile)
 	}
 
-	laneKey := browserprofile.LaneKey(engine, region)
+	laneKey := deviceLaneKey(browserprofile.LaneKey(engine, region), mobile)
 
 	state := b.connectionState()
 	state.mu.Lock()
@@ -691,7 +698,7 @@ func (b *Browser) laneProfile(ctx context.Context, browser *rod.Browser) (browse
 	// RWMutex-guarded catalog, and applyRuntimeBrowserVersion makes a CDP
 	// round-trip (browser.Version). Holding state.mu over network I/O would
 	// serialize all concurrent Navigate calls.
-	profile := browserprofile.SelectProfileForSession(engine, region, laneKey)
+	profile := browserprofile.SelectDeviceProfileForSession(engine, region, laneKey, mobile)
 	profile = applyRuntimeBrowserVersion(profile, browser)
 	profile = applyProfileLanguageHint(profile, region)
 	if overrideUA := strings.TrimSpace(b.UserAgent); overrideUA != "" {
@@ -798,6 +805,8 @@ func navigatorPlatformForProfile(profile browserprofile.Profile) string {
 		return "MacIntel"
 	case "linux":
 		return "Linux x86_64"
+	case "android":
+		return "Linux armv8l"
 	default:
 		return strings.TrimSpace(profile.Platform)
 	}
@@ -840,6 +849,12 @@ func profileDisplayMetricsFor(profile browserprofile.Profile) profileDisplayMetr
 		chromeHeight = 0
 		systemReservedHeight = 0
 		availTop = 0
+		// The device=mobile lane's Android profiles report Chrome's real
+		// metrics: the address bar inside the window, the status bar outside.
+		if strings.EqualFold(strings.TrimSpace(profile.Platform), "android") {
+			chromeHeight = 56
+			systemReservedHeight = 24
+		}
 	}
 
 	availHeight := max(screenHeight-systemReservedHeight, 1)
@@ -859,6 +874,13 @@ func profileDisplayMetricsFor(profile browserprofile.Profile) profileDisplayMetr
 	}
 }
 
+func profileDeviceScaleFactor(profile browserprofile.Profile) float64 {
+	if profile.DeviceScaleFactor > 0 {
+		return profile.DeviceScaleFactor
+	}
+	return 1
+}
+
 // applyProfileLanguageHint overrides the profile's locale-derived fields when
 // the requested language differs from the cached profile's. A bare language
 // hint that already matches the profile language is treated as a no-op so that
@@ -921,6 +943,7 @@ func applyProfile(page *rod.Page, profile browserprofile.Profile, minimal bool)
 		PlatformVersion: strings.TrimSpace(profile.PlatformVersion),
 		Architecture:    strings.TrimSpace(profile.Architecture),
 		Bitness:         strings.TrimSpace(profile.Bitness),
+		Model:           strings.TrimSpace(profile.Model),
 		Mobile:          profile.Mobile,
 	}
 
@@ -948,7 +971,7 @@ func applyProfile(page *rod.Page, profile browserprofile.Profile, minimal bool)
 	if err := (proto.EmulationSetDeviceMetricsOverride{
 		Width:             metrics.ViewportWidth,
 		Height:            metrics.ViewportHeight,
-		DeviceScaleFactor: 1,
+		DeviceScaleFactor: profileDeviceScaleFactor(profile),
 		Mobile:            profile.Mobile,
 		ScreenWidth:       &metrics.ScreenWidth,
 		ScreenHeight:      &metrics.ScreenHeight,
@@ -958,6 +981,16 @@ func applyProfile(page *rod.Page, profile browserprofile.Profile, minimal bool)
 		return fmt.Errorf("set device metrics failed: %w", err)
 	}
 
+	if profile.Mobile {
+		maxTouchPoints := 5
+		if err := (proto.EmulationSetTouchEmulationEnabled{
+			Enabled:        true,
+			MaxTouchPoints: &maxTouchPoints,
+		}).Call(page); err != nil {
+			return fmt.Errorf("set touch emulation failed: %w", err)
+		}
+	}
+
 	if err := (proto.NetworkSetExtraHTTPHeaders{
 		Headers: proto.NetworkHeaders{
 			"Accept-Language": gson.New(acceptLanguage),
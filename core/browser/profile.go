@@ -41,6 +41,11 @@ type Profile struct {
 	WebGLRenderer   string         `json:"webgl_renderer"`
 	Tags            []string       `json:"tags"`
 	Weight          int            `json:"weight"`
+
+	// Model is the UA-CH device model and DeviceScaleFactor the screen pixel
+	// ratio (zero means 1); both are only set on mobile profiles.
+	Model             string  `json:"model,omitempty"`
+	DeviceScaleFactor float64 `json:"device_scale_factor,omitempty"`
 }
 
 type catalogConfig struct {
@@ -195,12 +200,20 @@ func SelectProfile(engine string, region string) Profile {
 	return SelectProfileForSession(engine, region, "")
 }
 
-// SelectProfileForSession picks a profile for (engine, region, salt).
+// SelectProfileForSession picks a desktop profile for (engine, region, salt).
 // If a lane_profile_ids override exists it is always honoured.
 // Empty salt picks the first eligible profile (same as SelectProfile).
 // Non-empty salt uses weighted selection seeded by FNV-1a hash of salt,
 // giving each session a stable but varied profile.
 func SelectProfileForSession(engine, region, salt string) Profile {
+	return SelectDeviceProfileForSession(engine, region, salt, false)
+}
+
+// SelectDeviceProfileForSession is SelectProfileForSession restricted to
+// mobile or desktop profiles. A lane_profile_ids override is honoured only
+// when it matches the device. When the catalog has no profile for the device
+// the default desktop profile is returned.
+func SelectDeviceProfileForSession(engine, region, salt string, mobile bool) Profile {
 	engine = NormalizeEngine(engine)
 	region = NormalizeRegion(region)
 	if region == "" {
@@ -212,10 +225,12 @@ func SelectProfileForSession(engine, region, salt string) Profile {
 	profileID, ok := laneProfileIDs[laneKey]
 	profileCatalogMu.RUnlock()
 	if ok {
-		return profileByID(profileID)
+		if profile := profileByID(profileID); profile.Mobile == mobile {
+			return profile
+		}
 	}
 
-	pool := eligibleProfiles(engine, region)
+	pool := eligibleProfiles(engine, region, mobile)
 	return pickWeighted(pool, salt)
 }
 
@@ -224,10 +239,11 @@ type weightedProfile struct {
 	weight  int
 }
 
-// eligibleProfiles builds the weighted pool for (engine, region).
+// eligibleProfiles builds the weighted pool for (engine, region, device).
 // Linux profiles are preferred 4x on linux runtime; Windows 4x on windows; macOS 4x on darwin.
 // Profiles tagged "ru" are included only when region == "ru"; "ru"-tagged profiles are excluded otherwise.
-func eligibleProfiles(engine, region string) []weightedProfile {
+// Only profiles whose Mobile flag equals mobile are included.
+func eligibleProfiles(engine, region string, mobile bool) []weightedProfile {
 	profileCatalogMu.RLock()
 	snap := make([]Profile, 0, len(catalog))
 	for _, p := range catalog {
@@ -244,6 +260,9 @@ func eligibleProfiles(engine, region string) []weightedProfile {
 
 	var pool []weightedProfile
 	for _, p := range snap {
+		if p.Mobile != mobile {
+			continue
+		}
 		isRu := slices.Contains(p.Tags, "ru")
 		if region == "ru" && !isRu {
 			continue
@@ -49,6 +49,7 @@ func TestProfileCoherence(t *testing.T) {
 		name   string
 		engine string
 		region string
+		mobile bool
 	}{
 		{
 			name:   "windows lane",
@@ -60,6 +61,18 @@ func TestProfileCoherence(t *testing.T) {
 			engine: "bing",
 			region: "en-US",
 		},
+		{
+			name:   "mobile lane",
+			engine: "google",
+			region: "en-US",
+			mobile: true,
+		},
+		{
+			name:   "mobile ru lane",
+			engine: "yandex",
+			region: "ru",
+			mobile: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -67,6 +80,9 @@ func TestProfileCoherence(t *testing.T) {
 			ctx := core.WithEngine(context.Background(), tc.engine)
 			ctx = core.WithProfileRegion(ctx, tc.region)
 			ctx = core.WithBrowserProfileUsage(ctx)
+			if tc.mobile {
+				ctx = core.WithMobileBrowserProfile(ctx)
+			}
 
 			page, err := browser.Navigate(ctx, fixture.URL)
 			if err != nil {
@@ -94,6 +110,15 @@ func TestProfileCoherence(t *testing.T) {
 			if got.NavigatorPlatform != expectedNavigatorPlatform(expected.Platform) {
 				t.Fatalf("navigator.platform mismatch: expected %q got %q", expectedNavigatorPlatform(expected.Platform), got.NavigatorPlatform)
 			}
+			if expected.Mobile != tc.mobile {
+				t.Fatalf("selected profile %q has mobile=%t, want %t", expected.ID, expected.Mobile, tc.mobile)
+			}
+			if got.Mobile != expected.Mobile {
+				t.Fatalf("navigator.userAgentData.mobile mismatch: expected %t got %t", expected.Mobile, got.Mobile)
+			}
+			if expected.Mobile && got.MaxTouchPoints == 0 {
+				t.Fatal("mobile profile should report touch points")
+			}
 			if got.Locale != expected.Locale {
 				t.Fatalf("Intl locale mismatch: expected %q got %q", expected.Locale, got.Locale)
 			}
@@ -150,6 +175,8 @@ type profileSurface struct {
 	UserAgent               string   `json:"userAgent"`
 	Platform                string   `json:"platform"`
 	NavigatorPlatform       string   `json:"navigatorPlatform"`
+	Mobile                  bool     `json:"mobile"`
+	MaxTouchPoints          int      `json:"maxTouchPoints"`
 	NavigatorLanguages      []string `json:"navigatorLanguages"`
 	Timezone                string   `json:"timezone"`
 	Locale                  string   `json:"locale"`
@@ -186,6 +213,8 @@ func expectedNavigatorPlatform(platform string) string {
 		return "Win32"
 	case "macOS":
 		return "MacIntel"
+	case "Android":
+		return "Linux armv8l"
 	default:
 		return "Linux x86_64"
 	}
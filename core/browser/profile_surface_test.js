@@ -47,6 +47,8 @@ async () => {
     userAgent: navigator.userAgent || "",
     platform: navigator.userAgentData ? (navigator.userAgentData.platform || "") : "",
     navigatorPlatform: navigator.platform || "",
+    mobile: navigator.userAgentData ? Boolean(navigator.userAgentData.mobile) : false,
+    maxTouchPoints: navigator.maxTouchPoints || 0,
     navigatorLanguages: Array.from(navigator.languages || []),
     timezone: Intl.DateTimeFormat().resolvedOptions().timeZone || "",
     locale: Intl.DateTimeFormat().resolvedOptions().locale || "",
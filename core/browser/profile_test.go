@@ -196,3 +196,39 @@ func restoreProfileState(catalogState map[string]Profile, laneProfiles map[strin
 	laneProfileIDs = laneProfiles
 	defaultRegionByEngine = defaultRegions
 }
+
+func TestSelectDeviceProfileForSession(t *testing.T) {
+	for _, region := range []string{"us", "ru"} {
+		for i := 0; i < 20; i++ {
+			salt := "session-" + string(rune('a'+i))
+			if p := SelectDeviceProfileForSession("google", region, salt, true); !p.Mobile {
+				t.Fatalf("region %s: expected mobile profile, got %q", region, p.ID)
+			}
+			if p := SelectDeviceProfileForSession("google", region, salt, false); p.Mobile {
+				t.Fatalf("region %s: expected desktop profile, got %q", region, p.ID)
+			}
+		}
+	}
+
+	if p := SelectDeviceProfileForSession("yandex", "ru", "", true); !slices.Contains(p.Tags, "ru") {
+		t.Fatalf("expected ru-tagged mobile profile, got ID=%q tags=%v", p.ID, p.Tags)
+	}
+}
+
+func TestSelectDeviceProfileSkipsMismatchedLaneOverride(t *testing.T) {
+	originalCatalog, originalLaneProfiles, originalDefaultRegions := snapshotProfileState()
+	t.Cleanup(func() {
+		restoreProfileState(originalCatalog, originalLaneProfiles, originalDefaultRegions)
+	})
+
+	profileCatalogMu.Lock()
+	laneProfileIDs = map[string]string{"google:us": ProfileChromeLinuxUS}
+	profileCatalogMu.Unlock()
+
+	if p := SelectDeviceProfileForSession("google", "us", "", false); p.ID != ProfileChromeLinuxUS {
+		t.Fatalf("expected desktop lane override, got %q", p.ID)
+	}
+	if p := SelectDeviceProfileForSession("google", "us", "", true); !p.Mobile {
+		t.Fatalf("expected mobile request to ignore desktop lane override, got %q", p.ID)
+	}
+}
@@ -149,3 +149,27 @@ func TestApplyProfileLanguageHint(t *testing.T) {
 		})
 	}
 }
+
+func TestProfileDisplayMetricsForMobileProfiles(t *testing.T) {
+	tests := []struct {
+		name         string
+		platform     string
+		wantViewport int
+		wantAvail    int
+	}{
+		{name: "android keeps chrome and status bar", platform: "Android", wantViewport: 835, wantAvail: 891},
+		{name: "other mobile profiles fill the screen", platform: "iOS", wantViewport: 915, wantAvail: 915},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := browserprofile.Profile{Platform: tt.platform, Mobile: true, Viewport: browserprofile.Viewport{Width: 412, Height: 915}}
+			got := profileDisplayMetricsFor(profile)
+			if got.ViewportHeight != tt.wantViewport || got.AvailHeight != tt.wantAvail || got.AvailTop != 0 {
+				t.Fatalf("metrics = %+v, want viewport %d avail %d", got, tt.wantViewport, tt.wantAvail)
+			}
+		})
+	}
+	if platform := navigatorPlatformForProfile(browserprofile.Profile{Platform: "Android"}); platform != "Linux armv8l" {
+		t.Fatalf("android navigator.platform = %q, want Linux armv8l", platform)
+	}
+}
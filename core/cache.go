@@ -55,6 +55,34 @@ func BuildCacheKey(engine string, action string, q Query) string {
 		class,
 		provider,
 	)
+	if q.AIOnly {
+		raw += "|ai_only"
+	}
+	if q.PAADepth > 0 {
+		raw += fmt.Sprintf("|paa=%d", q.PAADepth)
+	}
+	if q.SafeSearch != "" {
+		raw += "|safe=" + string(q.SafeSearch)
+	}
+	if q.Device == DeviceMobile {
+		raw += "|device=mobile"
+	}
+	if q.Verbatim {
+		raw += "|verbatim"
+	}
+	if q.Layout {
+		raw += "|layout"
+	}
+	if len(q.RequestedOperators()) > 0 {
+		raw += fmt.Sprintf("|ops=%s;%s;%s;%s;%s;%s",
+			strings.TrimSpace(q.Exact),
+			strings.Join(q.ExcludeTerms, ","),
+			cacheToken(strings.Join(q.ExcludeSites, ",")),
+			strings.TrimSpace(q.InTitle),
+			strings.TrimSpace(q.InURL),
+			strings.Join(q.OrTerms, ","),
+		)
+	}
 	hash := sha256.Sum256([]byte(raw))
 	return hex.EncodeToString(hash[:])
 }
@@ -185,6 +185,16 @@ func TestBuildCacheKeyChangesWithPaginationAndFlags(t *testing.T) {
 	}); changed == baseKey {
 		t.Fatal("expected features to affect cache key")
 	}
+	if changed := BuildCacheKey("google", "search", Query{
+		Text:     "golang",
+		LangCode: "EN",
+		Limit:    10,
+		Start:    0,
+		Filter:   true,
+		Layout:   true,
+	}); changed == baseKey {
+		t.Fatal("expected layout to affect cache key")
+	}
 }
 
 func TestBuildCacheKeyNormalizesStableFields(t *testing.T) {
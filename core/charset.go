@@ -0,0 +1,23 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// DecodeHTMLBody converts a SERP document to UTF-8. Engines in CJK markets
+// still serve legacy encodings (Shift_JIS, EUC-JP, GBK) depending on the
+// entry point, so the encoding is taken from contentType or the document's
+// <meta charset> prescan. Bodies that are already valid UTF-8 are returned
+// unchanged, which also makes the call safe to repeat on decoded output whose
+// <meta> still names the original charset.
+func DecodeHTMLBody(body []byte, contentType string) ([]byte, error) {
+	if utf8.Valid(body) {
+		return body, nil
+	}
+	enc, _, _ := charset.DetermineEncoding(body, contentType)
+	return io.ReadAll(enc.NewDecoder().Reader(bytes.NewReader(body)))
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestDecodeHTMLBody(t *testing.T) {
+	t.Parallel()
+
+	page := `<html><head><meta charset="Shift_JIS"></head><body>検索結果</body></html>`
+	sjis, err := japanese.ShiftJIS.NewEncoder().String(page)
+	if err != nil {
+		t.Fatalf("encode fixture: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		body        []byte
+		contentType string
+	}{
+		{name: "meta prescan", body: []byte(sjis)},
+		{name: "content type header", body: []byte(sjis), contentType: "text/html; charset=Shift_JIS"},
+		{name: "already utf-8", body: []byte(page)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := DecodeHTMLBody(tt.body, tt.contentType)
+			if err != nil {
+				t.Fatalf("DecodeHTMLBody() error = %v", err)
+			}
+			if !bytes.Contains(got, []byte("検索結果")) {
+				t.Fatalf("expected decoded body to contain 検索結果, got %q", got)
+			}
+		})
+	}
+}
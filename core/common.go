@@ -123,6 +123,30 @@ type SearchResult struct {
 	Ad bool `json:"ad"`
 	// Features carries extracted SERP modules alongside the legacy result stream.
 	Features []SerpFeature `json:"-"`
+	// Sources lists the upstream engines that returned this result when the
+	// engine is itself a metasearch frontend (e.g. SearXNG). Empty otherwise.
+	Sources []string `json:"-"`
+	// News carries publisher metadata for news-tab results. Nil otherwise.
+	News *NewsMeta `json:"-"`
+	// Video carries clip metadata for video-tab results. Nil otherwise.
+	Video *VideoMeta `json:"-"`
+	// Product carries offer data for shopping-tab results. Nil otherwise.
+	Product *ProductMeta `json:"-"`
+	// Local carries the business listing for local-tab results. Nil otherwise.
+	Local *LocalMeta `json:"-"`
+	// Scholar carries citation data for scholar-tab results. Nil otherwise.
+	Scholar *ScholarMeta `json:"-"`
+	// SerpMeta carries page-level SERP information on the first result only
+	// (see AttachSerpMetaToFirstResult). Nil otherwise.
+	SerpMeta *SerpMeta `json:"-"`
+	// Layout is the result's box on a browser-rendered SERP (see
+	// StampLayout). Nil in raw and parse modes.
+	Layout *LayoutBox `json:"-"`
+	// Sitelinks, Breadcrumbs and RichSnippet carry the structure nested in an
+	// organic result block (see ApplyResultExtras). Empty otherwise.
+	Sitelinks   []Sitelink   `json:"-"`
+	Breadcrumbs []string     `json:"-"`
+	RichSnippet *RichSnippet `json:"-"`
 }
 
 // DeduplicateResults removes items with duplicate URLs and returns a result set
@@ -268,6 +292,17 @@ type Query struct {
 	Filetype string
 	// Site restricts results to a specific domain, for example "github.com".
 	Site string
+	// Exact is a phrase that must appear verbatim.
+	Exact string
+	// ExcludeTerms and ExcludeSites drop results containing a term or coming
+	// from a domain.
+	ExcludeTerms []string
+	ExcludeSites []string
+	// InTitle and InURL require a term in the page title or URL.
+	InTitle string
+	InURL   string
+	// OrTerms requires at least one of the terms.
+	OrTerms []string
 	// Limit is the maximum number of results requested by the client.
 	Limit int
 	// Start is an engine pagination offset. Values are engine-specific:
@@ -281,6 +316,29 @@ type Query struct {
 	// supported by the engine. Such entries may be returned with non-positive
 	// internal rank values.
 	Features bool
+	// AIOnly (features=ai_only) narrows the response to AI summary features.
+	// Engines that can stop once their generative answer resolves do so
+	// without reading the organic results. It implies Features.
+	AIOnly bool
+	// PAADepth is how many levels of Google's people-also-ask box the browser
+	// engine expands: questions revealed by clicking one are a level deeper.
+	// Zero keeps the single pass over the questions already on the page.
+	PAADepth int
+	// SafeSearch is the adult-content filter level. Empty leaves the engine
+	// default, which differs by engine and market.
+	SafeSearch SafeSearch
+	// Device selects mobile or desktop browser profiles, and so the engine's
+	// mobile or desktop SERP. Empty means desktop.
+	Device Device
+	// Verbatim asks the engine to run the query as typed, without spelling
+	// correction or rewriting. Engines without such a switch ignore it.
+	Verbatim bool
+	// Screenshot asks browser engines to capture the SERP once results load.
+	// Empty captures nothing; raw HTTP engines ignore it.
+	Screenshot ScreenshotMode
+	// Layout asks browser engines to measure where results and features were
+	// drawn (see StampLayout). Raw HTTP engines ignore it.
+	Layout bool
 	// Extract fetches and embeds cleaned target-page content for top results.
 	Extract bool
 	// ExtractTop limits how many top results are enriched when Extract is true.
@@ -318,9 +376,10 @@ func (q Query) String() string {
 		maskedProxyURL = MaskProxyURL(q.ProxyURL)
 	}
 	return fmt.Sprintf(
-		"{Text:%s LangCode:%s Region:%s DateInterval:%s Filetype:%s Site:%s Limit:%d Start:%d Filter:%t Features:%t Extract:%t ExtractTop:%d ExtractMode:%s ProxyURL:%s ProxyCountry:%s ProxyClass:%s ProxyProvider:%s ProxySessionID:%s ProxyOverride:%s Insecure:%t}",
+		"{Text:%s LangCode:%s Region:%s DateInterval:%s Filetype:%s Site:%s Exact:%s ExcludeTerms:%v ExcludeSites:%v InTitle:%s InURL:%s OrTerms:%v Limit:%d Start:%d Filter:%t Features:%t AIOnly:%t PAADepth:%d SafeSearch:%s Device:%s Verbatim:%t Screenshot:%s Layout:%t Extract:%t ExtractTop:%d ExtractMode:%s ProxyURL:%s ProxyCountry:%s ProxyClass:%s ProxyProvider:%s ProxySessionID:%s ProxyOverride:%s Insecure:%t}",
 		q.Text, q.LangCode, q.Region, q.DateInterval, q.Filetype, q.Site,
-		q.Limit, q.Start, q.Filter, q.Features, q.Extract, q.ExtractTop, q.ExtractMode,
+		q.Exact, q.ExcludeTerms, q.ExcludeSites, q.InTitle, q.InURL, q.OrTerms,
+		q.Limit, q.Start, q.Filter, q.Features, q.AIOnly, q.PAADepth, q.SafeSearch, q.Device, q.Verbatim, q.Screenshot, q.Layout, q.Extract, q.ExtractTop, q.ExtractMode,
 		maskedProxyURL, q.ProxyCountry, q.ProxyClass, q.ProxyProvider,
 		q.ProxySessionID, q.ProxyOverride, q.Insecure,
 	)
@@ -338,9 +397,11 @@ func ComputePagination(start int, pageSize int) (int, int, error) {
 	return start / pageSize, start % pageSize, nil
 }
 
-// IsEmpty reports whether query text operators are all absent.
+// IsEmpty reports whether query text operators are all absent. Exclusions
+// alone do not make a query.
 func (q Query) IsEmpty() bool {
-	if q.Site == "" && q.Filetype == "" && q.Text == "" {
+	if q.Site == "" && q.Filetype == "" && q.Text == "" &&
+		q.Exact == "" && q.InTitle == "" && q.InURL == "" && len(q.OrTerms) == 0 {
 		return true
 	}
 	return false
@@ -349,6 +410,12 @@ func (q Query) IsEmpty() bool {
 // MaxQueryLimit is the maximum allowed value for the limit parameter.
 const MaxQueryLimit = 100
 
+// FeaturesAIOnly is the features parameter value that sets Query.AIOnly.
+const FeaturesAIOnly = "ai_only"
+
+// MaxPAADepth is the maximum allowed value for the paa_depth parameter.
+const MaxPAADepth = 4
+
 // defaultQueryLimit is the assumed limit when a request omits it (InitFromContext)
 // and the fallback used by pagination math for internally-built queries that
 // leave Limit unset.
@@ -364,6 +431,12 @@ func (searchQuery *Query) InitFromContext(reqCtx *fiber.Ctx) error {
 	searchQuery.DateInterval = strings.TrimSpace(reqCtx.Query("date"))
 	searchQuery.Filetype = strings.TrimSpace(reqCtx.Query("file"))
 	searchQuery.Site = strings.TrimSpace(reqCtx.Query("site"))
+	searchQuery.Exact = strings.TrimSpace(reqCtx.Query("exact"))
+	searchQuery.ExcludeTerms = queryList(reqCtx, "exclude_terms")
+	searchQuery.ExcludeSites = queryList(reqCtx, "exclude_sites")
+	searchQuery.InTitle = strings.TrimSpace(reqCtx.Query("intitle"))
+	searchQuery.InURL = strings.TrimSpace(reqCtx.Query("inurl"))
+	searchQuery.OrTerms = queryList(reqCtx, "or_terms")
 
 	limitRaw := reqCtx.Query("limit", strconv.Itoa(defaultQueryLimit))
 	limit, err := strconv.Atoi(limitRaw)
@@ -390,10 +463,37 @@ func (searchQuery *Query) InitFromContext(reqCtx *fiber.Ctx) error {
 		return errInvalidParam(fmt.Sprintf("filter: %v", err))
 	}
 
-	searchQuery.Features, err = strconv.ParseBool(reqCtx.Query("features", "1"))
-	if err != nil {
+	features := reqCtx.Query("features", "1")
+	if strings.EqualFold(strings.TrimSpace(features), FeaturesAIOnly) {
+		searchQuery.Features, searchQuery.AIOnly = true, true
+	} else if searchQuery.Features, err = strconv.ParseBool(features); err != nil {
 		return errInvalidParam(fmt.Sprintf("features: %v", err))
 	}
+	paaDepth, err := parseNonNegativeIntQuery(reqCtx.Query("paa_depth"), 0)
+	if err != nil || paaDepth > MaxPAADepth {
+		return errInvalidParam(fmt.Sprintf("paa_depth must be between 0 and %d", MaxPAADepth))
+	}
+	searchQuery.PAADepth = paaDepth
+	searchQuery.SafeSearch, err = ParseSafeSearch(strings.ToLower(strings.TrimSpace(reqCtx.Query("safe"))))
+	if err != nil {
+		return errInvalidParam(err.Error())
+	}
+	searchQuery.Device, err = ParseDevice(strings.ToLower(strings.TrimSpace(reqCtx.Query("device"))))
+	if err != nil {
+		return errInvalidParam(err.Error())
+	}
+	searchQuery.Verbatim, err = strconv.ParseBool(reqCtx.Query("verbatim", "0"))
+	if err != nil {
+		return errInvalidParam(fmt.Sprintf("verbatim: %v", err))
+	}
+	searchQuery.Screenshot, err = ParseScreenshotMode(strings.ToLower(strings.TrimSpace(reqCtx.Query("screenshot"))))
+	if err != nil {
+		return errInvalidParam(err.Error())
+	}
+	searchQuery.Layout, err = strconv.ParseBool(reqCtx.Query("layout", "0"))
+	if err != nil {
+		return errInvalidParam(fmt.Sprintf("layout: %v", err))
+	}
 	// extract is a unified bool-or-int knob: extract=0/false disables, extract=N
 	// (or true/1) extracts the top N results. The tuning params extract_mode and
 	// min_runes also imply extraction (extract=0 still overrides them). The
@@ -402,6 +502,34 @@ func (searchQuery *Query) InitFromContext(reqCtx *fiber.Ctx) error {
 		return err
 	}
 
+	if err := searchQuery.initProxyFromHeaders(reqCtx); err != nil {
+		return err
+	}
+
+	if searchQuery.IsEmpty() {
+		return errEmptyQuery()
+	}
+	return nil
+}
+
+// queryList reads a multi-valued parameter given either repeated
+// (?exclude_sites=a&exclude_sites=b) or comma-separated, dropping blanks.
+func queryList(reqCtx *fiber.Ctx, name string) []string {
+	var values []string
+	for _, raw := range reqCtx.Context().QueryArgs().PeekMulti(name) {
+		for _, value := range strings.Split(string(raw), ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+// initProxyFromHeaders reads the per-request proxy override and market headers
+// (X-Use-Proxy, X-Proxy-*) onto the query.
+func (searchQuery *Query) initProxyFromHeaders(reqCtx *fiber.Ctx) error {
+	var err error
 	searchQuery.ProxyOverride, err = NormalizeProxyRequestOverride(reqCtx.Get("X-Use-Proxy"))
 	if err != nil {
 		return errInvalidParam(fmt.Sprintf("X-Use-Proxy: %v", err))
@@ -418,10 +546,6 @@ func (searchQuery *Query) InitFromContext(reqCtx *fiber.Ctx) error {
 	searchQuery.ProxyClass = strings.ToLower(strings.TrimSpace(reqCtx.Get("X-Proxy-Class")))
 	searchQuery.ProxyProvider = strings.ToLower(strings.TrimSpace(reqCtx.Get("X-Proxy-Provider")))
 	searchQuery.ProxySessionID = strings.TrimSpace(reqCtx.Get("X-Proxy-Session-ID"))
-
-	if searchQuery.IsEmpty() {
-		return errEmptyQuery()
-	}
 	return nil
 }
 
@@ -45,6 +45,9 @@ func PrepareEngineContext(ctx context.Context, query Query, engineName string, m
 	if minimalBrowserProfile {
 		ctx = WithMinimalBrowserProfile(ctx)
 	}
+	if query.Device == DeviceMobile {
+		ctx = WithMobileBrowserProfile(ctx)
+	}
 	return WithQueryHash(ctx, QueryHashFromQuery(query))
 }
 
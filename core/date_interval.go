@@ -0,0 +1,68 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DateSpan is the recency bucket a DateInterval falls into. Engines that only
+// offer fixed "past day/week/month/year" filters map it to their own
+// parameter value instead of parsing the interval themselves.
+type DateSpan int
+
+const (
+	// DateSpanNone means no filter: the interval is empty or longer than a
+	// year.
+	DateSpanNone DateSpan = iota
+	DateSpanDay
+	DateSpanWeek
+	DateSpanMonth
+	DateSpanYear
+)
+
+// ParseDateInterval parses a "YYYYMMDD..YYYYMMDD" DateInterval into its start
+// and end dates.
+func ParseDateInterval(dateInterval string) (start, end time.Time, err error) {
+	parts := strings.Split(strings.TrimSpace(dateInterval), "..")
+	if len(parts) != 2 {
+		return time.Time{}, time.Time{}, errors.New("incorrect date interval provided, expected YYYYMMDD..YYYYMMDD")
+	}
+	start, err = time.Parse("20060102", parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid start date format, expected YYYYMMDD")
+	}
+	end, err = time.Parse("20060102", parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid end date format, expected YYYYMMDD")
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, errors.New("date interval end is before start")
+	}
+	return start, end, nil
+}
+
+// DateIntervalSpan buckets a DateInterval by its length: up to 1 day, 7 days,
+// 31 days or 366 days. An empty interval yields DateSpanNone; malformed input
+// is rejected so a non-spec value does not silently lose the filter.
+func DateIntervalSpan(dateInterval string) (DateSpan, error) {
+	if strings.TrimSpace(dateInterval) == "" {
+		return DateSpanNone, nil
+	}
+	start, end, err := ParseDateInterval(dateInterval)
+	if err != nil {
+		return DateSpanNone, err
+	}
+	switch span := end.Sub(start); {
+	case span <= 24*time.Hour:
+		return DateSpanDay, nil
+	case span <= 7*24*time.Hour:
+		return DateSpanWeek, nil
+	case span <= 31*24*time.Hour:
+		return DateSpanMonth, nil
+	case span <= 366*24*time.Hour:
+		return DateSpanYear, nil
+	default:
+		return DateSpanNone, nil
+	}
+}
@@ -0,0 +1,30 @@
+package core
+
+import "testing"
+
+func TestDateIntervalSpan(t *testing.T) {
+	tests := []struct {
+		interval string
+		want     DateSpan
+		wantErr  bool
+	}{
+		{interval: "", want: DateSpanNone},
+		{interval: "20240101..20240102", want: DateSpanDay},
+		{interval: "20240101..20240105", want: DateSpanWeek},
+		{interval: "20240101..20240201", want: DateSpanMonth},
+		{interval: "20240101..20241231", want: DateSpanYear},
+		{interval: "20200101..20241231", want: DateSpanNone},
+		{interval: "20240101", wantErr: true},
+		{interval: "2024-01-01..20240102", wantErr: true},
+		{interval: "20240102..20240101", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := DateIntervalSpan(tt.interval)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("DateIntervalSpan(%q) err = %v, wantErr %v", tt.interval, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("DateIntervalSpan(%q) = %v, want %v", tt.interval, got, tt.want)
+		}
+	}
+}
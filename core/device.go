@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	browserprofile "github.com/karust/openserp/core/browser"
+)
+
+// Device is the browser form factor a query is searched from. The zero value
+// is desktop.
+type Device string
+
+const (
+	DeviceDesktop Device = "desktop"
+	DeviceMobile  Device = "mobile"
+)
+
+// ParseDevice validates a device= value. An empty value is accepted and means
+// desktop.
+func ParseDevice(raw string) (Device, error) {
+	switch device := Device(raw); device {
+	case "", DeviceDesktop, DeviceMobile:
+		return device, nil
+	}
+	return "", fmt.Errorf("unknown device %q: accepted values are desktop, mobile", raw)
+}
+
+// DeviceSupporter is implemented by engines (or their raw and pooled
+// wrappers) that can parse a non-desktop SERP layout on some verticals.
+type DeviceSupporter interface {
+	SupportsDevice(Device, Vertical) bool
+}
+
+// EngineSupportsDevice reports whether engine can parse the device's SERP on
+// vertical. Every engine parses desktop pages, and suggestions come from an
+// autocomplete API with no layout; anything else needs DeviceSupporter.
+func EngineSupportsDevice(engine SearchEngine, device Device, vertical Vertical) bool {
+	if device == "" || device == DeviceDesktop || vertical == VerticalSuggest {
+		return true
+	}
+	supporter, ok := engine.(DeviceSupporter)
+	return ok && supporter.SupportsDevice(device, vertical)
+}
+
+func enginesSupportingDevice(engines []SearchEngine, device Device, vertical Vertical) []SearchEngine {
+	out := make([]SearchEngine, 0, len(engines))
+	for _, engine := range engines {
+		if EngineSupportsDevice(engine, device, vertical) {
+			out = append(out, engine)
+		}
+	}
+	return out
+}
+
+// usedDevice reports the device a request actually searched from, judged by
+// the browser profiles it recorded: a desktop profile standing in for
+// device=mobile (a forced profile, or no mobile profile for the market)
+// makes it desktop. With no profile recorded the requested device stands.
+func usedDevice(ctx context.Context, requested Device) Device {
+	if requested != DeviceMobile {
+		return requested
+	}
+	for _, id := range BrowserProfileIDsFromContext(ctx) {
+		if profile, ok := browserprofile.ProfileByID(id); ok && !profile.Mobile {
+			return DeviceDesktop
+		}
+	}
+	return requested
+}
@@ -28,6 +28,8 @@ const (
 	ReasonUnknownFormat           = "UNKNOWN_FORMAT"
 	ReasonRequestProxyURLDisabled = "REQUEST_PROXY_URL_DISABLED"
 	ReasonUnsupportedProxyScheme  = "UNSUPPORTED_PROXY_SCHEME"
+	ReasonArtifactNotFound        = "ARTIFACT_NOT_FOUND"
+	ReasonArchiveNotFound         = "ARCHIVE_NOT_FOUND"
 )
 
 func errInvalidLimit(msg string) *APIError {
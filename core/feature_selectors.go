@@ -123,6 +123,7 @@ func ExtractSerpFeaturesBySelectors(doc *goquery.Document, specs []SerpFeatureSe
 					Items:      selectedFeatureItems(container, spec.ItemSelector),
 					Links:      selectedFeatureLinks(container, spec.LinkSelector),
 					Confidence: spec.Confidence,
+					Layout:     SelectionLayout(container),
 				}
 				if spec.Position > 0 {
 					feature.Position = &Position{Absolute: spec.Position}
@@ -87,6 +87,180 @@ func RenderMarkdownImage(env *ImageEnvelope) []byte {
 	return []byte(b.String())
 }
 
+// RenderMarkdownNews formats a NewsEnvelope as Markdown.
+func RenderMarkdownNews(env *NewsEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# News results for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, escapeMarkdown(r.Title))
+		fmt.Fprintf(&b, "**Source:** %s", newsSourceLabel(r))
+		if r.PublishedAt != "" {
+			fmt.Fprintf(&b, " - **Published:** %s", r.PublishedAt)
+		}
+		b.WriteString("\n\n")
+		if r.Snippet != "" {
+			fmt.Fprintf(&b, "%s\n\n", r.Snippet)
+		}
+		fmt.Fprintf(&b, "-> %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// RenderMarkdownVideos formats a VideoEnvelope as Markdown.
+func RenderMarkdownVideos(env *VideoEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# Video results for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, escapeMarkdown(r.Title))
+		fmt.Fprintf(&b, "**Channel:** %s", videoChannelLabel(r))
+		if r.Duration != "" {
+			fmt.Fprintf(&b, " - **Duration:** %s", r.Duration)
+		}
+		if r.PublishedAt != "" {
+			fmt.Fprintf(&b, " - **Published:** %s", r.PublishedAt)
+		}
+		b.WriteString("\n\n")
+		if r.Thumbnail != "" {
+			fmt.Fprintf(&b, "![%s](%s)\n\n", escapeMarkdown(r.Title), r.Thumbnail)
+		}
+		fmt.Fprintf(&b, "-> %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// RenderMarkdownShopping formats a ShoppingEnvelope as Markdown.
+func RenderMarkdownShopping(env *ShoppingEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# Shopping results for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, escapeMarkdown(r.Title))
+		fmt.Fprintf(&b, "**Merchant:** %s", escapeMarkdown(productMerchantLabel(r)))
+		if price := FormatProductPrice(r.Price, r.Currency); price != "" {
+			fmt.Fprintf(&b, " - **Price:** %s", price)
+		}
+		if r.Rating > 0 {
+			fmt.Fprintf(&b, " - **Rating:** %s", productRatingLabel(r))
+		}
+		b.WriteString("\n\n")
+		if r.Thumbnail != "" {
+			fmt.Fprintf(&b, "![%s](%s)\n\n", escapeMarkdown(r.Title), r.Thumbnail)
+		}
+		fmt.Fprintf(&b, "-> %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// RenderMarkdownLocal formats a LocalEnvelope as Markdown.
+func RenderMarkdownLocal(env *LocalEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# Local results for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, escapeMarkdown(r.Title))
+		var facts []string
+		if r.Category != "" {
+			facts = append(facts, "**Category:** "+escapeMarkdown(r.Category))
+		}
+		if r.Rating > 0 {
+			facts = append(facts, "**Rating:** "+ratingLabel(r.Rating, r.ReviewCount))
+		}
+		if r.Phone != "" {
+			facts = append(facts, "**Phone:** "+escapeMarkdown(r.Phone))
+		}
+		if len(facts) > 0 {
+			b.WriteString(strings.Join(facts, " - ") + "\n\n")
+		}
+		if r.Address != "" {
+			fmt.Fprintf(&b, "%s\n\n", escapeMarkdown(r.Address))
+		}
+		if r.Hours != "" {
+			fmt.Fprintf(&b, "_%s_\n\n", escapeMarkdown(r.Hours))
+		}
+		fmt.Fprintf(&b, "-> %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// RenderMarkdownScholar formats a ScholarEnvelope as Markdown.
+func RenderMarkdownScholar(env *ScholarEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# Scholar results for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, escapeMarkdown(r.Title))
+		if byline := scholarBylineLabel(r); byline != "" {
+			fmt.Fprintf(&b, "_%s_\n\n", escapeMarkdown(byline))
+		}
+		if r.Snippet != "" {
+			fmt.Fprintf(&b, "%s\n\n", r.Snippet)
+		}
+		var links []string
+		if r.CitedBy > 0 {
+			links = append(links, fmt.Sprintf("**Cited by:** %d", r.CitedBy))
+		}
+		if r.PDFURL != "" {
+			links = append(links, fmt.Sprintf("[PDF](%s)", r.PDFURL))
+		}
+		if r.VersionsURL != "" {
+			links = append(links, fmt.Sprintf("[All versions](%s)", r.VersionsURL))
+		}
+		if len(links) > 0 {
+			b.WriteString(strings.Join(links, " - ") + "\n\n")
+		}
+		fmt.Fprintf(&b, "-> %s\n\n", r.URL)
+		if r.Extracted != nil && r.Extracted.Content != "" {
+			b.WriteString("### Extracted content\n\n")
+			b.WriteString(shiftMarkdownHeadings(r.Extracted.Content, 4))
+			b.WriteString("\n\n")
+		}
+	}
+
+	return []byte(b.String())
+}
+
+// RenderMarkdownSuggestions formats a SuggestEnvelope as Markdown.
+func RenderMarkdownSuggestions(env *SuggestEnvelope) []byte {
+	var b strings.Builder
+
+	enginesStr := strings.Join(env.Query.EnginesRequested, ", ")
+	fmt.Fprintf(&b, "# Suggestions for %q\n\n", env.Query.Text)
+	fmt.Fprintf(&b, "**Query:** %s - **Engines:** %s - **Took:** %dms\n\n",
+		env.Query.Text, enginesStr, env.Meta.TookMs)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "%d. %s (%s)\n", i+1, escapeMarkdown(r.Text), suggestionEnginesLabel(r))
+	}
+
+	return []byte(b.String())
+}
+
 func renderMarkdownFeatures(b *strings.Builder, features []SerpFeature, order []ResultType) {
 	forEachFeatureInOrder(features, order, func(feature SerpFeature) {
 		renderMarkdownFeature(b, feature)
@@ -94,11 +268,7 @@ func renderMarkdownFeatures(b *strings.Builder, features []SerpFeature, order []
 }
 
 func renderMarkdownFeature(b *strings.Builder, feature SerpFeature) {
-	heading := featureHeading(feature)
-	if feature.Type == ResultTypeKnowledgePanel && feature.Title != "" {
-		heading += " - " + feature.Title
-	}
-	fmt.Fprintf(b, "## %s\n\n", heading)
+	fmt.Fprintf(b, "## %s\n\n", featureHeadingWithEntity(feature))
 	if feature.Type == ResultTypeFeaturedSnippet && feature.Text != "" {
 		fmt.Fprintf(b, "> %s\n", feature.Text)
 		if len(feature.Links) > 0 {
@@ -112,17 +282,21 @@ func renderMarkdownFeature(b *strings.Builder, feature SerpFeature) {
 	}
 	if len(feature.Items) > 0 {
 		for _, item := range feature.Items {
+			indent := featureItemIndent(item)
 			switch {
 			case item.Title != "" && item.Text != "":
-				fmt.Fprintf(b, "- **%s** - %s\n", escapeMarkdown(item.Title), item.Text)
+				fmt.Fprintf(b, "%s- **%s** - %s\n", indent, escapeMarkdown(item.Title), item.Text)
 			case item.Text != "":
-				fmt.Fprintf(b, "- %s\n", item.Text)
+				fmt.Fprintf(b, "%s- %s\n", indent, item.Text)
 			case item.Title != "":
-				fmt.Fprintf(b, "- %s\n", escapeMarkdown(item.Title))
+				fmt.Fprintf(b, "%s- %s\n", indent, escapeMarkdown(item.Title))
 			}
 		}
 		b.WriteString("\n")
 	}
+	if feature.Knowledge != nil {
+		renderMarkdownKnowledge(b, feature.Knowledge)
+	}
 	if len(feature.Links) > 0 {
 		b.WriteString("Sources:\n")
 		for _, link := range feature.Links {
@@ -136,6 +310,27 @@ func renderMarkdownFeature(b *strings.Builder, feature SerpFeature) {
 	}
 }
 
+// renderMarkdownKnowledge writes a knowledge panel's fact table as a list,
+// followed by its official site and profiles.
+func renderMarkdownKnowledge(b *strings.Builder, knowledge *KnowledgeMeta) {
+	if len(knowledge.Attributes) > 0 {
+		for _, attribute := range knowledge.Attributes {
+			fmt.Fprintf(b, "- **%s:** %s\n", escapeMarkdown(attribute.Label), attribute.Value)
+		}
+		b.WriteString("\n")
+	}
+	if knowledge.Website != "" {
+		fmt.Fprintf(b, "Website: [%s](%s)\n\n", knowledge.Website, knowledge.Website)
+	}
+	if len(knowledge.Profiles) > 0 {
+		links := make([]string, 0, len(knowledge.Profiles))
+		for _, profile := range knowledge.Profiles {
+			links = append(links, fmt.Sprintf("[%s](%s)", escapeMarkdown(firstNonEmpty(profile.Network, profile.URL)), profile.URL))
+		}
+		fmt.Fprintf(b, "Profiles: %s\n\n", strings.Join(links, " · "))
+	}
+}
+
 func escapeMarkdown(s string) string {
 	replacer := strings.NewReplacer(
 		"*", `\*`,
@@ -59,6 +59,209 @@ func RenderTextImage(env *ImageEnvelope) []byte {
 	return []byte(b.String())
 }
 
+// RenderTextNews formats a NewsEnvelope as plain text.
+func RenderTextNews(env *NewsEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "News search: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s (%s)\n", i+1, r.Title, newsSourceLabel(r))
+		if r.PublishedAt != "" {
+			fmt.Fprintf(&b, "Published: %s\n", r.PublishedAt)
+		}
+		if r.Snippet != "" {
+			fmt.Fprintf(&b, "%s\n", r.Snippet)
+		}
+		fmt.Fprintf(&b, "URL: %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// newsSourceLabel prefers the publication name and falls back to the domain.
+func newsSourceLabel(r NewsResult) string {
+	if r.Source != "" {
+		return r.Source
+	}
+	return r.Domain
+}
+
+// RenderTextVideos formats a VideoEnvelope as plain text.
+func RenderTextVideos(env *VideoEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Video search: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s (%s)\n", i+1, r.Title, videoChannelLabel(r))
+		if r.Duration != "" {
+			fmt.Fprintf(&b, "Duration: %s\n", r.Duration)
+		}
+		if r.PublishedAt != "" {
+			fmt.Fprintf(&b, "Published: %s\n", r.PublishedAt)
+		}
+		fmt.Fprintf(&b, "URL: %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// videoChannelLabel prefers the channel name, then the platform, then the
+// domain.
+func videoChannelLabel(r VideoResult) string {
+	switch {
+	case r.Channel != "":
+		return r.Channel
+	case r.Platform != "":
+		return r.Platform
+	default:
+		return r.Domain
+	}
+}
+
+// RenderTextShopping formats a ShoppingEnvelope as plain text.
+func RenderTextShopping(env *ShoppingEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Shopping search: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s (%s)\n", i+1, r.Title, productMerchantLabel(r))
+		if price := FormatProductPrice(r.Price, r.Currency); price != "" {
+			fmt.Fprintf(&b, "Price: %s\n", price)
+		}
+		if r.Rating > 0 {
+			fmt.Fprintf(&b, "Rating: %s\n", productRatingLabel(r))
+		}
+		fmt.Fprintf(&b, "URL: %s\n\n", r.URL)
+	}
+
+	return []byte(b.String())
+}
+
+// RenderTextLocal formats a LocalEnvelope as plain text.
+func RenderTextLocal(env *LocalEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Local search: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s", i+1, r.Title)
+		if r.Category != "" {
+			fmt.Fprintf(&b, " (%s)", r.Category)
+		}
+		b.WriteString("\n")
+		if r.Rating > 0 {
+			fmt.Fprintf(&b, "Rating: %s\n", ratingLabel(r.Rating, r.ReviewCount))
+		}
+		for _, line := range []struct{ label, value string }{
+			{"Address", r.Address}, {"Phone", r.Phone}, {"Hours", r.Hours},
+			{"Website", r.Website}, {"Map", r.MapURL},
+		} {
+			if line.value != "" {
+				fmt.Fprintf(&b, "%s: %s\n", line.label, line.value)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	return []byte(b.String())
+}
+
+// RenderTextScholar formats a ScholarEnvelope as plain text.
+func RenderTextScholar(env *ScholarEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Scholar search: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s\n", i+1, r.Title)
+		if byline := scholarBylineLabel(r); byline != "" {
+			fmt.Fprintf(&b, "%s\n", byline)
+		}
+		if r.Snippet != "" {
+			fmt.Fprintf(&b, "%s\n", r.Snippet)
+		}
+		if r.CitedBy > 0 {
+			fmt.Fprintf(&b, "Cited by: %d\n", r.CitedBy)
+		}
+		fmt.Fprintf(&b, "URL: %s\n", r.URL)
+		if r.PDFURL != "" {
+			fmt.Fprintf(&b, "PDF: %s\n", r.PDFURL)
+		}
+		b.WriteString("\n")
+		if r.Extracted != nil && r.Extracted.Content != "" {
+			b.WriteString("Extracted content:\n")
+			b.WriteString(r.Extracted.Content)
+			b.WriteString("\n\n")
+		}
+	}
+
+	return []byte(b.String())
+}
+
+// scholarBylineLabel renders "A Vaswani, N Shazeer - NeurIPS, 2017".
+func scholarBylineLabel(r ScholarResult) string {
+	var venue []string
+	if r.Venue != "" {
+		venue = append(venue, r.Venue)
+	}
+	if r.Year > 0 {
+		venue = append(venue, fmt.Sprint(r.Year))
+	}
+	var parts []string
+	if len(r.Authors) > 0 {
+		parts = append(parts, strings.Join(r.Authors, ", "))
+	}
+	if len(venue) > 0 {
+		parts = append(parts, strings.Join(venue, ", "))
+	}
+	return strings.Join(parts, " - ")
+}
+
+// productMerchantLabel prefers the merchant name, then the domain.
+func productMerchantLabel(r ProductResult) string {
+	if r.Merchant != "" {
+		return r.Merchant
+	}
+	return r.Domain
+}
+
+// productRatingLabel renders "4.5/5 (1234 reviews)".
+func productRatingLabel(r ProductResult) string {
+	return ratingLabel(r.Rating, r.ReviewCount)
+}
+
+func ratingLabel(rating float64, reviews int) string {
+	label := fmt.Sprintf("%.1f/5", rating)
+	if reviews > 0 {
+		label += fmt.Sprintf(" (%d reviews)", reviews)
+	}
+	return label
+}
+
+// RenderTextSuggestions formats a SuggestEnvelope as plain text.
+func RenderTextSuggestions(env *SuggestEnvelope) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Suggestions: %s\n\n", env.Query.Text)
+
+	for i, r := range env.Results {
+		fmt.Fprintf(&b, "[%d] %s (%s)\n", i+1, r.Text, suggestionEnginesLabel(r))
+	}
+
+	return []byte(b.String())
+}
+
+// suggestionEnginesLabel lists the merged engines, or the single source.
+func suggestionEnginesLabel(r SuggestionResult) string {
+	if len(r.Engines) > 0 {
+		return strings.Join(r.Engines, ", ")
+	}
+	return r.Engine
+}
+
 // RenderNDJSON formats an Envelope as newline-delimited JSON.
 func RenderNDJSON(env *Envelope) []byte {
 	var b strings.Builder
@@ -80,6 +283,60 @@ func RenderNDJSONImage(env *ImageEnvelope) []byte {
 	return []byte(b.String())
 }
 
+// RenderNDJSONNews formats a NewsEnvelope as newline-delimited JSON.
+func RenderNDJSONNews(env *NewsEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
+// RenderNDJSONVideos formats a VideoEnvelope as newline-delimited JSON.
+func RenderNDJSONVideos(env *VideoEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
+// RenderNDJSONLocal formats a LocalEnvelope as newline-delimited JSON.
+func RenderNDJSONLocal(env *LocalEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
+// RenderNDJSONScholar formats a ScholarEnvelope as newline-delimited JSON.
+func RenderNDJSONScholar(env *ScholarEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
+// RenderNDJSONShopping formats a ShoppingEnvelope as newline-delimited JSON.
+func RenderNDJSONShopping(env *ShoppingEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
+// RenderNDJSONSuggestions formats a SuggestEnvelope as newline-delimited JSON.
+func RenderNDJSONSuggestions(env *SuggestEnvelope) []byte {
+	var b strings.Builder
+	for _, r := range env.Results {
+		writeNDJSONLine(&b, "result", r)
+	}
+	return []byte(b.String())
+}
+
 func renderTextFeatures(b *strings.Builder, features []SerpFeature, order []ResultType) {
 	forEachFeatureInOrder(features, order, func(feature SerpFeature) {
 		renderTextFeature(b, feature)
@@ -100,11 +357,7 @@ func forEachFeatureInOrder(features []SerpFeature, order []ResultType, render fu
 }
 
 func renderTextFeature(b *strings.Builder, feature SerpFeature) {
-	heading := featureHeading(feature)
-	if feature.Type == ResultTypeKnowledgePanel && feature.Title != "" {
-		heading += " - " + feature.Title
-	}
-	fmt.Fprintf(b, "%s\n", heading)
+	fmt.Fprintf(b, "%s\n", featureHeadingWithEntity(feature))
 	if feature.Text != "" {
 		fmt.Fprintf(b, "%s", feature.Text)
 		if len(feature.Links) == 1 {
@@ -113,13 +366,25 @@ func renderTextFeature(b *strings.Builder, feature SerpFeature) {
 		b.WriteString("\n")
 	}
 	for _, item := range feature.Items {
+		indent := featureItemIndent(item)
 		switch {
 		case item.Title != "" && item.Text != "":
-			fmt.Fprintf(b, "- %s - %s\n", item.Title, item.Text)
+			fmt.Fprintf(b, "%s- %s - %s\n", indent, item.Title, item.Text)
 		case item.Text != "":
-			fmt.Fprintf(b, "- %s\n", item.Text)
+			fmt.Fprintf(b, "%s- %s\n", indent, item.Text)
 		case item.Title != "":
-			fmt.Fprintf(b, "- %s\n", item.Title)
+			fmt.Fprintf(b, "%s- %s\n", indent, item.Title)
+		}
+	}
+	if knowledge := feature.Knowledge; knowledge != nil {
+		for _, attribute := range knowledge.Attributes {
+			fmt.Fprintf(b, "- %s: %s\n", attribute.Label, attribute.Value)
+		}
+		if knowledge.Website != "" {
+			fmt.Fprintf(b, "Website: %s\n", knowledge.Website)
+		}
+		for _, profile := range knowledge.Profiles {
+			fmt.Fprintf(b, "Profile: %s\n", knowledgeProfileLabel(profile))
 		}
 	}
 	if len(feature.Links) > 1 {
@@ -131,6 +396,35 @@ func renderTextFeature(b *strings.Builder, feature SerpFeature) {
 	b.WriteString("\n")
 }
 
+// featureHeadingWithEntity names the entity, and its type when known, after
+// a knowledge panel heading.
+func featureHeadingWithEntity(feature SerpFeature) string {
+	heading := featureHeading(feature)
+	if feature.Type != ResultTypeKnowledgePanel || feature.Title == "" {
+		return heading
+	}
+	heading += " - " + feature.Title
+	if feature.Knowledge != nil && feature.Knowledge.EntityType != "" {
+		heading += " (" + feature.Knowledge.EntityType + ")"
+	}
+	return heading
+}
+
+func knowledgeProfileLabel(profile KnowledgeProfile) string {
+	if profile.Network == "" {
+		return profile.URL
+	}
+	return profile.Network + " " + profile.URL
+}
+
+// featureItemIndent nests tree items (paa_depth) under their parent.
+func featureItemIndent(item FeatureItem) string {
+	if item.Depth <= 1 {
+		return ""
+	}
+	return strings.Repeat("  ", item.Depth-1)
+}
+
 func writeNDJSONLine(b *strings.Builder, kind string, value any) {
 	data, err := json.Marshal(value)
 	if err != nil {
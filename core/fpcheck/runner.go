@@ -119,9 +119,9 @@ func saveScreenshot(page *rod.Page, path string) error {
 		return fmt.Errorf("create screenshot directory: %w", err)
 	}
 
-	bytes, err := page.Screenshot(true, nil)
+	bytes, err := CaptureScreenshot(page, true)
 	if err != nil {
-		return fmt.Errorf("capture screenshot: %w", err)
+		return err
 	}
 	if err := os.WriteFile(path, bytes, 0o644); err != nil {
 		return fmt.Errorf("write screenshot file %s: %w", path, err)
@@ -129,6 +129,19 @@ func saveScreenshot(page *rod.Page, path string) error {
 	return nil
 }
 
+// CaptureScreenshot returns a PNG of page. fullPage captures the whole
+// scrollable document; otherwise only the current viewport is captured.
+func CaptureScreenshot(page *rod.Page, fullPage bool) ([]byte, error) {
+	if page == nil {
+		return nil, fmt.Errorf("capture screenshot: nil page")
+	}
+	bytes, err := page.Screenshot(fullPage, nil)
+	if err != nil {
+		return nil, fmt.Errorf("capture screenshot: %w", err)
+	}
+	return bytes, nil
+}
+
 type pageCloser interface {
 	ClosePage(context.Context, *rod.Page, time.Duration) error
 }
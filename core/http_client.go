@@ -117,6 +117,37 @@ func RawSearchRequest(ctx context.Context, searchURL string, query Query) (*http
 	return doRawRequest(ctx, client, searchURL, profile, query)
 }
 
+// RawFormRequest executes a raw-mode form POST with the same client, profile
+// headers and proxy as RawSearchRequest, for engines whose SERP is only
+// reachable by submitting their search form.
+func RawFormRequest(ctx context.Context, searchURL string, form url.Values, query Query) (*http.Response, error) {
+	profile := rawRequestProfileFor(ctx, query)
+	client, err := cachedRawHTTPClient(query, profile.cacheKey(), profile.tlsProfile)
+	if err != nil {
+		return nil, err
+	}
+	SetBrowserProfileID(ctx, profile.id)
+
+	if query.GuardPrivateNetworks {
+		if err := ValidatePublicHTTPURL(ctx, searchURL); err != nil {
+			return nil, err
+		}
+	}
+	req, err := fhttp.NewRequestWithContext(ctx, fhttp.MethodPost, searchURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	applyRawRequestHeaders(req, profile)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// A form submit is same-origin, unlike the typed-URL navigation the
+	// profile headers describe.
+	req.Header.Set("Sec-Fetch-Site", "same-origin")
+	if origin, err := url.Parse(searchURL); err == nil {
+		req.Header.Set("Origin", origin.Scheme+"://"+origin.Host)
+	}
+	return execRawRequest(ctx, client, req, rawRequestUsesProxy(query))
+}
+
 // doRawRequest issues one GET and converts the response at the boundary.
 func doRawRequest(ctx context.Context, client tlsclient.HttpClient, searchURL string, profile rawRequestProfile, query Query) (*http.Response, error) {
 	req, err := fhttp.NewRequestWithContext(ctx, fhttp.MethodGet, searchURL, nil)
@@ -196,7 +227,20 @@ func resolveRedirectURL(base, location string) (string, error) {
 	return baseURL.ResolveReference(locURL).String(), nil
 }
 
+// ReadRawSearchBody reads a raw SERP response after classifying its HTTP
+// status, and archives the body as received when the request is archiving.
 func ReadRawSearchBody(resp *http.Response) ([]byte, error) {
+	body, err := ReadRawResponseBody(resp)
+	if err == nil && resp.Request != nil {
+		ArchiveHTML(resp.Request.Context(), resp.Request.URL.String(), resp.Header.Get("Content-Type"), body)
+	}
+	return body, err
+}
+
+// ReadRawResponseBody is ReadRawSearchBody for pages that are not SERPs,
+// such as the homepage an engine's form token is read from; it never
+// archives.
+func ReadRawResponseBody(resp *http.Response) ([]byte, error) {
 	if resp == nil {
 		return nil, fmt.Errorf("%w: nil raw search response", ErrEngineInternal)
 	}
@@ -346,6 +390,11 @@ func convertRawResponse(ctx context.Context, resp *fhttp.Response) *http.Respons
 		std.Body = io.NopCloser(bytes.NewReader(nil))
 	}
 	std.Body = networkUsageReadCloser{ReadCloser: std.Body, ctx: ctx}
+	// Carry the request context and URL so readers such as ReadRawSearchBody
+	// can attribute the body to the request.
+	if resp.Request != nil && resp.Request.URL != nil {
+		std.Request = (&http.Request{Method: resp.Request.Method, URL: resp.Request.URL}).WithContext(ctx)
+	}
 	return std
 }
 
@@ -378,7 +427,8 @@ func (rt rawTLSRoundTripper) RoundTrip(req *http.Request) (*http.Response, error
 func rawRequestProfileFor(ctx context.Context, query Query) rawRequestProfile {
 	engine := engineFromContext(ctx)
 	region := rawProfileRegion(ctx, query)
-	salt := rawProfileSalt(ctx, engine, region)
+	mobile := mobileBrowserProfileFromContext(ctx)
+	salt := deviceLaneKey(rawProfileSalt(ctx, engine, region), mobile)
 
 	profile := browserprofile.Profile{}
 	if forcedID := forcedProfileIDFromContext(ctx); forcedID != "" {
@@ -387,7 +437,7 @@ func rawRequestProfileFor(ctx context.Context, query Query) rawRequestProfile {
 		}
 	}
 	if strings.TrimSpace(profile.ID) == "" {
-		profile = browserprofile.SelectProfileForSession(engine, region, salt)
+		profile = browserprofile.SelectDeviceProfileForSession(engine, region, salt, mobile)
 	}
 	profile = applyProfileLanguageHint(profile, region)
 
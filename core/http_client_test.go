@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -238,6 +239,46 @@ func TestRawSearchRequestReusesPooledClient(t *testing.T) {
 	}
 }
 
+func TestReadRawSearchBodyArchivesDocument(t *testing.T) {
+	resetRawHTTPClientCache(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html>serp</html>"))
+	}))
+	defer server.Close()
+
+	ctx := WithPageArchive(WithEngine(context.Background(), "ecosia"), Query{})
+	resp, err := RawSearchRequest(ctx, server.URL+"/search?q=x", Query{})
+	if err != nil {
+		t.Fatalf("raw search request: %v", err)
+	}
+	defer DrainAndCloseResponse(resp)
+	if _, err := ReadRawSearchBody(resp); err != nil {
+		t.Fatalf("read raw search body: %v", err)
+	}
+
+	pages := ArchivedPagesFromContext(ctx)
+	if len(pages) != 1 || pages[0].Engine != "ecosia" || string(pages[0].Body) != "<html>serp</html>" || pages[0].URL != server.URL+"/search?q=x" {
+		t.Fatalf("unexpected archived pages: %+v", pages)
+	}
+	if !strings.HasPrefix(pages[0].ContentType, "text/html") {
+		t.Fatalf("archived page must keep the response Content-Type, got %q", pages[0].ContentType)
+	}
+
+	// Non-SERP pages, such as a homepage read for a form token, stay out.
+	resp, err = RawSearchRequest(ctx, server.URL+"/", Query{})
+	if err != nil {
+		t.Fatalf("raw homepage request: %v", err)
+	}
+	defer DrainAndCloseResponse(resp)
+	if _, err := ReadRawResponseBody(resp); err != nil {
+		t.Fatalf("read raw response body: %v", err)
+	}
+	if pages := ArchivedPagesFromContext(ctx); len(pages) != 1 {
+		t.Fatalf("ReadRawResponseBody must not archive, got %d pages", len(pages))
+	}
+}
+
 // TestRawRequestProfilesRoundRobinCoherently checks rotation hits every Chrome
 // major and keeps UA major == Sec-CH-UA major == TLS fingerprint.
 func TestRawRequestProfilesRoundRobinCoherently(t *testing.T) {
@@ -304,3 +345,43 @@ func resetRawHTTPClientCache(t *testing.T) {
 		entry.client.CloseIdleConnections()
 	}
 }
+
+func TestRawFormRequestPostsEncodedForm(t *testing.T) {
+	resetRawHTTPClientCache(t)
+
+	var method, contentType, origin, sc, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		origin = r.Header.Get("Origin")
+		userAgent = r.Header.Get("User-Agent")
+		if err := r.ParseForm(); err == nil {
+			sc = r.PostForm.Get("sc")
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	ctx := WithEngine(WithBrowserProfileUsage(context.Background()), "startpage")
+	resp, err := RawFormRequest(ctx, server.URL+"/sp/search", url.Values{"query": {"golang"}, "sc": {"token"}}, Query{})
+	if err != nil {
+		t.Fatalf("RawFormRequest() error = %v", err)
+	}
+	DrainAndCloseResponse(resp)
+
+	if method != http.MethodPost {
+		t.Fatalf("method = %q, want POST", method)
+	}
+	if contentType != "application/x-www-form-urlencoded" {
+		t.Fatalf("Content-Type = %q", contentType)
+	}
+	if origin != server.URL {
+		t.Fatalf("Origin = %q, want %q", origin, server.URL)
+	}
+	if sc != "token" {
+		t.Fatalf("expected sc form value to round-trip, got %q", sc)
+	}
+	if userAgent == "" || strings.Contains(userAgent, "Go-http-client") {
+		t.Fatalf("unexpected User-Agent %q", userAgent)
+	}
+}
@@ -0,0 +1,237 @@
+package core
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// KnowledgeMeta is the structured content of a knowledge panel: the entity
+// card engines show beside the results for people, places, organisations and
+// works. It rides on the knowledge_panel SerpFeature, whose Title is the
+// entity name and Text its description.
+type KnowledgeMeta struct {
+	// EntityType is the subtitle under the name, for example "Technology
+	// company" or "American singer".
+	EntityType string `json:"entity_type,omitempty"`
+	// DescriptionSource names where the description was taken from, usually
+	// "Wikipedia"; DescriptionURL links it.
+	DescriptionSource string               `json:"description_source,omitempty"`
+	DescriptionURL    string               `json:"description_url,omitempty"`
+	Attributes        []KnowledgeAttribute `json:"attributes,omitempty"`
+	Images            []string             `json:"images,omitempty"`
+	// Website is the entity's official site.
+	Website  string             `json:"website,omitempty"`
+	Profiles []KnowledgeProfile `json:"profiles,omitempty"`
+}
+
+// KnowledgeAttribute is one row of a knowledge panel's fact table, for
+// example {"Founded", "September 4, 1998"}. Label is as shown, without the
+// trailing colon.
+type KnowledgeAttribute struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// KnowledgeProfile is a social or external profile linked from a panel.
+// Network is derived from the link host ("Twitter", "LinkedIn", ...) and
+// falls back to the link text for unknown hosts.
+type KnowledgeProfile struct {
+	Network string `json:"network,omitempty"`
+	URL     string `json:"url"`
+}
+
+// KnowledgePanelSelectors locates the parts of a knowledge panel. Attribute
+// matches one fact row; Label and Value are looked up inside it. When Value
+// is empty or matches nothing, the value is the row text after the label,
+// and when Label matches nothing the row is split at its first colon.
+type KnowledgePanelSelectors struct {
+	Container   string
+	Title       string
+	EntityType  string
+	Description string
+	// Source is the description's attribution link.
+	Source    string
+	Attribute string
+	Label     string
+	Value     string
+	Image     string
+	Website   string
+	Profile   string
+}
+
+// knowledgeProfileNetworks maps profile hosts to network names.
+var knowledgeProfileNetworks = map[string]string{
+	"twitter.com":   "Twitter",
+	"x.com":         "X",
+	"facebook.com":  "Facebook",
+	"instagram.com": "Instagram",
+	"linkedin.com":  "LinkedIn",
+	"youtube.com":   "YouTube",
+	"tiktok.com":    "TikTok",
+	"pinterest.com": "Pinterest",
+	"github.com":    "GitHub",
+	"vk.com":        "VK",
+	"ok.ru":         "Odnoklassniki",
+	"t.me":          "Telegram",
+	"weibo.com":     "Weibo",
+}
+
+// ExtractKnowledgePanel turns the first knowledge panel matched by
+// sel.Container into a knowledge_panel SerpFeature. resolve turns raw hrefs
+// and image sources into absolute URLs and may return "" to drop one. A
+// panel without a name is ignored.
+func ExtractKnowledgePanel(doc *goquery.Document, sel KnowledgePanelSelectors, resolve func(string) string) []SerpFeature {
+	var features []SerpFeature
+	doc.Find(sel.Container).EachWithBreak(func(_ int, panel *goquery.Selection) bool {
+		feature, ok := ParseKnowledgePanel(panel, sel, resolve)
+		if !ok {
+			return true
+		}
+		features = append(features, feature)
+		return false
+	})
+	return features
+}
+
+// ParseKnowledgePanel reads one panel container. ok is false when the panel
+// has no name.
+func ParseKnowledgePanel(panel *goquery.Selection, sel KnowledgePanelSelectors, resolve func(string) string) (SerpFeature, bool) {
+	title := knowledgeText(panel, sel.Title)
+	if title == "" {
+		return SerpFeature{}, false
+	}
+
+	meta := &KnowledgeMeta{EntityType: knowledgeText(panel, sel.EntityType)}
+	description := knowledgeText(panel, sel.Description)
+	if sel.Source != "" {
+		source := panel.Find(sel.Source).First()
+		meta.DescriptionSource = NormalizeWhitespace(source.Text())
+		meta.DescriptionURL = knowledgeHref(source.AttrOr("href", ""), resolve)
+		// The attribution link usually sits inside the description node.
+		if meta.DescriptionSource != "" {
+			description = strings.TrimSpace(strings.TrimSuffix(description, meta.DescriptionSource))
+		}
+	}
+
+	if sel.Attribute != "" {
+		panel.Find(sel.Attribute).Each(func(_ int, row *goquery.Selection) {
+			if attribute, ok := parseKnowledgeAttribute(row, sel); ok {
+				meta.Attributes = append(meta.Attributes, attribute)
+			}
+		})
+	}
+	if sel.Image != "" {
+		seen := map[string]bool{}
+		panel.Find(sel.Image).Each(func(_ int, img *goquery.Selection) {
+			src := knowledgeHref(firstAttr(img, "data-src", "src"), resolve)
+			if src != "" && !seen[src] {
+				seen[src] = true
+				meta.Images = append(meta.Images, src)
+			}
+		})
+	}
+	if sel.Website != "" {
+		meta.Website = knowledgeHref(panel.Find(sel.Website).First().AttrOr("href", ""), resolve)
+	}
+	if sel.Profile != "" {
+		seen := map[string]bool{}
+		panel.Find(sel.Profile).Each(func(_ int, link *goquery.Selection) {
+			href := knowledgeHref(link.AttrOr("href", ""), resolve)
+			if href == "" || seen[href] {
+				return
+			}
+			seen[href] = true
+			meta.Profiles = append(meta.Profiles, KnowledgeProfile{
+				Network: KnowledgeProfileNetwork(href, firstNonEmpty(NormalizeWhitespace(link.Text()), link.AttrOr("aria-label", ""))),
+				URL:     href,
+			})
+		})
+	}
+
+	feature := SerpFeature{
+		Type:       ResultTypeKnowledgePanel,
+		Title:      title,
+		Text:       description,
+		Knowledge:  meta,
+		Confidence: 0.8,
+		Layout:     SelectionLayout(panel),
+	}
+	if meta.DescriptionURL != "" {
+		feature.Links = []FeatureLink{{Title: meta.DescriptionSource, URL: meta.DescriptionURL}}
+	}
+	return feature, true
+}
+
+func parseKnowledgeAttribute(row *goquery.Selection, sel KnowledgePanelSelectors) (KnowledgeAttribute, bool) {
+	rowText := NormalizeWhitespace(row.Text())
+	label := ""
+	if sel.Label != "" {
+		label = NormalizeWhitespace(row.Find(sel.Label).First().Text())
+	}
+	value := ""
+	if sel.Value != "" {
+		value = NormalizeWhitespace(row.Find(sel.Value).First().Text())
+	}
+	switch {
+	case label == "":
+		var ok bool
+		label, value, ok = cutKnowledgeLabel(rowText)
+		if !ok {
+			return KnowledgeAttribute{}, false
+		}
+	case value == "":
+		value = strings.TrimSpace(strings.TrimPrefix(rowText, label))
+	}
+	label = strings.TrimSpace(strings.TrimRight(label, ":： "))
+	value = strings.TrimSpace(strings.TrimLeft(value, ":： "))
+	if label == "" || value == "" {
+		return KnowledgeAttribute{}, false
+	}
+	return KnowledgeAttribute{Label: label, Value: value}, true
+}
+
+// cutKnowledgeLabel splits "Founded: September 4, 1998" at the first ASCII
+// or full-width colon.
+func cutKnowledgeLabel(text string) (label, value string, ok bool) {
+	index := strings.IndexAny(text, ":：")
+	if index <= 0 {
+		return "", "", false
+	}
+	_, size := utf8.DecodeRuneInString(text[index:])
+	return text[:index], text[index+size:], true
+}
+
+// KnowledgeProfileNetwork names the network of a profile URL, falling back
+// to label for hosts it does not know.
+func KnowledgeProfileNetwork(profileURL, label string) string {
+	parsed, err := url.Parse(profileURL)
+	if err == nil {
+		host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+		host = strings.TrimPrefix(host, "m.")
+		if network, ok := knowledgeProfileNetworks[host]; ok {
+			return network
+		}
+	}
+	return NormalizeWhitespace(label)
+}
+
+func knowledgeText(panel *goquery.Selection, selector string) string {
+	if selector == "" {
+		return ""
+	}
+	return NormalizeWhitespace(panel.Find(selector).First().Text())
+}
+
+func knowledgeHref(href string, resolve func(string) string) string {
+	href = strings.TrimSpace(href)
+	if href != "" && resolve != nil {
+		href = resolve(href)
+	}
+	if !strings.HasPrefix(href, "http") {
+		return ""
+	}
+	return href
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestExtractKnowledgePanel(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`
+<div class="panel"><p>No name here</p></div>
+<div class="panel">
+  <h2>百度</h2>
+  <div class="type">互联网公司</div>
+  <p class="desc">百度是拥有强大互联网基础的领先AI公司。 <a href="https://baike.baidu.com/item/baidu">百度百科</a></p>
+  <ul>
+    <li>创始人：李彦宏</li>
+    <li><b>Founded</b> January 18, 2000</li>
+    <li>no separator</li>
+  </ul>
+  <img src="/logo.png"><img src="/logo.png">
+  <a class="site" href="https://www.baidu.com/">baidu.com</a>
+  <div class="social"><a href="https://weibo.com/baidu">微博</a><a href="https://example.com/baidu">Example</a><a href="mailto:ir@baidu.com">Mail</a></div>
+</div>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	sel := KnowledgePanelSelectors{
+		Container: "div.panel", Title: "h2", EntityType: ".type", Description: "p.desc", Source: "p.desc a",
+		Attribute: "li", Label: "b", Image: "img", Website: "a.site", Profile: ".social a",
+	}
+	features := ExtractKnowledgePanel(doc, sel, func(href string) string {
+		if strings.HasPrefix(href, "/") {
+			return "https://www.example.com" + href
+		}
+		return href
+	})
+	if len(features) != 1 {
+		t.Fatalf("expected the first named panel only, got %+v", features)
+	}
+	panel := features[0]
+	kp := panel.Knowledge
+	if panel.Type != ResultTypeKnowledgePanel || panel.Title != "百度" || panel.Text != "百度是拥有强大互联网基础的领先AI公司。" || kp.EntityType != "互联网公司" {
+		t.Fatalf("unexpected panel: %+v %+v", panel, kp)
+	}
+	want := []KnowledgeAttribute{{Label: "创始人", Value: "李彦宏"}, {Label: "Founded", Value: "January 18, 2000"}}
+	if len(kp.Attributes) != 2 || kp.Attributes[0] != want[0] || kp.Attributes[1] != want[1] {
+		t.Fatalf("unexpected attributes: %+v", kp.Attributes)
+	}
+	if len(kp.Images) != 1 || kp.Images[0] != "https://www.example.com/logo.png" {
+		t.Fatalf("expected one deduplicated absolute image, got %v", kp.Images)
+	}
+	if len(kp.Profiles) != 2 || kp.Profiles[0].Network != "Weibo" || kp.Profiles[1].Network != "Example" {
+		t.Fatalf("unexpected profiles: %+v", kp.Profiles)
+	}
+}
+
+func TestKnowledgeProfileNetwork(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"https://x.com/openai":                "X",
+		"https://m.facebook.com/openai":       "Facebook",
+		"https://www.instagram.com/openai/":   "Instagram",
+		"https://mastodon.social/@openserp":   "Mastodon",
+		"https://www.youtube.com/@openai/...": "YouTube",
+	}
+	for profileURL, want := range cases {
+		if got := KnowledgeProfileNetwork(profileURL, " Mastodon "); got != want {
+			t.Fatalf("KnowledgeProfileNetwork(%q) = %q, want %q", profileURL, got, want)
+		}
+	}
+}
+
+func TestRenderersIncludeKnowledgePanel(t *testing.T) {
+	env := NewEnvelope(Query{Text: "openai"}, "req-1", time.Unix(0, 0), []string{"google"})
+	env.SerpFeatures = append(env.SerpFeatures, EnrichSerpFeature(SerpFeature{
+		Type:  ResultTypeKnowledgePanel,
+		Title: "OpenAI",
+		Text:  "AI research organization.",
+		Knowledge: &KnowledgeMeta{
+			EntityType: "Artificial intelligence company",
+			Attributes: []KnowledgeAttribute{{Label: "CEO", Value: "Sam Altman"}},
+			Website:    "https://openai.com/?utm_source=kp",
+			Profiles:   []KnowledgeProfile{{Network: "LinkedIn", URL: "https://www.linkedin.com/company/openai"}},
+		},
+	}, "google", "", time.Unix(0, 0)))
+
+	if got := env.SerpFeatures[0].Knowledge.Website; got != "https://openai.com/" {
+		t.Fatalf("expected normalized website, got %q", got)
+	}
+	text := string(RenderText(env))
+	for _, want := range []string{"Knowledge panel - OpenAI (Artificial intelligence company)", "- CEO: Sam Altman", "Profile: LinkedIn https://www.linkedin.com/company/openai"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("text missing %q:\n%s", want, text)
+		}
+	}
+	markdown := string(RenderMarkdown(env))
+	for _, want := range []string{"## Knowledge panel - OpenAI (Artificial intelligence company)", "- **CEO:** Sam Altman", "Profiles: [LinkedIn](https://www.linkedin.com/company/openai)"} {
+		if !strings.Contains(markdown, want) {
+			t.Fatalf("markdown missing %q:\n%s", want, markdown)
+		}
+	}
+}
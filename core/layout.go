@@ -0,0 +1,273 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-rod/rod"
+)
+
+// layoutBoxAttr and layoutViewportAttr hold the boxes StampLayout writes
+// into the live DOM. Stamping the page rather than measuring each element
+// lets the rod-based result parsers and the goquery feature extractors,
+// which read a snapshot, share one measurement pass. Only result and feature
+// containers are stamped.
+const (
+	layoutBoxAttr      = "data-openserp-box"
+	layoutViewportAttr = "data-openserp-viewport"
+)
+
+// LayoutBox is where a result or feature was drawn on a browser-rendered
+// SERP, in CSS pixels from the top-left of the document. AboveFold is true
+// when the box starts inside the first viewport.
+type LayoutBox struct {
+	Top       int
+	Left      int
+	Width     int
+	Height    int
+	AboveFold bool
+}
+
+// SerpLayout summarises a browser-rendered SERP. The viewport is the one the
+// browser profile emulates; the counts are filled by Envelope.Finalize from
+// the positioned results and features.
+type SerpLayout struct {
+	ViewportWidth  int `json:"viewport_width"`
+	ViewportHeight int `json:"viewport_height"`
+	PageHeight     int `json:"page_height"`
+	// FirstOrganicTop is the pixel top of the first organic result, which
+	// shows how far ads and features push it down.
+	FirstOrganicTop   int `json:"first_organic_top,omitempty"`
+	OrganicAboveFold  int `json:"organic_above_fold"`
+	AdsAboveFold      int `json:"ads_above_fold"`
+	FeaturesAboveFold int `json:"features_above_fold"`
+	// FeatureHeight is the vertical space all SERP features take;
+	// FeatureHeightAboveFold the part of it inside the first viewport.
+	FeatureHeight          int `json:"feature_height"`
+	FeatureHeightAboveFold int `json:"feature_height_above_fold"`
+}
+
+// stampLayoutScript records the document-relative box of every visible
+// element matching one of the container selectors, and the viewport and page
+// size on <html>. Selectors are tried one by one so a selector the browser
+// rejects skips only itself. The list is kept on window for restampLayout.
+// Tops add scrollY because feature hydration scrolls the page before
+// snapshotting.
+const stampLayoutScript = `(containers) => {
+	const boxAttr = "` + layoutBoxAttr + `";
+	const root = document.documentElement;
+	const vw = window.innerWidth, vh = window.innerHeight;
+	const sx = window.scrollX || 0, sy = window.scrollY || 0;
+	window.__openserpLayoutContainers = containers;
+	for (const selector of containers) {
+		let matches = [];
+		try { matches = document.querySelectorAll(selector); } catch (e) { continue; }
+		for (const el of matches) {
+			const r = el.getBoundingClientRect();
+			if (r.width < 1 || r.height < 1) {
+				el.removeAttribute(boxAttr);
+				continue;
+			}
+			const top = Math.round(r.top + sy);
+			el.setAttribute(boxAttr, [top, Math.round(r.left + sx), Math.round(r.width), Math.round(r.height), top < vh ? 1 : 0].join(","));
+		}
+	}
+	const ph = Math.max(root.scrollHeight, document.body ? document.body.scrollHeight : 0);
+	root.setAttribute("` + layoutViewportAttr + `", [vw, vh, ph].join(","));
+	return {viewport_width: vw, viewport_height: vh, page_height: ph};
+}`
+
+// StampLayout measures the elements matching containers, the engine's result
+// and feature containers, for ElementLayout and SelectionLayout and returns
+// the page-level part of the SERP layout. Engines call it only for
+// layout=true, so other requests leave the DOM untouched. It returns nil
+// when the page cannot be evaluated; layout is best-effort and never fails a
+// search.
+func StampLayout(page *rod.Page, containers ...string) *SerpLayout {
+	if page == nil || len(containers) == 0 {
+		return nil
+	}
+	res, err := page.Eval(stampLayoutScript, containers)
+	if err != nil || res == nil {
+		return nil
+	}
+	return &SerpLayout{
+		ViewportWidth:  res.Value.Get("viewport_width").Int(),
+		ViewportHeight: res.Value.Get("viewport_height").Int(),
+		PageHeight:     res.Value.Get("page_height").Int(),
+	}
+}
+
+// LayoutContainers collects the selectors StampLayout measures for an
+// engine: its result selectors and the Container selectors of its feature
+// specs. Empty selectors are dropped.
+func LayoutContainers(results []string, features ...[]SerpFeatureSelector) []string {
+	var containers []string
+	for _, selector := range results {
+		if selector = strings.TrimSpace(selector); selector != "" {
+			containers = append(containers, selector)
+		}
+	}
+	for _, specs := range features {
+		for _, spec := range specs {
+			containers = append(containers, spec.Container...)
+		}
+	}
+	return containers
+}
+
+// WithSerpLayout sets layout on meta, creating meta when the page printed no
+// other SERP information.
+func WithSerpLayout(meta *SerpMeta, layout *SerpLayout) *SerpMeta {
+	if layout == nil {
+		return meta
+	}
+	if meta == nil {
+		meta = &SerpMeta{}
+	}
+	meta.Layout = layout
+	return meta
+}
+
+// restampLayout re-measures a page StampLayout has already measured, with
+// the same containers, so features that hydrated after the results were
+// parsed get boxes too. Pages that were never stamped are left alone.
+func restampLayout(page *rod.Page) {
+	if page == nil {
+		return
+	}
+	res, err := page.Eval(`() => window.__openserpLayoutContainers || []`)
+	if err != nil || res == nil {
+		return
+	}
+	var containers []string
+	for _, container := range res.Value.Arr() {
+		containers = append(containers, container.Str())
+	}
+	StampLayout(page, containers...)
+}
+
+// ElementLayout returns the box StampLayout recorded for el, or nil when el
+// was not measured (the page was not stamped, or el was hidden).
+func ElementLayout(el *rod.Element) *LayoutBox {
+	if el == nil {
+		return nil
+	}
+	value, err := el.Attribute(layoutBoxAttr)
+	if err != nil || value == nil {
+		return nil
+	}
+	return parseLayoutBox(*value)
+}
+
+// SelectionLayout returns the box StampLayout recorded for the first node of
+// sel, or for its nearest measured ancestor when the node itself was not
+// measured (display: contents wrappers). It is nil for documents that did
+// not come from a stamped page, which keeps raw and parse modes free of
+// layout data.
+func SelectionLayout(sel *goquery.Selection) *LayoutBox {
+	if sel == nil || sel.Length() == 0 {
+		return nil
+	}
+	node := sel.First()
+	if value, ok := node.Attr(layoutBoxAttr); ok {
+		return parseLayoutBox(value)
+	}
+	if value, ok := node.Closest("[" + layoutBoxAttr + "]").Attr(layoutBoxAttr); ok {
+		return parseLayoutBox(value)
+	}
+	return nil
+}
+
+// parseLayoutBox reads a "top,left,width,height,fold" stamp.
+func parseLayoutBox(value string) *LayoutBox {
+	parts := strings.Split(value, ",")
+	if len(parts) != 5 {
+		return nil
+	}
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil
+		}
+		numbers[i] = number
+	}
+	return &LayoutBox{
+		Top:       numbers[0],
+		Left:      numbers[1],
+		Width:     numbers[2],
+		Height:    numbers[3],
+		AboveFold: numbers[4] == 1,
+	}
+}
+
+// withLayout copies box onto position, creating the position when the
+// result or feature has no rank.
+func withLayout(position *Position, box *LayoutBox) *Position {
+	if box == nil {
+		return position
+	}
+	if position == nil {
+		position = &Position{}
+	} else {
+		copied := *position
+		position = &copied
+	}
+	top, aboveFold := box.Top, box.AboveFold
+	position.PixelTop = &top
+	position.PixelHeight = box.Height
+	position.PixelWidth = box.Width
+	position.AboveFold = &aboveFold
+	return position
+}
+
+// summarizeLayout fills the per-engine counts of every SerpLayout from the
+// positioned results and features. It is recomputed from scratch so repeated
+// Finalize calls do not double count.
+func (e *Envelope) summarizeLayout() {
+	for engine, meta := range e.SerpMeta {
+		if meta == nil || meta.Layout == nil {
+			continue
+		}
+		layout := meta.Layout
+		*layout = SerpLayout{
+			ViewportWidth:  layout.ViewportWidth,
+			ViewportHeight: layout.ViewportHeight,
+			PageHeight:     layout.PageHeight,
+		}
+		for _, result := range e.Results {
+			position := result.Position
+			if result.Engine != engine || position == nil || position.PixelTop == nil {
+				continue
+			}
+			aboveFold := position.AboveFold != nil && *position.AboveFold
+			switch result.Type {
+			case ResultTypeOrganic:
+				if layout.FirstOrganicTop == 0 || *position.PixelTop < layout.FirstOrganicTop {
+					layout.FirstOrganicTop = *position.PixelTop
+				}
+				if aboveFold {
+					layout.OrganicAboveFold++
+				}
+			case ResultTypeAd:
+				if aboveFold {
+					layout.AdsAboveFold++
+				}
+			}
+		}
+		for _, feature := range e.SerpFeatures {
+			position := feature.Position
+			if feature.Engine != engine || position == nil || position.PixelTop == nil {
+				continue
+			}
+			layout.FeatureHeight += position.PixelHeight
+			if position.AboveFold != nil && *position.AboveFold {
+				layout.FeaturesAboveFold++
+				bottom := min(*position.PixelTop+position.PixelHeight, layout.ViewportHeight)
+				layout.FeatureHeightAboveFold += max(bottom-*position.PixelTop, 0)
+			}
+		}
+	}
+}
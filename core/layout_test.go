@@ -0,0 +1,164 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestParseLayoutBox(t *testing.T) {
+	t.Parallel()
+
+	box := parseLayoutBox("1240,180,652,118,0")
+	if box == nil || *box != (LayoutBox{Top: 1240, Left: 180, Width: 652, Height: 118}) {
+		t.Fatalf("unexpected box: %+v", box)
+	}
+	for _, bad := range []string{"", "1,2,3,4", "1,2,x,4,1"} {
+		if box := parseLayoutBox(bad); box != nil {
+			t.Fatalf("parseLayoutBox(%q) = %+v, want nil", bad, box)
+		}
+	}
+}
+
+func TestSelectionLayoutReadsStampOrAncestor(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`
+<div id="stamped" data-openserp-box="320,16,600,240,1"><span id="inline">Inline</span></div>
+<div id="raw"><p>No stamp</p></div>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+
+	box := SelectionLayout(doc.Find("#inline"))
+	if box == nil || box.Top != 320 || box.Height != 240 || !box.AboveFold {
+		t.Fatalf("expected the ancestor's box, got %+v", box)
+	}
+	if box := SelectionLayout(doc.Find("#raw p")); box != nil {
+		t.Fatalf("unstamped documents must have no layout, got %+v", box)
+	}
+	if box := SelectionLayout(doc.Find("#missing")); box != nil {
+		t.Fatalf("empty selection must have no layout, got %+v", box)
+	}
+}
+
+func TestInitFromContextLayout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantLayout bool
+	}{
+		{"?text=q", http.StatusOK, false},
+		{"?text=q&layout=true", http.StatusOK, true},
+		{"?text=q&layout=1", http.StatusOK, true},
+		{"?text=q&layout=boxes", http.StatusBadRequest, false},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Layout", strconv.FormatBool(q.Layout))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil), -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && resp.Header.Get("X-Layout") != strconv.FormatBool(tt.wantLayout) {
+			t.Fatalf("%s: Layout = %s, want %t", tt.query, resp.Header.Get("X-Layout"), tt.wantLayout)
+		}
+	}
+}
+
+func TestLayoutContainersCollectsResultsAndFeatures(t *testing.T) {
+	t.Parallel()
+
+	got := LayoutContainers([]string{" li.result ", "", "li.ad"},
+		[]SerpFeatureSelector{{Container: []string{"#answer", ".answer"}}},
+		[]SerpFeatureSelector{{Container: []string{"#related"}}},
+	)
+	want := []string{"li.result", "li.ad", "#answer", ".answer", "#related"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("LayoutContainers = %q, want %q", got, want)
+	}
+	if got := StampLayout(nil, want...); got != nil {
+		t.Fatalf("StampLayout(nil) = %+v, want nil", got)
+	}
+}
+
+func TestEnrichResultCopiesLayoutIntoPosition(t *testing.T) {
+	t.Parallel()
+
+	result := EnrichResult(SearchResult{
+		Rank:         1,
+		AbsoluteRank: 3,
+		URL:          "https://example.com/",
+		Title:        "Example",
+		Layout:       &LayoutBox{Top: 910, Width: 652, Height: 120},
+	}, EnrichContext{Engine: "google", Query: Query{Limit: 10}})
+
+	position := result.Position
+	if position == nil || position.Absolute != 3 || position.PixelTop == nil || *position.PixelTop != 910 {
+		t.Fatalf("unexpected position: %+v", position)
+	}
+	if position.PixelHeight != 120 || position.PixelWidth != 652 || position.AboveFold == nil || *position.AboveFold {
+		t.Fatalf("unexpected pixel fields: %+v", position)
+	}
+
+	raw := EnrichResult(SearchResult{Rank: 1, URL: "https://example.com/", Title: "Example"}, EnrichContext{Engine: "google", Query: Query{Limit: 10}})
+	if raw.Position != nil && (raw.Position.PixelTop != nil || raw.Position.AboveFold != nil) {
+		t.Fatalf("results without layout must leave pixel fields empty: %+v", raw.Position)
+	}
+}
+
+func TestFinalizeSummarizesLayout(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	env := NewEnvelope(Query{Text: "openserp", Limit: 10}, "req", startedAt, []string{"google", "bing"})
+	env.AddSerpMeta("google", &SerpMeta{Layout: &SerpLayout{ViewportWidth: 1366, ViewportHeight: 768, PageHeight: 4200}})
+	for _, raw := range []SearchResult{
+		{Rank: 1, AbsoluteRank: 1, Ad: true, URL: "https://ads.example.com/", Title: "Ad", Layout: &LayoutBox{Top: 150, Height: 100, AboveFold: true}},
+		{Rank: 1, AbsoluteRank: 2, URL: "https://one.example.com/", Title: "One", Layout: &LayoutBox{Top: 700, Height: 120, AboveFold: true}},
+		{Rank: 2, AbsoluteRank: 3, URL: "https://two.example.com/", Title: "Two", Layout: &LayoutBox{Top: 900, Height: 120}},
+	} {
+		env.Results = append(env.Results, EnrichResult(raw, EnrichContext{Engine: "google", Query: Query{Limit: 10}}))
+	}
+	env.Results = append(env.Results, EnrichResult(SearchResult{Rank: 1, URL: "https://bing.example.com/", Title: "Bing", Layout: &LayoutBox{Top: 10, AboveFold: true}}, EnrichContext{Engine: "bing", Query: Query{Limit: 10}}))
+	env.SerpFeatures = append(env.SerpFeatures,
+		EnrichSerpFeature(SerpFeature{Type: ResultTypeAnswerBox, Title: "Answer", Layout: &LayoutBox{Top: 300, Height: 380, AboveFold: true}}, "google", "", startedAt),
+		EnrichSerpFeature(SerpFeature{Type: ResultTypePeopleAlsoAsk, Title: "PAA", Layout: &LayoutBox{Top: 1100, Height: 300}}, "google", "", startedAt),
+	)
+
+	env.Finalize(startedAt, Query{Limit: 10})
+	env.Finalize(startedAt, Query{Limit: 10})
+
+	got := *env.SerpMeta["google"].Layout
+	want := SerpLayout{
+		ViewportWidth: 1366, ViewportHeight: 768, PageHeight: 4200,
+		FirstOrganicTop: 700, OrganicAboveFold: 1, AdsAboveFold: 1, FeaturesAboveFold: 1,
+		FeatureHeight: 680, FeatureHeightAboveFold: 380,
+	}
+	if got != want {
+		t.Fatalf("layout = %+v, want %+v", got, want)
+	}
+	if env.SerpMeta["bing"] != nil {
+		t.Fatalf("engines without a measured page must have no layout summary")
+	}
+}
@@ -0,0 +1,208 @@
+package core
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// LocalMeta is the business listing data a local pack or maps tab shows for
+// each place. It rides on SearchResult for local-tab results and on
+// FeatureItem for local packs on regular result pages.
+type LocalMeta struct {
+	// Position is the 1-based slot in the local pack.
+	Position    int     `json:"position,omitempty"`
+	Rating      float64 `json:"rating,omitempty"`
+	ReviewCount int     `json:"review_count,omitempty"`
+	// Category is the business type, for example "Coffee shop".
+	Category string `json:"category,omitempty"`
+	Address  string `json:"address,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+	// Hours is the opening-hours snippet as shown, for example "Open ⋅
+	// Closes 6 PM".
+	Hours   string `json:"hours,omitempty"`
+	Website string `json:"website,omitempty"`
+	// MapURL links the place on the engine's map.
+	MapURL string `json:"map_url,omitempty"`
+}
+
+// LocalCardSelectors locates the fields of one business card. Details
+// matches the free-form lines under the name ("Coffee shop · 12 Main St");
+// their parts are classified by ApplyLocalDetail. The dedicated selectors win
+// over anything classified from Details.
+type LocalCardSelectors struct {
+	Name     string
+	Rating   string
+	Reviews  string
+	Details  string
+	Category string
+	Address  string
+	Phone    string
+	Hours    string
+	Website  string
+	MapLink  string
+}
+
+var (
+	localPhonePattern  = regexp.MustCompile(`^\+?[\d\s().\-]{7,}$`)
+	localRatingPattern = regexp.MustCompile(`^\d(?:[.,]\d)?\s*\(`)
+	localPricePattern  = regexp.MustCompile(`^[$€£₽¥·\s]+$`)
+	localDigitPattern  = regexp.MustCompile(`\d`)
+	// localDetailSplitter separates the parts of a details line: Google uses
+	// "·", Bing "•" or "|". Google's hours snippet keeps its own "⋅"
+	// ("Open ⋅ Closes 6 PM"), so that one is not a separator.
+	localDetailSplitter = regexp.MustCompile(`\s*[·•|]\s*`)
+)
+
+// localHoursMarkers flag a details part as an opening-hours snippet.
+var localHoursMarkers = []string{
+	"open", "closed", "closes", "opens", "24 hours",
+	"открыто", "закрыто", "откроется", "закроется", "круглосуточно",
+}
+
+// ParseLocalCard reads one business card. resolve turns raw hrefs into
+// absolute URLs and may return "" to drop a link; ok is false when the card
+// has no name.
+func ParseLocalCard(card *goquery.Selection, sel LocalCardSelectors, resolve func(string) string) (name string, meta *LocalMeta, ok bool) {
+	name = NormalizeWhitespace(card.Find(sel.Name).First().Text())
+	if name == "" {
+		return "", nil, false
+	}
+
+	meta = &LocalMeta{}
+	card.Find(sel.Details).Each(func(_ int, line *goquery.Selection) {
+		for _, part := range localDetailSplitter.Split(NormalizeWhitespace(line.Text()), -1) {
+			ApplyLocalDetail(meta, part)
+		}
+	})
+	if sel.Rating != "" {
+		rating := card.Find(sel.Rating).First()
+		if value := ParseRating(firstNonEmpty(rating.AttrOr("aria-label", ""), rating.Text())); value > 0 {
+			meta.Rating = value
+		}
+	}
+	if sel.Reviews != "" {
+		if count := ParseReviewCount(card.Find(sel.Reviews).First().Text()); count > 0 {
+			meta.ReviewCount = count
+		}
+	}
+	for _, field := range []struct {
+		selector string
+		target   *string
+	}{
+		{sel.Category, &meta.Category},
+		{sel.Address, &meta.Address},
+		{sel.Phone, &meta.Phone},
+		{sel.Hours, &meta.Hours},
+	} {
+		if field.selector == "" {
+			continue
+		}
+		if text := NormalizeWhitespace(card.Find(field.selector).First().Text()); text != "" {
+			*field.target = text
+		}
+	}
+	meta.Website = localCardHref(card, sel.Website, resolve)
+	meta.MapURL = localCardHref(card, sel.MapLink, resolve)
+	return name, meta, true
+}
+
+func localCardHref(card *goquery.Selection, selector string, resolve func(string) string) string {
+	if selector == "" {
+		return ""
+	}
+	href := strings.TrimSpace(card.Find(selector).First().AttrOr("href", ""))
+	if href == "" && card.Is(selector) {
+		href = strings.TrimSpace(card.AttrOr("href", ""))
+	}
+	if href != "" && resolve != nil {
+		href = resolve(href)
+	}
+	if !strings.HasPrefix(href, "http") {
+		return ""
+	}
+	return href
+}
+
+// ApplyLocalDetail classifies one part of a details line and fills the first
+// empty LocalMeta field it fits: rating, hours, phone, address (has digits)
+// or category. Hours parts accumulate; price-level badges such as "$$" are
+// dropped.
+func ApplyLocalDetail(meta *LocalMeta, part string) {
+	part = NormalizeWhitespace(part)
+	if part == "" || localPricePattern.MatchString(part) {
+		return
+	}
+	lower := strings.ToLower(part)
+	switch {
+	case localRatingPattern.MatchString(part):
+		if meta.Rating == 0 {
+			meta.Rating = ParseRating(part)
+		}
+		if meta.ReviewCount == 0 {
+			meta.ReviewCount = ParseReviewCount(part[strings.Index(part, "("):])
+		}
+	case containsAny(lower, localHoursMarkers):
+		// "Open · Closes 7 PM" arrives as two parts; keep them together.
+		if meta.Hours == "" {
+			meta.Hours = part
+		} else {
+			meta.Hours += " · " + part
+		}
+	case localPhonePattern.MatchString(part) && len(localDigitPattern.FindAllString(part, -1)) >= 7:
+		if meta.Phone == "" {
+			meta.Phone = part
+		}
+	case localDigitPattern.MatchString(part):
+		if meta.Address == "" {
+			meta.Address = part
+		}
+	case meta.Category == "":
+		meta.Category = part
+	case meta.Address == "":
+		meta.Address = part
+	}
+}
+
+func containsAny(text string, markers []string) bool {
+	for _, marker := range markers {
+		if strings.Contains(text, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractLocalPack turns each local pack matched by container into a local
+// SerpFeature with one item per business, numbered in pack order.
+func ExtractLocalPack(doc *goquery.Document, container, card string, sel LocalCardSelectors, resolve func(string) string) []SerpFeature {
+	var features []SerpFeature
+	doc.Find(container).Each(func(_ int, block *goquery.Selection) {
+		var items []FeatureItem
+		block.Find(card).Each(func(_ int, node *goquery.Selection) {
+			name, meta, ok := ParseLocalCard(node, sel, resolve)
+			if !ok {
+				return
+			}
+			meta.Position = len(items) + 1
+			items = append(items, FeatureItem{
+				Title: name,
+				Text:  meta.Address,
+				Link:  firstNonEmpty(meta.Website, meta.MapURL),
+				Local: meta,
+			})
+		})
+		if len(items) == 0 {
+			return
+		}
+		features = append(features, SerpFeature{
+			Type:       ResultTypeLocal,
+			Title:      "Local pack",
+			Items:      items,
+			Confidence: 0.7,
+			Layout:     SelectionLayout(block),
+		})
+	})
+	return DeduplicateSerpFeatures(features)
+}
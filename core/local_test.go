@@ -0,0 +1,62 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestApplyLocalDetail(t *testing.T) {
+	t.Parallel()
+
+	meta := &LocalMeta{}
+	for _, part := range []string{"4.6(1.2K)", "$$", "Coffee shop", "1 Ferry Building #7", "Open ⋅ Closes 7 PM", "(415) 555-0100", "Embarcadero"} {
+		ApplyLocalDetail(meta, part)
+	}
+	want := LocalMeta{Rating: 4.6, ReviewCount: 1200, Category: "Coffee shop", Address: "1 Ferry Building #7", Hours: "Open ⋅ Closes 7 PM", Phone: "(415) 555-0100"}
+	if *meta != want {
+		t.Fatalf("unexpected local meta: %+v", meta)
+	}
+
+	ru := &LocalMeta{}
+	for _, part := range []string{"Кофейня", "Тверская ул., 7", "Открыто до 22:00", "+7 495 123-45-67"} {
+		ApplyLocalDetail(ru, part)
+	}
+	if ru.Category != "Кофейня" || ru.Address != "Тверская ул., 7" || ru.Hours != "Открыто до 22:00" || ru.Phone != "+7 495 123-45-67" {
+		t.Fatalf("unexpected localized local meta: %+v", ru)
+	}
+}
+
+func TestExtractLocalPack(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="pack">
+<div class="biz"><b>Blue Bottle Coffee</b><p>4.5(820) · Coffee shop</p><p>1 Ferry Building</p><a class="site" href="https://bluebottlecoffee.com/">Website</a><a class="map" href="/maps/place/1">Map</a></div>
+<div class="biz"><b>Sightglass</b><p>270 7th St</p><a class="map" href="/maps/place/2">Map</a></div>
+<div class="biz"><p>No name</p></div>
+</div>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	sel := LocalCardSelectors{Name: "b", Details: "p", Website: "a.site", MapLink: "a.map"}
+	features := ExtractLocalPack(doc, "div.pack", "div.biz", sel, func(href string) string {
+		if strings.HasPrefix(href, "/") {
+			return "https://maps.example.com" + href
+		}
+		return href
+	})
+	if len(features) != 1 || len(features[0].Items) != 2 {
+		t.Fatalf("expected one pack with two businesses, got %+v", features)
+	}
+	first, second := features[0].Items[0], features[0].Items[1]
+	if features[0].Type != ResultTypeLocal || first.Link != "https://bluebottlecoffee.com/" || first.Text != "1 Ferry Building" {
+		t.Fatalf("unexpected first pack item: %+v", first)
+	}
+	if first.Local.Position != 1 || first.Local.Rating != 4.5 || first.Local.ReviewCount != 820 || first.Local.Category != "Coffee shop" {
+		t.Fatalf("unexpected first listing: %+v", first.Local)
+	}
+	if second.Local.Position != 2 || second.Link != "https://maps.example.com/maps/place/2" || second.Local.Website != "" {
+		t.Fatalf("expected map link fallback for the second business, got %+v %+v", second, second.Local)
+	}
+}
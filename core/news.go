@@ -0,0 +1,311 @@
+package core
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// NewsMeta is the publisher metadata a news tab shows next to each headline.
+type NewsMeta struct {
+	// Source is the publication name, for example "Reuters".
+	Source string
+	// PublishedAt is zero when the engine showed no parseable timestamp.
+	PublishedAt time.Time
+	// Thumbnail is the story image URL when the card has one.
+	Thumbnail string
+}
+
+var (
+	// relativeAgoPattern covers English ("3 hours ago", "2h ago") and the
+	// compact badges Bing and Google print without "ago" ("2h", "1d").
+	relativeAgoPattern = regexp.MustCompile(`^(\d+)\s*(seconds?|secs?|s|minutes?|mins?|m|hours?|hrs?|h|days?|d|weeks?|wks?|w|months?|mos?|years?|yrs?|y)(?:\s+ago)?$`)
+	// relativeRuPattern covers Yandex's "3 часа назад" / "15 минут назад".
+	relativeRuPattern = regexp.MustCompile(`^(\d+)\s*(секунд\S*|минут\S*|час\S*|дн\S*|день|недел\S*|месяц\S*|год\S*|лет)\s+назад$`)
+	// relativeZhPattern covers Baidu's "3小时前" / "2天前".
+	relativeZhPattern = regexp.MustCompile(`^(\d+)\s*(秒|分钟|小时|天|周|个月|月|年)前$`)
+	// clockPattern matches a bare "14:05", which news tabs use for today.
+	clockPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	// dayClockPattern matches "вчера в 14:05" / "昨天 14:05" / "yesterday 14:05".
+	dayClockPattern = regexp.MustCompile(`^(yesterday|вчера|昨天|前天)(?:\s*(?:at|в)?\s*(\d{1,2}):(\d{2}))?$`)
+	// ruDatePattern matches "5 марта", "5 марта 2024" and "5 марта в 12:30".
+	ruDatePattern = regexp.MustCompile(`^(\d{1,2})\s+([а-я]+)(?:\s+(\d{4}))?(?:\s+в\s+(\d{1,2}):(\d{2}))?$`)
+	// zhDatePattern matches "2024年3月5日" and the year-less "3月5日".
+	zhDatePattern = regexp.MustCompile(`^(?:(\d{4})年)?(\d{1,2})月(\d{1,2})日`)
+)
+
+var ruGenitiveMonths = map[string]time.Month{
+	"января": time.January, "февраля": time.February, "марта": time.March,
+	"апреля": time.April, "мая": time.May, "июня": time.June,
+	"июля": time.July, "августа": time.August, "сентября": time.September,
+	"октября": time.October, "ноября": time.November, "декабря": time.December,
+}
+
+var absoluteNewsLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"02.01.2006",
+	"2006/01/02",
+}
+
+// ParsePublishedTime parses the timestamp a news card prints, relative to now.
+// It understands relative English, Russian and Chinese phrases ("3 hours ago",
+// "3 часа назад", "3小时前"), bare clock times (today) and common absolute
+// dates. It returns false when raw matches none of them.
+func ParsePublishedTime(raw string, now time.Time) (time.Time, bool) {
+	text := strings.ToLower(NormalizeWhitespace(raw))
+	text = strings.Trim(text, " ·•-.")
+	if text == "" {
+		return time.Time{}, false
+	}
+	now = now.UTC()
+
+	switch text {
+	case "just now", "now", "только что", "刚刚":
+		return now, true
+	case "today", "сегодня", "今天":
+		return startOfDay(now), true
+	}
+
+	if m := relativeAgoPattern.FindStringSubmatch(text); m != nil {
+		return subtractUnit(now, atoi(m[1]), englishUnit(m[2])), true
+	}
+	if m := relativeRuPattern.FindStringSubmatch(text); m != nil {
+		return subtractUnit(now, atoi(m[1]), russianUnit(m[2])), true
+	}
+	if m := relativeZhPattern.FindStringSubmatch(text); m != nil {
+		return subtractUnit(now, atoi(m[1]), chineseUnit(m[2])), true
+	}
+	if m := clockPattern.FindStringSubmatch(text); m != nil {
+		return atClock(startOfDay(now), m[1], m[2]), true
+	}
+	if m := dayClockPattern.FindStringSubmatch(text); m != nil {
+		daysBack := 1
+		if m[1] == "前天" {
+			daysBack = 2
+		}
+		day := startOfDay(now).AddDate(0, 0, -daysBack)
+		if m[2] != "" {
+			day = atClock(day, m[2], m[3])
+		}
+		return day, true
+	}
+	if m := ruDatePattern.FindStringSubmatch(text); m != nil {
+		if month, ok := ruGenitiveMonths[m[2]]; ok {
+			year := now.Year()
+			if m[3] != "" {
+				year = atoi(m[3])
+			}
+			day := time.Date(year, month, atoi(m[1]), 0, 0, 0, 0, time.UTC)
+			if m[4] != "" {
+				day = atClock(day, m[4], m[5])
+			}
+			return rollBackIfFuture(day, now, m[3] == ""), true
+		}
+	}
+	if m := zhDatePattern.FindStringSubmatch(text); m != nil {
+		year := now.Year()
+		if m[1] != "" {
+			year = atoi(m[1])
+		}
+		day := time.Date(year, time.Month(atoi(m[2])), atoi(m[3]), 0, 0, 0, 0, time.UTC)
+		return rollBackIfFuture(day, now, m[1] == ""), true
+	}
+
+	// Absolute layouts are matched case-sensitively against the original text.
+	original := strings.Trim(NormalizeWhitespace(raw), " ·•-")
+	for _, layout := range absoluteNewsLayouts {
+		if parsed, err := time.Parse(layout, original); err == nil {
+			return parsed.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func atClock(day time.Time, hour, minute string) time.Time {
+	return day.Add(time.Duration(atoi(hour))*time.Hour + time.Duration(atoi(minute))*time.Minute)
+}
+
+// rollBackIfFuture moves a year-less date into the previous year when it
+// would otherwise sit in the future, e.g. "28 декабря" read on January 2nd.
+func rollBackIfFuture(t, now time.Time, yearless bool) time.Time {
+	if yearless && t.After(now) {
+		return t.AddDate(-1, 0, 0)
+	}
+	return t
+}
+
+type timeUnit int
+
+const (
+	unitSecond timeUnit = iota
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+func subtractUnit(now time.Time, n int, unit timeUnit) time.Time {
+	switch unit {
+	case unitSecond:
+		return now.Add(-time.Duration(n) * time.Second)
+	case unitMinute:
+		return now.Add(-time.Duration(n) * time.Minute)
+	case unitHour:
+		return now.Add(-time.Duration(n) * time.Hour)
+	case unitDay:
+		return now.AddDate(0, 0, -n)
+	case unitWeek:
+		return now.AddDate(0, 0, -7*n)
+	case unitMonth:
+		return now.AddDate(0, -n, 0)
+	default:
+		return now.AddDate(-n, 0, 0)
+	}
+}
+
+func englishUnit(s string) timeUnit {
+	switch {
+	case strings.HasPrefix(s, "mo"):
+		return unitMonth
+	case strings.HasPrefix(s, "s"):
+		return unitSecond
+	case strings.HasPrefix(s, "m"):
+		return unitMinute
+	case strings.HasPrefix(s, "h"):
+		return unitHour
+	case strings.HasPrefix(s, "d"):
+		return unitDay
+	case strings.HasPrefix(s, "w"):
+		return unitWeek
+	default:
+		return unitYear
+	}
+}
+
+func russianUnit(s string) timeUnit {
+	switch {
+	case strings.HasPrefix(s, "секунд"):
+		return unitSecond
+	case strings.HasPrefix(s, "минут"):
+		return unitMinute
+	case strings.HasPrefix(s, "час"):
+		return unitHour
+	case strings.HasPrefix(s, "дн"), s == "день":
+		return unitDay
+	case strings.HasPrefix(s, "недел"):
+		return unitWeek
+	case strings.HasPrefix(s, "месяц"):
+		return unitMonth
+	default:
+		return unitYear
+	}
+}
+
+func chineseUnit(s string) timeUnit {
+	switch s {
+	case "秒":
+		return unitSecond
+	case "分钟":
+		return unitMinute
+	case "小时":
+		return unitHour
+	case "天":
+		return unitDay
+	case "周":
+		return unitWeek
+	case "个月", "月":
+		return unitMonth
+	default:
+		return unitYear
+	}
+}
+
+// FilterNewsByDate drops news results published outside dateInterval
+// (YYYYMMDD..YYYYMMDD, end day inclusive). Engines only offer coarse native
+// buckets, so this trims what they return to the exact window. Results with
+// no parsed timestamp are kept, as are all results when the interval is
+// empty or malformed (BuildNewsURL already rejects malformed input).
+func FilterNewsByDate(results []SearchResult, dateInterval string) []SearchResult {
+	start, end, ok := parseNewsDateInterval(dateInterval)
+	if !ok {
+		return results
+	}
+	filtered := make([]SearchResult, 0, len(results))
+	for _, r := range results {
+		if publishedWithin(r.News, start, end) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// filterMegaNewsByDate is FilterNewsByDate for merged /mega/news results.
+func filterMegaNewsByDate(results []MegaSearchResult, dateInterval string) []MegaSearchResult {
+	start, end, ok := parseNewsDateInterval(dateInterval)
+	if !ok {
+		return results
+	}
+	filtered := make([]MegaSearchResult, 0, len(results))
+	for _, r := range results {
+		if publishedWithin(r.News, start, end) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// publishedWithin reports whether meta falls in [start, end); unknown
+// timestamps pass.
+func publishedWithin(meta *NewsMeta, start, end time.Time) bool {
+	if meta == nil || meta.PublishedAt.IsZero() {
+		return true
+	}
+	return !meta.PublishedAt.Before(start) && meta.PublishedAt.Before(end)
+}
+
+func parseNewsDateInterval(dateInterval string) (time.Time, time.Time, bool) {
+	start, end, err := ParseDateInterval(dateInterval)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end.AddDate(0, 0, 1), true
+}
+
+// NormalizeNewsTitle folds a headline to lowercase letters and digits so the
+// same story syndicated under different URLs dedupes across engines.
+func NormalizeNewsTitle(title string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		space = true
+	}
+	return b.String()
+}
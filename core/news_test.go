@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePublishedTime(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		raw  string
+		want time.Time
+	}{
+		{"3 hours ago", now.Add(-3 * time.Hour)},
+		{"1 day ago", now.AddDate(0, 0, -1)},
+		{"2h", now.Add(-2 * time.Hour)},
+		{"45m", now.Add(-45 * time.Minute)},
+		{"2 months ago", now.AddDate(0, -2, 0)},
+		{"3 weeks ago", now.AddDate(0, 0, -21)},
+		{"yesterday", time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC)},
+		{"Mar 5, 2024", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+		{"2024-03-05T08:30:00Z", time.Date(2024, time.March, 5, 8, 30, 0, 0, time.UTC)},
+		{"3 часа назад", now.Add(-3 * time.Hour)},
+		{"15 минут назад", now.Add(-15 * time.Minute)},
+		{"вчера в 14:05", time.Date(2024, time.March, 9, 14, 5, 0, 0, time.UTC)},
+		{"09:30", time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC)},
+		{"5 марта", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+		{"28 декабря", time.Date(2023, time.December, 28, 0, 0, 0, 0, time.UTC)},
+		{"3小时前", now.Add(-3 * time.Hour)},
+		{"2天前", now.AddDate(0, 0, -2)},
+		{"昨天 08:15", time.Date(2024, time.March, 9, 8, 15, 0, 0, time.UTC)},
+		{"2024年3月5日", time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+		{"03月01日", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, ok := ParsePublishedTime(tt.raw, now)
+		if !ok {
+			t.Fatalf("ParsePublishedTime(%q) failed to parse", tt.raw)
+		}
+		if !got.Equal(tt.want) {
+			t.Fatalf("ParsePublishedTime(%q) = %s, want %s", tt.raw, got, tt.want)
+		}
+	}
+
+	for _, raw := range []string{"", "Reuters", "sponsored"} {
+		if _, ok := ParsePublishedTime(raw, now); ok {
+			t.Fatalf("expected %q not to parse", raw)
+		}
+	}
+}
+
+func TestFilterNewsByDate(t *testing.T) {
+	at := func(day int) *NewsMeta {
+		return &NewsMeta{PublishedAt: time.Date(2024, time.March, day, 18, 0, 0, 0, time.UTC)}
+	}
+	results := []SearchResult{
+		{URL: "https://a.example/1", News: at(1)},
+		{URL: "https://a.example/5", News: at(5)},
+		{URL: "https://a.example/7", News: at(7)},
+		{URL: "https://a.example/unknown", News: &NewsMeta{Source: "Wire"}},
+		{URL: "https://a.example/nometa"},
+	}
+
+	got := FilterNewsByDate(results, "20240305..20240306")
+	if len(got) != 3 || got[0].URL != "https://a.example/5" {
+		t.Fatalf("unexpected filtered results: %+v", got)
+	}
+
+	if got := FilterNewsByDate(results, ""); len(got) != len(results) {
+		t.Fatalf("expected empty interval to keep all results, got %d", len(got))
+	}
+}
+
+func TestNormalizeNewsTitle(t *testing.T) {
+	if got := NormalizeNewsTitle("  Go 1.22 Released!  "); got != "go 1 22 released" {
+		t.Fatalf("unexpected normalized title: %q", got)
+	}
+	if NormalizeNewsTitle("Go 1.22 released") != NormalizeNewsTitle("GO 1.22 — Released") {
+		t.Fatal("expected punctuation and case to be ignored")
+	}
+}
@@ -0,0 +1,167 @@
+package core
+
+import "strings"
+
+// Structured query operator names, as accepted by InitFromContext and listed
+// in ResponseMeta.UnsupportedOperators.
+const (
+	OperatorExact        = "exact"
+	OperatorExcludeTerms = "exclude_terms"
+	OperatorExcludeSites = "exclude_sites"
+	OperatorInTitle      = "intitle"
+	OperatorInURL        = "inurl"
+	OperatorOrTerms      = "or_terms"
+)
+
+// OperatorSyntax is an engine's spelling of the structured query operators.
+// An empty field means the engine has no such operator; Compile reports it
+// instead of guessing.
+type OperatorSyntax struct {
+	// Phrase is true when the engine honours "quoted phrases".
+	Phrase bool
+	// Exclude prefixes an excluded term, e.g. "-".
+	Exclude string
+	// ExcludeSite prefixes an excluded domain, e.g. "-site:".
+	ExcludeSite string
+	// InTitle and InURL prefix a term that must appear in the page title or
+	// URL, e.g. "intitle:" or Yandex's "title:".
+	InTitle string
+	InURL   string
+	// Or separates alternatives, e.g. " OR " or " | ".
+	Or string
+}
+
+// RequestedOperators lists the structured operators set on q, in a fixed
+// order.
+func (q Query) RequestedOperators() []string {
+	var ops []string
+	if q.Exact != "" {
+		ops = append(ops, OperatorExact)
+	}
+	if len(q.ExcludeTerms) > 0 {
+		ops = append(ops, OperatorExcludeTerms)
+	}
+	if len(q.ExcludeSites) > 0 {
+		ops = append(ops, OperatorExcludeSites)
+	}
+	if q.InTitle != "" {
+		ops = append(ops, OperatorInTitle)
+	}
+	if q.InURL != "" {
+		ops = append(ops, OperatorInURL)
+	}
+	if len(q.OrTerms) > 0 {
+		ops = append(ops, OperatorOrTerms)
+	}
+	return ops
+}
+
+// Compile renders the operators in q that syntax can express, each preceded
+// by a space so the result appends to the query text. Requested operators the
+// engine lacks are returned in unsupported and left out of the text.
+func (syntax OperatorSyntax) Compile(q Query) (text string, unsupported []string) {
+	var b strings.Builder
+	if q.Exact != "" {
+		if syntax.Phrase {
+			b.WriteString(` "` + strings.ReplaceAll(q.Exact, `"`, "") + `"`)
+		} else {
+			unsupported = append(unsupported, OperatorExact)
+		}
+	}
+	if len(q.ExcludeTerms) > 0 {
+		if syntax.Exclude != "" {
+			for _, term := range q.ExcludeTerms {
+				b.WriteString(" " + syntax.Exclude + quoteOperatorTerm(term, syntax.Phrase))
+			}
+		} else {
+			unsupported = append(unsupported, OperatorExcludeTerms)
+		}
+	}
+	if len(q.ExcludeSites) > 0 {
+		if syntax.ExcludeSite != "" {
+			for _, site := range q.ExcludeSites {
+				b.WriteString(" " + syntax.ExcludeSite + site)
+			}
+		} else {
+			unsupported = append(unsupported, OperatorExcludeSites)
+		}
+	}
+	if q.InTitle != "" {
+		if syntax.InTitle != "" {
+			b.WriteString(" " + syntax.InTitle + quoteOperatorTerm(q.InTitle, syntax.Phrase))
+		} else {
+			unsupported = append(unsupported, OperatorInTitle)
+		}
+	}
+	if q.InURL != "" {
+		if syntax.InURL != "" {
+			b.WriteString(" " + syntax.InURL + q.InURL)
+		} else {
+			unsupported = append(unsupported, OperatorInURL)
+		}
+	}
+	if len(q.OrTerms) > 0 {
+		switch {
+		case len(q.OrTerms) == 1:
+			b.WriteString(" " + quoteOperatorTerm(q.OrTerms[0], syntax.Phrase))
+		case syntax.Or != "":
+			terms := make([]string, len(q.OrTerms))
+			for i, term := range q.OrTerms {
+				terms[i] = quoteOperatorTerm(term, syntax.Phrase)
+			}
+			b.WriteString(" (" + strings.Join(terms, syntax.Or) + ")")
+		default:
+			unsupported = append(unsupported, OperatorOrTerms)
+		}
+	}
+	return b.String(), unsupported
+}
+
+// quoteOperatorTerm quotes a multi-word term so an operator applies to all of
+// it. Quotes inside the term are dropped; they cannot be escaped.
+func quoteOperatorTerm(term string, phrase bool) string {
+	term = strings.ReplaceAll(term, `"`, "")
+	if phrase && strings.ContainsAny(term, " \t") {
+		return `"` + term + `"`
+	}
+	return term
+}
+
+// OperatorCompiler is implemented by engines (or their raw and pooled
+// wrappers) that compile structured operators into their web search text.
+type OperatorCompiler interface {
+	QueryOperators() OperatorSyntax
+}
+
+// EngineUnsupportedOperators lists the operators in q that engine cannot
+// apply on vertical. Only web search compiles operators; other tabs report
+// every requested operator. Suggestions take none, so they report nothing.
+func EngineUnsupportedOperators(engine SearchEngine, q Query, v Vertical) []string {
+	requested := q.RequestedOperators()
+	if len(requested) == 0 || v == VerticalSuggest {
+		return nil
+	}
+	compiler, ok := engine.(OperatorCompiler)
+	if !ok || v != VerticalWeb {
+		return requested
+	}
+	_, unsupported := compiler.QueryOperators().Compile(q)
+	return unsupported
+}
+
+// unsupportedOperatorsByEngine maps engine names to the operators each cannot
+// apply, or returns nil when all of them can.
+func unsupportedOperatorsByEngine(engines []SearchEngine, q Query, v Vertical) map[string][]string {
+	var out map[string][]string
+	for _, engine := range engines {
+		unsupported := EngineUnsupportedOperators(engine, q, v)
+		if len(unsupported) == 0 {
+			continue
+		}
+		if out == nil {
+			out = map[string][]string{}
+		}
+		out[engine.Name()] = unsupported
+	}
+	return out
+}
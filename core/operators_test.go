@@ -0,0 +1,108 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestOperatorSyntaxCompile(t *testing.T) {
+	t.Parallel()
+
+	q := Query{
+		Text:         "golang",
+		Exact:        "error handling",
+		ExcludeTerms: []string{"java", "rust lang"},
+		ExcludeSites: []string{"pinterest.com"},
+		InTitle:      "generics",
+		InURL:        "blog",
+		OrTerms:      []string{"tutorial", "how to"},
+	}
+
+	full := OperatorSyntax{Phrase: true, Exclude: "-", ExcludeSite: "-site:", InTitle: "title:", InURL: "inurl:", Or: " | "}
+	text, unsupported := full.Compile(q)
+	want := ` "error handling" -java -"rust lang" -site:pinterest.com title:generics inurl:blog (tutorial | "how to")`
+	if text != want || unsupported != nil {
+		t.Fatalf("Compile() = %q, %v; want %q", text, unsupported, want)
+	}
+
+	partial := OperatorSyntax{Phrase: true, Exclude: "-"}
+	text, unsupported = partial.Compile(q)
+	if text != ` "error handling" -java -"rust lang"` {
+		t.Fatalf("unexpected partial text: %q", text)
+	}
+	wantUnsupported := []string{OperatorExcludeSites, OperatorInTitle, OperatorInURL, OperatorOrTerms}
+	if !reflect.DeepEqual(unsupported, wantUnsupported) {
+		t.Fatalf("unsupported = %v, want %v", unsupported, wantUnsupported)
+	}
+}
+
+func TestInitFromContextOperators(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	var got Query
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		got = Query{}
+		if err := got.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		return c.SendStatus(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/probe?exact=go%20modules&exclude_sites=a.com,b.com&exclude_sites=c.com&or_terms=x,%20y", nil)
+	resp, err := app.Test(req, -1)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("request failed: %v status=%v", err, resp)
+	}
+	if got.Exact != "go modules" || strings.Join(got.ExcludeSites, ",") != "a.com,b.com,c.com" || strings.Join(got.OrTerms, ",") != "x,y" {
+		t.Fatalf("unexpected operators: %+v", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/probe?exclude_terms=java", nil)
+	if resp, _ := app.Test(req, -1); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected exclusions alone to be an empty query, got %d", resp.StatusCode)
+	}
+}
+
+// operatorEngineMock compiles phrases only.
+type operatorEngineMock struct {
+	*engineMock
+}
+
+func (e *operatorEngineMock) QueryOperators() OperatorSyntax {
+	return OperatorSyntax{Phrase: true}
+}
+
+func TestSearchReportsUnsupportedOperators(t *testing.T) {
+	google := &operatorEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google)
+
+	resp := request(t, srv, "/google/search?text=golang&exact=go%20vet&inurl=docs")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if got := env.Meta.UnsupportedOperators; len(got) != 1 || strings.Join(got["google"], ",") != OperatorInURL {
+		t.Fatalf("unexpected unsupported operators: %+v", got)
+	}
+
+	resp = request(t, srv, "/google/image?text=golang&exact=go%20vet")
+	env = Envelope{}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if strings.Join(env.Meta.UnsupportedOperators["google"], ",") != OperatorExact {
+		t.Fatalf("expected image search to report operators as unsupported, got %+v", env.Meta.UnsupportedOperators)
+	}
+}
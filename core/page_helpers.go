@@ -263,6 +263,7 @@ func FeaturesFromPage(page *rod.Page, extract func(*goquery.Document) []SerpFeat
 	if page == nil {
 		return nil
 	}
+	restampLayout(page)
 	doc, err := DocumentFromPage(page)
 	if err != nil {
 		return nil
@@ -281,6 +282,35 @@ func DocumentFromPage(page *rod.Page) (*goquery.Document, error) {
 	return goquery.NewDocumentFromReader(strings.NewReader(html))
 }
 
+// ArchivePage records the page's current HTML for replay when the request
+// in ctx is archiving. Engines call it on the SERP they parse, before
+// StampLayout marks the DOM, and on the captcha, timeout and parse-error
+// pages they give up on.
+func ArchivePage(ctx context.Context, page *rod.Page) {
+	if page == nil || archiveTrackerFromContext(ctx) == nil {
+		return
+	}
+	html, err := page.HTML()
+	if err != nil {
+		return
+	}
+	pageURL := ""
+	if info, err := page.Info(); err == nil {
+		pageURL = info.URL
+	}
+	ArchiveHTML(ctx, pageURL, "text/html; charset=utf-8", []byte(html))
+}
+
+// SerpMetaFromPage snapshots page and runs parse over it, returning nil if
+// the page can't be read.
+func SerpMetaFromPage(page *rod.Page, parse func(*goquery.Document) *SerpMeta) *SerpMeta {
+	doc, err := DocumentFromPage(page)
+	if err != nil {
+		return nil
+	}
+	return parse(doc)
+}
+
 // ClassifyFromPage snapshots page and runs classify over it, returning nil if
 // the page can't be read (caller treats that as "not classified").
 func ClassifyFromPage(page *rod.Page, classify func(*goquery.Document) error) error {
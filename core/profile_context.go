@@ -10,6 +10,7 @@ type profileContextKey string
 const profileRegionContextKey profileContextKey = "profile_region"
 const forcedProfileIDContextKey profileContextKey = "forced_profile_id"
 const minimalProfileContextKey profileContextKey = "minimal_profile"
+const mobileProfileContextKey profileContextKey = "mobile_profile"
 
 func WithProfileRegion(ctx context.Context, region string) context.Context {
 	region = strings.TrimSpace(region)
@@ -50,3 +51,23 @@ func minimalBrowserProfileFromContext(ctx context.Context) bool {
 	value, _ := EnsureContext(ctx).Value(minimalProfileContextKey).(bool)
 	return value
 }
+
+// WithMobileBrowserProfile asks profile selection for a mobile profile, for
+// device=mobile queries.
+func WithMobileBrowserProfile(ctx context.Context) context.Context {
+	return context.WithValue(EnsureContext(ctx), mobileProfileContextKey, true)
+}
+
+func mobileBrowserProfileFromContext(ctx context.Context) bool {
+	value, _ := EnsureContext(ctx).Value(mobileProfileContextKey).(bool)
+	return value
+}
+
+// deviceLaneKey keeps mobile profiles in their own lane so a desktop profile
+// cached for (engine, region) is never reused for a mobile request.
+func deviceLaneKey(laneKey string, mobile bool) string {
+	if mobile {
+		return laneKey + ":mobile"
+	}
+	return laneKey
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	browserprofile "github.com/karust/openserp/core/browser"
+)
+
+func TestInitFromContextDevice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantDevice Device
+	}{
+		{"?text=q", http.StatusOK, ""},
+		{"?text=q&device=mobile", http.StatusOK, DeviceMobile},
+		{"?text=q&device=Desktop", http.StatusOK, DeviceDesktop},
+		{"?text=q&device=tablet", http.StatusBadRequest, ""},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Device", string(q.Device))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && Device(resp.Header.Get("X-Device")) != tt.wantDevice {
+			t.Fatalf("%s: Device = %q, want %q", tt.query, resp.Header.Get("X-Device"), tt.wantDevice)
+		}
+	}
+}
+
+func TestBuildCacheKeySeparatesDevice(t *testing.T) {
+	t.Parallel()
+
+	q := Query{Text: "golang", Limit: 10}
+	mobile := q
+	mobile.Device = DeviceMobile
+	if BuildCacheKey("google", "search", q) == BuildCacheKey("google", "search", mobile) {
+		t.Fatal("expected device=mobile to change the cache key")
+	}
+	desktop := q
+	desktop.Device = DeviceDesktop
+	if BuildCacheKey("google", "search", q) != BuildCacheKey("google", "search", desktop) {
+		t.Fatal("expected device=desktop to share the default cache key")
+	}
+}
+
+func TestRawRequestProfileForMobile(t *testing.T) {
+	t.Parallel()
+
+	ctx := PrepareEngineContext(context.Background(), Query{Text: "q", Device: DeviceMobile}, "google", false)
+	profile := rawRequestProfileFor(ctx, Query{Device: DeviceMobile})
+	if !profile.mobile || profile.platform != "Android" {
+		t.Fatalf("expected an Android mobile profile, got id=%q platform=%q mobile=%t", profile.id, profile.platform, profile.mobile)
+	}
+
+	desktop := rawRequestProfileFor(WithEngine(context.Background(), "google"), Query{})
+	if desktop.mobile {
+		t.Fatalf("expected a desktop profile by default, got %q", desktop.id)
+	}
+}
+
+// mobileEngineMock parses the mobile layout of web search only.
+type mobileEngineMock struct {
+	*engineMock
+}
+
+func (e *mobileEngineMock) SupportsDevice(device Device, vertical Vertical) bool {
+	return vertical == VerticalWeb
+}
+
+func TestSearchEchoesDevice(t *testing.T) {
+	google := &mobileEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google)
+
+	resp := request(t, srv, "/google/search?text=golang&device=mobile")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.Query.Device != DeviceMobile {
+		t.Fatalf("expected device echo mobile, got %q", env.Query.Device)
+	}
+}
+
+func TestSearchEchoesDesktopStandIn(t *testing.T) {
+	var desktopID string
+	for _, profile := range browserprofile.Catalog() {
+		if !profile.Mobile {
+			desktopID = profile.ID
+			break
+		}
+	}
+	if desktopID == "" {
+		t.Skip("catalog has no desktop profile")
+	}
+
+	google := &mobileEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	google.searchFn = func(ctx context.Context, q Query) ([]SearchResult, error) {
+		SetBrowserProfileID(ctx, desktopID)
+		return []SearchResult{{Rank: 1, URL: "https://example.com/google", Title: "google"}}, nil
+	}
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google)
+
+	resp := request(t, srv, "/google/search?text=golang&device=mobile")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.Query.Device != DeviceDesktop {
+		t.Fatalf("expected a desktop profile to echo device=desktop, got %q", env.Query.Device)
+	}
+}
+
+func TestSearchRejectsUnsupportedDevice(t *testing.T) {
+	google := &mobileEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	bing := &engineMock{name: "bing", initialized: true}
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google, bing)
+
+	for _, path := range []string{
+		"/bing/search?text=golang&device=mobile",
+		"/google/image?text=golang&device=mobile",
+		"/mega/search?text=golang&engines=bing&device=mobile",
+	} {
+		if resp := request(t, srv, path); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", path, resp.StatusCode)
+		}
+	}
+	if calls := bing.searchCalls; calls != 0 {
+		t.Fatalf("expected bing not to be searched, got %d calls", calls)
+	}
+
+	resp := request(t, srv, "/mega/search?text=golang&engines=google,bing&device=mobile")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	for _, result := range env.Results {
+		if result.Engine == "bing" {
+			t.Fatalf("expected bing to be dropped from a mobile mega search: %+v", env.Results)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestInitFromContextPAADepth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantDepth  int
+	}{
+		{"?text=q", http.StatusOK, 0},
+		{"?text=q&paa_depth=2", http.StatusOK, 2},
+		{"?text=q&paa_depth=" + strconv.Itoa(MaxPAADepth+1), http.StatusBadRequest, 0},
+		{"?text=q&paa_depth=-1", http.StatusBadRequest, 0},
+		{"?text=q&paa_depth=deep", http.StatusBadRequest, 0},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-PAA-Depth", strconv.Itoa(q.PAADepth))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && resp.Header.Get("X-PAA-Depth") != strconv.Itoa(tt.wantDepth) {
+			t.Fatalf("%s: PAADepth = %s, want %d", tt.query, resp.Header.Get("X-PAA-Depth"), tt.wantDepth)
+		}
+	}
+}
+
+func TestBuildCacheKeySeparatesPAADepth(t *testing.T) {
+	t.Parallel()
+
+	q := Query{Text: "golang", Limit: 10, Features: true}
+	deep := q
+	deep.PAADepth = 2
+	if BuildCacheKey("google", "search", q) == BuildCacheKey("google", "search", deep) {
+		t.Fatal("expected paa_depth to change the cache key")
+	}
+}
+
+func TestInitFromContextFeaturesAIOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       string
+	}{
+		{"?text=q", http.StatusOK, "features=true ai_only=false"},
+		{"?text=q&features=0", http.StatusOK, "features=false ai_only=false"},
+		{"?text=q&features=ai_only", http.StatusOK, "features=true ai_only=true"},
+		{"?text=q&features=AI_ONLY", http.StatusOK, "features=true ai_only=true"},
+		{"?text=q&features=ai", http.StatusBadRequest, ""},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Features", "features="+strconv.FormatBool(q.Features)+" ai_only="+strconv.FormatBool(q.AIOnly))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && resp.Header.Get("X-Features") != tt.want {
+			t.Fatalf("%s: got %s, want %s", tt.query, resp.Header.Get("X-Features"), tt.want)
+		}
+	}
+
+	q := Query{Text: "golang", Limit: 10, Features: true}
+	aiOnly := q
+	aiOnly.AIOnly = true
+	if BuildCacheKey("google", "search", q) == BuildCacheKey("google", "search", aiOnly) {
+		t.Fatal("expected ai_only to change the cache key")
+	}
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// safeEngineMock honours every SafeSearch level except off.
+type safeEngineMock struct {
+	*engineMock
+}
+
+func (e *safeEngineMock) SupportsSafeSearch(level SafeSearch) bool {
+	return level != SafeSearchOff
+}
+
+func TestInitFromContextSafeSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantSafe   SafeSearch
+	}{
+		{"?text=q", http.StatusOK, ""},
+		{"?text=q&safe=strict", http.StatusOK, SafeSearchStrict},
+		{"?text=q&safe=Moderate", http.StatusOK, SafeSearchModerate},
+		{"?text=q&safe=on", http.StatusBadRequest, ""},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Safe", string(q.SafeSearch))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil)
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && SafeSearch(resp.Header.Get("X-Safe")) != tt.wantSafe {
+			t.Fatalf("%s: SafeSearch = %q, want %q", tt.query, resp.Header.Get("X-Safe"), tt.wantSafe)
+		}
+	}
+}
+
+func TestBuildCacheKeySeparatesSafeSearch(t *testing.T) {
+	t.Parallel()
+
+	q := Query{Text: "golang", Limit: 10}
+	strict := q
+	strict.SafeSearch = SafeSearchStrict
+	if BuildCacheKey("google", "search", q) == BuildCacheKey("google", "search", strict) {
+		t.Fatal("expected safe to change the cache key")
+	}
+}
+
+func TestMegaSearchEchoesSafeSearchUnsupported(t *testing.T) {
+	google := &safeEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	sogou := &engineMock{name: "sogou", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google, sogou)
+
+	resp := request(t, srv, "/mega/search?text=golang&engines=google,sogou&safe=strict")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.Query.Safe != SafeSearchStrict || strings.Join(env.Query.SafeUnsupported, ",") != "sogou" {
+		t.Fatalf("unexpected safe echo: %+v", env.Query)
+	}
+
+	resp = request(t, srv, "/google/search?text=golang&safe=off")
+	env = Envelope{}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if strings.Join(env.Query.SafeUnsupported, ",") != "google" {
+		t.Fatalf("expected google to report safe=off as unsupported, got %+v", env.Query)
+	}
+}
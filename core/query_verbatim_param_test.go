@@ -0,0 +1,100 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// verbatimEngineMock has a verbatim switch on every vertical but news.
+type verbatimEngineMock struct {
+	*engineMock
+}
+
+func (e *verbatimEngineMock) SupportsVerbatim(vertical Vertical) bool {
+	return vertical != VerticalNews
+}
+
+func TestInitFromContextVerbatim(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query        string
+		wantStatus   int
+		wantVerbatim bool
+	}{
+		{"?text=q", http.StatusOK, false},
+		{"?text=q&verbatim=true", http.StatusOK, true},
+		{"?text=q&verbatim=1", http.StatusOK, true},
+		{"?text=q&verbatim=yes", http.StatusBadRequest, false},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Verbatim", strconv.FormatBool(q.Verbatim))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil), -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && resp.Header.Get("X-Verbatim") != strconv.FormatBool(tt.wantVerbatim) {
+			t.Fatalf("%s: Verbatim = %s, want %t", tt.query, resp.Header.Get("X-Verbatim"), tt.wantVerbatim)
+		}
+	}
+}
+
+func TestBuildCacheKeySeparatesVerbatim(t *testing.T) {
+	t.Parallel()
+
+	q := Query{Text: "pyhton", Limit: 10}
+	verbatim := q
+	verbatim.Verbatim = true
+	if BuildCacheKey("google", "search", q) == BuildCacheKey("google", "search", verbatim) {
+		t.Fatal("verbatim and corrected searches must not share a cache entry")
+	}
+}
+
+func TestMegaSearchEchoesVerbatimUnsupported(t *testing.T) {
+	google := &verbatimEngineMock{engineMock: &engineMock{name: "google", initialized: true}}
+	sogou := &engineMock{name: "sogou", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google, sogou)
+
+	resp := request(t, srv, "/mega/search?text=pyhton&engines=google,sogou&verbatim=true")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var env Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if !env.Query.Verbatim || strings.Join(env.Query.VerbatimUnsupported, ",") != "sogou" {
+		t.Fatalf("unexpected verbatim echo: %+v", env.Query)
+	}
+
+	resp = request(t, srv, "/mega/search?text=pyhton&engines=google,sogou")
+	env = Envelope{}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.Query.VerbatimUnsupported != nil {
+		t.Fatalf("verbatim gaps reported without verbatim=true: %+v", env.Query)
+	}
+}
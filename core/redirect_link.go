@@ -0,0 +1,63 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// maxRedirectPageBytes bounds how much of an interstitial redirect page is
+// read; the target always sits in the <head> or the first inline script.
+const maxRedirectPageBytes = 64 << 10
+
+var (
+	metaRefreshTarget = regexp.MustCompile(`(?is)<meta[^>]+http-equiv\s*=\s*["']?refresh["']?[^>]*content\s*=\s*["']?\s*\d*\s*;\s*url\s*=\s*['"]?([^'">\s]+)`)
+	scriptLocation    = regexp.MustCompile(`(?i)(?:window\.|document\.|top\.)?location(?:\.href)?(?:\.replace\(|\s*=)\s*["']([^"']+)["']`)
+)
+
+// ExtractHTMLRedirectTarget returns the destination of an interstitial page
+// that redirects with a <meta http-equiv="refresh"> tag or an inline
+// location.replace()/location.href assignment, or "" when body has neither.
+// Only absolute http(s) targets are returned.
+func ExtractHTMLRedirectTarget(body []byte) string {
+	for _, re := range []*regexp.Regexp{scriptLocation, metaRefreshTarget} {
+		if m := re.FindSubmatch(body); m != nil {
+			target := strings.TrimSpace(html.UnescapeString(string(m[1])))
+			if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+				return target
+			}
+		}
+	}
+	return ""
+}
+
+// ResolveWrappedLink fetches a click-tracking link with the raw client and
+// returns where it points, from either the Location header or an HTML
+// interstitial (see ExtractHTMLRedirectTarget). Only the first hop is taken,
+// so the destination site itself is never contacted.
+func ResolveWrappedLink(ctx context.Context, link string, query Query) (string, error) {
+	res, err := RawSearchRequest(ctx, link, query)
+	if err != nil {
+		return "", err
+	}
+	defer DrainAndCloseResponse(res)
+
+	if location, ok := redirectLocation(res); ok {
+		return resolveRedirectURL(link, location)
+	}
+	if err := ClassifySearchHTTPStatus(res.StatusCode); err != nil {
+		return "", err
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxRedirectPageBytes))
+	if err != nil {
+		return "", err
+	}
+	if target := ExtractHTMLRedirectTarget(bytes.TrimSpace(body)); target != "" {
+		return target, nil
+	}
+	return "", fmt.Errorf("%w: no redirect target in %s", ErrParser, link)
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractHTMLRedirectTarget(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "sogou style script and noscript meta",
+			body: `<script>window.location.replace("https://example.com/a?b=1&c=2")</script><noscript><META http-equiv="refresh" content="0;URL='https://example.com/a?b=1&c=2'"></noscript>`,
+			want: "https://example.com/a?b=1&c=2",
+		},
+		{
+			name: "meta refresh only with entity escapes",
+			body: `<html><head><meta http-equiv="refresh" content="0; url=https://example.com/x?a=1&amp;b=2"></head></html>`,
+			want: "https://example.com/x?a=1&b=2",
+		},
+		{
+			name: "location href assignment",
+			body: `<script>window.location.href='https://example.org/'</script>`,
+			want: "https://example.org/",
+		},
+		{
+			name: "relative target ignored",
+			body: `<meta http-equiv="refresh" content="0;url=/antispider/">`,
+			want: "",
+		},
+		{
+			name: "plain page",
+			body: `<html><body>hello</body></html>`,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractHTMLRedirectTarget([]byte(tt.body)); got != tt.want {
+				t.Fatalf("ExtractHTMLRedirectTarget() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveWrappedLink(t *testing.T) {
+	resetRawHTTPClientCache(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/header":
+			http.Redirect(w, r, "https://example.com/from-header", http.StatusFound)
+		case "/page":
+			_, _ = w.Write([]byte(`<meta http-equiv="refresh" content="0;URL='https://example.com/from-page'">`))
+		default:
+			_, _ = w.Write([]byte("nothing here"))
+		}
+	}))
+	defer server.Close()
+
+	ctx := WithEngine(WithBrowserProfileUsage(context.Background()), "sogou")
+	for path, want := range map[string]string{
+		"/header": "https://example.com/from-header",
+		"/page":   "https://example.com/from-page",
+	} {
+		got, err := ResolveWrappedLink(ctx, server.URL+path, Query{})
+		if err != nil {
+			t.Fatalf("ResolveWrappedLink(%s) error = %v", path, err)
+		}
+		if got != want {
+			t.Fatalf("ResolveWrappedLink(%s) = %q, want %q", path, got, want)
+		}
+	}
+
+	if _, err := ResolveWrappedLink(ctx, server.URL+"/plain", Query{}); !errors.Is(err, ErrParser) {
+		t.Fatalf("expected ErrParser for page without target, got %v", err)
+	}
+}
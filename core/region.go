@@ -16,3 +16,9 @@ type RegionTarget = region.RegionTarget
 func ResolveRegion(hint string) RegionTarget {
 	return region.ResolveRegion(hint)
 }
+
+// QwantLocale picks the Qwant locale for a language and region hint.
+// See region.QwantLocale for details.
+func QwantLocale(language, hint string) string {
+	return region.QwantLocale(language, hint)
+}
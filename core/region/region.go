@@ -1,5 +1,6 @@
 // Package region resolves a free-text region hint into per-engine search
-// targeting (Google UULE canonical names, Yandex lr IDs, ISO country codes).
+// targeting (Google UULE canonical names, Yandex lr IDs, Qwant locales, ISO
+// country codes).
 //
 // It is deliberately dependency-free (standard library only) so lightweight
 // consumers that only need geotargeting can import it without pulling in the
@@ -71,7 +72,7 @@ func isAlphaASCII(ch byte) bool {
 
 // RegionTarget is the resolved, per-engine targeting for a user-supplied region
 // hint. Engines read the field relevant to them: Google uses GoogleCanonical to
-// build a UULE, Yandex uses YandexLR. Country is the ISO 3166-1 alpha-2 code
+// build a UULE, Yandex uses YandexLR, Qwant uses QwantLocale. Country is the ISO 3166-1 alpha-2 code
 // when one could be derived, useful as a coarse market signal.
 //
 // A field left empty means "no better signal than the raw input" — callers
@@ -89,6 +90,10 @@ type RegionTarget struct {
 	GoogleCanonical string
 	// YandexLR is the Yandex lr region ID (e.g. "213"), else "".
 	YandexLR string
+	// QwantLocale is the country's default Qwant locale (e.g. "fr_BE"), else
+	// "". Qwant rejects locales outside its supported set, so markets it does
+	// not serve stay empty.
+	QwantLocale string
 }
 
 // yandexLRByCountry maps an ISO country code to a Yandex lr region ID. Yandex
@@ -103,6 +108,34 @@ var yandexLRByCountry = map[string]string{
 	"UA": "187", "UK": "102", "US": "84", "ZA": "10021",
 }
 
+// qwantLocales is the set of language_COUNTRY pairs Qwant accepts in its
+// locale parameter.
+var qwantLocales = map[string]bool{
+	"bg_BG": true, "ca_ES": true, "cs_CZ": true, "da_DK": true, "de_AT": true,
+	"de_CH": true, "de_DE": true, "el_GR": true, "en_AU": true, "en_CA": true,
+	"en_GB": true, "en_IE": true, "en_MY": true, "en_NZ": true, "en_US": true,
+	"es_AR": true, "es_CL": true, "es_CO": true, "es_ES": true, "es_MX": true,
+	"es_PE": true, "et_EE": true, "fi_FI": true, "fr_BE": true, "fr_CA": true,
+	"fr_CH": true, "fr_FR": true, "he_IL": true, "hu_HU": true, "it_CH": true,
+	"it_IT": true, "ko_KR": true, "nb_NO": true, "nl_BE": true, "nl_NL": true,
+	"pl_PL": true, "pt_PT": true, "ro_RO": true, "sv_SE": true, "th_TH": true,
+	"zh_CN": true, "zh_HK": true,
+}
+
+// qwantDefaultLocaleByCountry is the locale used for a country when the
+// caller gives no (or an unsupported) language. Multilingual markets default
+// to their largest language.
+var qwantDefaultLocaleByCountry = map[string]string{
+	"AR": "es_AR", "AT": "de_AT", "AU": "en_AU", "BE": "fr_BE", "BG": "bg_BG",
+	"CA": "en_CA", "CH": "de_CH", "CL": "es_CL", "CN": "zh_CN", "CO": "es_CO",
+	"CZ": "cs_CZ", "DE": "de_DE", "DK": "da_DK", "EE": "et_EE", "ES": "es_ES",
+	"FI": "fi_FI", "FR": "fr_FR", "GB": "en_GB", "GR": "el_GR", "HK": "zh_HK",
+	"HU": "hu_HU", "IE": "en_IE", "IL": "he_IL", "IT": "it_IT", "KR": "ko_KR",
+	"MX": "es_MX", "MY": "en_MY", "NL": "nl_NL", "NO": "nb_NO", "NZ": "en_NZ",
+	"PE": "es_PE", "PL": "pl_PL", "PT": "pt_PT", "RO": "ro_RO", "SE": "sv_SE",
+	"TH": "th_TH", "UK": "en_GB", "US": "en_US",
+}
+
 // cityCanonical maps a normalized bare city name to its exact Google geotargets
 // canonical name (used to build a UULE). Only UULE-bearing city targeting needs
 // a name table — country/state targeting rides on gl= and never needs one.
@@ -208,6 +241,7 @@ func ResolveRegion(region string) RegionTarget {
 	if cc := CountryFromRegion(region); cc != "" {
 		t.Country = cc
 		t.YandexLR = yandexLRByCountry[cc]
+		t.QwantLocale = qwantDefaultLocaleByCountry[cc]
 		return t
 	}
 
@@ -279,6 +313,28 @@ func EncodeGoogleUULE(canonical string) string {
 	return googleUULEPrefix + string(googleUULELengthAlphabet[length]) + base64.StdEncoding.EncodeToString([]byte(canonical))
 }
 
+// QwantLocale picks the Qwant locale for a language subtag and a region hint.
+// The exact language_COUNTRY pair wins when Qwant supports it; otherwise the
+// country's default locale applies, so "en" in Belgium falls back to fr_BE.
+// A locale-style region ("fr-CA") supplies the language when none is given.
+// Returns "" when the region names no market Qwant serves.
+func QwantLocale(language, region string) string {
+	country := ResolveRegion(region).Country
+	if country == "" {
+		return ""
+	}
+	if language == "" && len(strings.TrimSpace(region)) > 2 {
+		language = ParseLocale(region).Language
+	}
+	if country == "UK" {
+		country = "GB"
+	}
+	if pair := strings.ToLower(language) + "_" + country; qwantLocales[pair] {
+		return pair
+	}
+	return qwantDefaultLocaleByCountry[country]
+}
+
 // YandexLR converts a public region hint to a Yandex lr ID, returning "" when
 // none applies. Numeric input is passed through; a 2-letter country or locale
 // is mapped via the country table.
@@ -110,3 +110,31 @@ func TestYandexLR(t *testing.T) {
 		}
 	}
 }
+
+func TestQwantLocale(t *testing.T) {
+	cases := []struct {
+		language string
+		region   string
+		want     string
+	}{
+		{"fr", "FR", "fr_FR"},
+		{"nl", "BE", "nl_BE"},
+		{"en", "BE", "fr_BE"},
+		{"", "CH", "de_CH"},
+		{"it", "ch", "it_CH"},
+		{"en", "UK", "en_GB"},
+		{"", "fr-CA", "fr_CA"},
+		{"cs", "CZ", "cs_CZ"},
+		{"en", "ZZ", ""},
+		{"en", "", ""},
+		{"en", "213", ""},
+	}
+	for _, tt := range cases {
+		if got := QwantLocale(tt.language, tt.region); got != tt.want {
+			t.Errorf("QwantLocale(%q, %q) = %q, want %q", tt.language, tt.region, got, tt.want)
+		}
+	}
+	if got := ResolveRegion("BE").QwantLocale; got != "fr_BE" {
+		t.Errorf("ResolveRegion(BE).QwantLocale = %q, want fr_BE", got)
+	}
+}
@@ -0,0 +1,319 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ExpandSource names where an expansion candidate came from.
+type ExpandSource string
+
+const (
+	ExpandSourceSuggest ExpandSource = "suggest"
+	ExpandSourceRelated ExpandSource = "related"
+	ExpandSourcePAA     ExpandSource = "paa"
+)
+
+// Expansion stop reasons reported in ExpandStats.StopReason.
+const (
+	expandStopBudget      = "budget"
+	expandStopMaxKeywords = "max_keywords"
+	expandStopTimeout     = "timeout"
+)
+
+// expandAlphabet is appended to seeds for alphabet-soup suggestions.
+const expandAlphabet = "abcdefghijklmnopqrstuvwxyz"
+
+// ExpandOptions bounds a keyword expansion run.
+type ExpandOptions struct {
+	// Seeds are the depth-0 keywords.
+	Seeds []string
+	// Sources selects candidate sources; empty means all three.
+	Sources []ExpandSource
+	// Depth is how many levels below the seeds are expanded.
+	Depth int
+	// Budget caps engine calls across the whole run.
+	Budget int
+	// MaxKeywords caps the number of nodes, seeds included.
+	MaxKeywords int
+	// Alphabet also asks for suggestions on "seed a" ... "seed z".
+	Alphabet bool
+}
+
+// ExpandKeyword is one node of the expansion tree. Seeds have depth 0 and no
+// parent; other nodes record the first edge that discovered them.
+type ExpandKeyword struct {
+	Keyword string       `json:"keyword"`
+	Depth   int          `json:"depth"`
+	Parent  string       `json:"parent,omitempty"`
+	Engine  string       `json:"engine,omitempty"`
+	Source  ExpandSource `json:"source,omitempty"`
+}
+
+// ExpandEdge links a keyword to a candidate an engine produced for it. A
+// child reached from several parents or engines has one edge for each.
+type ExpandEdge struct {
+	Parent string       `json:"parent"`
+	Child  string       `json:"child"`
+	Engine string       `json:"engine"`
+	Source ExpandSource `json:"source"`
+}
+
+// ExpandStats reports how much of the budget a run used and why it stopped
+// early, if it did.
+type ExpandStats struct {
+	Calls      int    `json:"calls"`
+	Truncated  bool   `json:"truncated"`
+	StopReason string `json:"stop_reason,omitempty"`
+}
+
+// ExpandEcho echoes the interpreted expansion request.
+type ExpandEcho struct {
+	Seeds            []string       `json:"seeds"`
+	Lang             string         `json:"lang,omitempty"`
+	Region           string         `json:"region,omitempty"`
+	EnginesRequested []string       `json:"engines_requested"`
+	Sources          []ExpandSource `json:"sources"`
+	Depth            int            `json:"depth"`
+	Budget           int            `json:"budget"`
+	MaxKeywords      int            `json:"max_keywords"`
+	Alphabet         bool           `json:"alphabet"`
+}
+
+// ExpandEnvelope is the response wrapper for /research/expand.
+type ExpandEnvelope struct {
+	Query    ExpandEcho      `json:"query"`
+	Meta     ResponseMeta    `json:"meta"`
+	Keywords []ExpandKeyword `json:"keywords"`
+	Edges    []ExpandEdge    `json:"edges"`
+	Stats    ExpandStats     `json:"stats"`
+}
+
+// errExpandStop ends a run once the budget, the keyword cap or the deadline
+// is reached; the reason is already recorded on the expansion.
+var errExpandStop = errors.New("expansion stopped")
+
+// keywordExpansion holds the state of one breadth-first run.
+type keywordExpansion struct {
+	rs      *ResilientSearcher
+	base    Query
+	opts    ExpandOptions
+	engines []SearchEngine
+
+	nodes    map[string]int
+	edges    map[ExpandEdge]bool
+	failed   map[string]bool
+	env      *ExpandEnvelope
+	frontier []int
+}
+
+// ExpandKeywords breadth-first expands opts.Seeds through engines. Each
+// keyword is sent to every engine for suggestions and, for the related and
+// paa sources, a web search with features enabled; candidates are keyed by
+// SuggestionKey so one phrase is one node. An engine that fails is reported in
+// meta and skipped for the rest of the run. Running out of budget, hitting
+// MaxKeywords or ctx expiring returns the tree built so far.
+func (rs *ResilientSearcher) ExpandKeywords(ctx context.Context, base Query, engines []SearchEngine, opts ExpandOptions, env *ExpandEnvelope) {
+	run := &keywordExpansion{
+		rs:      rs,
+		base:    base,
+		opts:    opts,
+		engines: engines,
+		nodes:   map[string]int{},
+		edges:   map[ExpandEdge]bool{},
+		failed:  map[string]bool{},
+		env:     env,
+	}
+	err := run.expand(ctx)
+	if errors.Is(err, errExpandStop) {
+		env.Stats.Truncated = true
+	}
+}
+
+func (run *keywordExpansion) expand(ctx context.Context) error {
+	for _, seed := range run.opts.Seeds {
+		if _, err := run.addNode(seed, -1, 0, "", ""); err != nil {
+			return err
+		}
+	}
+	for depth := 0; depth < run.opts.Depth && len(run.frontier) > 0; depth++ {
+		level := run.frontier
+		run.frontier = nil
+		for _, idx := range level {
+			if err := run.expandNode(ctx, idx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (run *keywordExpansion) expandNode(ctx context.Context, idx int) error {
+	keyword := run.env.Keywords[idx].Keyword
+	for _, engine := range run.engines {
+		if run.failed[engine.Name()] {
+			continue
+		}
+		if run.usesSource(ExpandSourceSuggest) && EngineSupportsVertical(engine, VerticalSuggest) {
+			prompts := []string{keyword}
+			if run.opts.Alphabet && run.env.Keywords[idx].Depth == 0 {
+				for _, letter := range expandAlphabet {
+					prompts = append(prompts, keyword+" "+string(letter))
+				}
+			}
+			for _, prompt := range prompts {
+				results, err := run.call(ctx, engine, prompt, VerticalSuggest)
+				if err != nil {
+					if errors.Is(err, errExpandStop) {
+						return err
+					}
+					break
+				}
+				for _, result := range results {
+					if err := run.addCandidate(idx, result.Title, engine.Name(), ExpandSourceSuggest); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if !run.failed[engine.Name()] && (run.usesSource(ExpandSourceRelated) || run.usesSource(ExpandSourcePAA)) {
+			results, err := run.call(ctx, engine, keyword, VerticalWeb)
+			if err != nil {
+				if errors.Is(err, errExpandStop) {
+					return err
+				}
+				continue
+			}
+			for _, candidate := range serpExpansionCandidates(results) {
+				if !run.usesSource(candidate.source) {
+					continue
+				}
+				if err := run.addCandidate(idx, candidate.text, engine.Name(), candidate.source); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// call spends one unit of budget on an engine request. Engine failures are
+// recorded once per engine and the engine is dropped from the run.
+func (run *keywordExpansion) call(ctx context.Context, engine SearchEngine, text string, vertical Vertical) ([]SearchResult, error) {
+	if ctx.Err() != nil {
+		return nil, run.stop(expandStopTimeout)
+	}
+	if run.env.Stats.Calls >= run.opts.Budget {
+		return nil, run.stop(expandStopBudget)
+	}
+	run.env.Stats.Calls++
+
+	q := run.base
+	q.Text = text
+	q.Features = vertical == VerticalWeb
+	results, _, _, err := run.rs.SearchVerticalPrimary(ctx, engine, q, vertical)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, run.stop(expandStopTimeout)
+		}
+		WithRequest(ctx).WithError(err).Debugf("Keyword expansion: %s failed for %q", engine.Name(), text)
+		run.failed[engine.Name()] = true
+		run.env.Meta.EnginesFailed = append(run.env.Meta.EnginesFailed, engine.Name())
+		run.env.Meta.EngineErrors = append(run.env.Meta.EngineErrors, engineErrorDetail(engine.Name(), err, q))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (run *keywordExpansion) addCandidate(parentIdx int, text string, engine string, source ExpandSource) error {
+	key := SuggestionKey(text)
+	parent := run.env.Keywords[parentIdx]
+	if key == "" || key == SuggestionKey(parent.Keyword) {
+		return nil
+	}
+	childIdx, err := run.addNode(text, parentIdx, parent.Depth+1, engine, source)
+	if err != nil {
+		return err
+	}
+	edge := ExpandEdge{
+		Parent: parent.Keyword,
+		Child:  run.env.Keywords[childIdx].Keyword,
+		Engine: engine,
+		Source: source,
+	}
+	if !run.edges[edge] {
+		run.edges[edge] = true
+		run.env.Edges = append(run.env.Edges, edge)
+	}
+	return nil
+}
+
+// addNode returns the index of the node for text, creating it when the
+// phrase is new.
+func (run *keywordExpansion) addNode(text string, parentIdx int, depth int, engine string, source ExpandSource) (int, error) {
+	text = NormalizeWhitespace(text)
+	key := SuggestionKey(text)
+	if idx, ok := run.nodes[key]; ok {
+		return idx, nil
+	}
+	if len(run.env.Keywords) >= run.opts.MaxKeywords {
+		return 0, run.stop(expandStopMaxKeywords)
+	}
+	node := ExpandKeyword{Keyword: text, Depth: depth, Engine: engine, Source: source}
+	if parentIdx >= 0 {
+		node.Parent = run.env.Keywords[parentIdx].Keyword
+	}
+	run.nodes[key] = len(run.env.Keywords)
+	run.env.Keywords = append(run.env.Keywords, node)
+	run.frontier = append(run.frontier, len(run.env.Keywords)-1)
+	return len(run.env.Keywords) - 1, nil
+}
+
+func (run *keywordExpansion) stop(reason string) error {
+	run.env.Stats.StopReason = reason
+	return errExpandStop
+}
+
+func (run *keywordExpansion) usesSource(source ExpandSource) bool {
+	return len(run.opts.Sources) == 0 || containsExpandSource(run.opts.Sources, source)
+}
+
+type expansionCandidate struct {
+	text   string
+	source ExpandSource
+}
+
+// serpExpansionCandidates collects related searches and questions from a web
+// SERP. Questions come from people-also-ask and related-questions modules and
+// from Google's inline PAA results.
+func serpExpansionCandidates(results []SearchResult) []expansionCandidate {
+	var candidates []expansionCandidate
+	for _, result := range results {
+		if result.Type == ResultTypePeopleAlsoAsk && result.Title != "" {
+			candidates = append(candidates, expansionCandidate{text: result.Title, source: ExpandSourcePAA})
+		}
+		for _, feature := range result.Features {
+			switch feature.Type {
+			case ResultTypeRelatedSearches:
+				for _, item := range feature.Items {
+					candidates = append(candidates, expansionCandidate{text: firstNonEmpty(item.Text, item.Title), source: ExpandSourceRelated})
+				}
+			case ResultTypePeopleAlsoAsk, ResultTypeRelatedQuestions:
+				for _, item := range feature.Items {
+					candidates = append(candidates, expansionCandidate{text: firstNonEmpty(item.Title, item.Text), source: ExpandSourcePAA})
+				}
+			}
+		}
+	}
+	return candidates
+}
+
+// ParseExpandSource validates a source name from a request.
+func ParseExpandSource(raw string) (ExpandSource, error) {
+	switch source := ExpandSource(raw); source {
+	case ExpandSourceSuggest, ExpandSourceRelated, ExpandSourcePAA:
+		return source, nil
+	}
+	return "", fmt.Errorf("unknown source %q: accepted values are suggest, related, paa", raw)
+}
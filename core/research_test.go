@@ -0,0 +1,167 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// expandEngineMock answers suggestions and SERP features from fixed maps.
+type expandEngineMock struct {
+	*engineMock
+	suggestions map[string][]string
+	related     map[string][]string
+}
+
+func (e *expandEngineMock) Suggest(_ context.Context, q Query) ([]SearchResult, error) {
+	return SuggestionResults(e.suggestions[q.Text], q.Limit), nil
+}
+
+func newExpandEngineMock(name string, suggestions map[string][]string, related map[string][]string) *expandEngineMock {
+	e := &expandEngineMock{
+		engineMock:  &engineMock{name: name, initialized: true},
+		suggestions: suggestions,
+		related:     related,
+	}
+	e.searchFn = func(_ context.Context, q Query) ([]SearchResult, error) {
+		if !q.Features {
+			return nil, errors.New("expansion searched without features")
+		}
+		items := make([]FeatureItem, 0, len(e.related[q.Text]))
+		for _, text := range e.related[q.Text] {
+			items = append(items, FeatureItem{Title: text, Text: text})
+		}
+		return []SearchResult{{
+			Rank:     1,
+			URL:      "https://example.com/" + name,
+			Title:    name,
+			Features: []SerpFeature{{Type: ResultTypeRelatedSearches, Items: items}},
+		}}, nil
+	}
+	return e
+}
+
+func newExpandEnvelope() *ExpandEnvelope {
+	return &ExpandEnvelope{Meta: ResponseMeta{EnginesFailed: []string{}}}
+}
+
+func TestExpandKeywordsBuildsDedupedTree(t *testing.T) {
+	google := newExpandEngineMock("google",
+		map[string][]string{
+			"golang":          {"golang tutorial", "Golang  Generics"},
+			"golang tutorial": {"golang tutorial pdf"},
+		},
+		map[string][]string{"golang": {"go language"}},
+	)
+	bing := newExpandEngineMock("bing",
+		map[string][]string{"golang": {"golang generics", "golang"}},
+		nil,
+	)
+	engines := []SearchEngine{google, bing}
+	rs := NewResilientSearcher(engines, DefaultResilientConfig())
+
+	env := newExpandEnvelope()
+	rs.ExpandKeywords(context.Background(), Query{Limit: 10}, engines, ExpandOptions{
+		Seeds:       []string{"golang"},
+		Depth:       2,
+		Budget:      50,
+		MaxKeywords: 100,
+	}, env)
+
+	byKeyword := map[string]ExpandKeyword{}
+	for _, k := range env.Keywords {
+		byKeyword[k.Keyword] = k
+	}
+	if len(env.Keywords) != 5 {
+		t.Fatalf("expected 5 unique keywords, got %+v", env.Keywords)
+	}
+	if k := byKeyword["Golang Generics"]; k.Parent != "golang" || k.Engine != "google" || k.Source != ExpandSourceSuggest || k.Depth != 1 {
+		t.Fatalf("unexpected generics node: %+v", k)
+	}
+	if k := byKeyword["go language"]; k.Source != ExpandSourceRelated || k.Engine != "google" {
+		t.Fatalf("unexpected related node: %+v", k)
+	}
+	if k := byKeyword["golang tutorial pdf"]; k.Depth != 2 || k.Parent != "golang tutorial" {
+		t.Fatalf("unexpected depth-2 node: %+v", k)
+	}
+
+	genericsEdges := 0
+	for _, edge := range env.Edges {
+		if edge.Child == "Golang Generics" {
+			genericsEdges++
+		}
+		if edge.Child == "golang" {
+			t.Fatalf("a keyword must not be its own child: %+v", edge)
+		}
+	}
+	if genericsEdges != 2 {
+		t.Fatalf("expected one generics edge per engine, got %d in %+v", genericsEdges, env.Edges)
+	}
+	if env.Stats.Truncated {
+		t.Fatalf("expected a complete run, got %+v", env.Stats)
+	}
+}
+
+func TestExpandKeywordsStopsAtBudget(t *testing.T) {
+	google := newExpandEngineMock("google", map[string][]string{"golang": {"golang tutorial"}}, nil)
+	engines := []SearchEngine{google}
+	rs := NewResilientSearcher(engines, DefaultResilientConfig())
+
+	env := newExpandEnvelope()
+	rs.ExpandKeywords(context.Background(), Query{Limit: 10}, engines, ExpandOptions{
+		Seeds:       []string{"golang"},
+		Sources:     []ExpandSource{ExpandSourceSuggest},
+		Depth:       3,
+		Budget:      3,
+		MaxKeywords: 100,
+		Alphabet:    true,
+	}, env)
+
+	if env.Stats.Calls != 3 || !env.Stats.Truncated || env.Stats.StopReason != expandStopBudget {
+		t.Fatalf("expected the run to stop after 3 calls, got %+v", env.Stats)
+	}
+	if google.searchCalls != 0 {
+		t.Fatalf("suggest-only expansion should not run web searches, got %d", google.searchCalls)
+	}
+}
+
+func TestExpandKeywordsSkipsFailedEngine(t *testing.T) {
+	google := newExpandEngineMock("google", map[string][]string{"golang": {"golang tutorial"}}, nil)
+	broken := &engineMock{name: "bing", initialized: true}
+	broken.searchFn = func(context.Context, Query) ([]SearchResult, error) {
+		return nil, ErrCaptcha
+	}
+	engines := []SearchEngine{broken, google}
+	cfg := DefaultResilientConfig()
+	cfg.Retry.MaxRetries = 0
+	rs := NewResilientSearcher(engines, cfg)
+
+	env := newExpandEnvelope()
+	rs.ExpandKeywords(context.Background(), Query{Limit: 10}, engines, ExpandOptions{
+		Seeds:       []string{"golang"},
+		Depth:       2,
+		Budget:      50,
+		MaxKeywords: 100,
+	}, env)
+
+	if strings.Join(env.Meta.EnginesFailed, ",") != "bing" || broken.searchCalls != 1 {
+		t.Fatalf("expected bing to fail once and be dropped, failed=%v calls=%d", env.Meta.EnginesFailed, broken.searchCalls)
+	}
+	if len(env.Keywords) != 2 {
+		t.Fatalf("expected google to keep expanding, got %+v", env.Keywords)
+	}
+}
+
+func TestSerpExpansionCandidatesReadsQuestions(t *testing.T) {
+	candidates := serpExpansionCandidates([]SearchResult{
+		{Rank: -1, Type: ResultTypePeopleAlsoAsk, Title: "Is Go hard to learn?"},
+		{Rank: 1, Features: []SerpFeature{{
+			Type:  ResultTypeRelatedQuestions,
+			Items: []FeatureItem{{Title: "What is Go used for?", Text: "Go is used for..."}},
+		}}},
+	})
+	if len(candidates) != 2 || candidates[1].text != "What is Go used for?" || candidates[0].source != ExpandSourcePAA {
+		t.Fatalf("unexpected candidates: %+v", candidates)
+	}
+}
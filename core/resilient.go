@@ -90,34 +90,37 @@ func NewResilientSearcher(engines []SearchEngine, cfg ResilientConfig) *Resilien
 
 // SearchPrimary keeps dedicated endpoints engine-pure (no fallback).
 func (rs *ResilientSearcher) SearchPrimary(ctx context.Context, primaryEngine SearchEngine, q Query) ([]SearchResult, string, ProxyExecutionMeta, error) {
-	results, proxyMeta, err := rs.searchWithProtection(ctx, primaryEngine, q, false)
-	if err != nil {
-		return nil, primaryEngine.Name(), proxyMeta, err
-	}
-	return results, primaryEngine.Name(), proxyMeta, nil
+	return rs.SearchVerticalPrimary(ctx, primaryEngine, q, VerticalWeb)
 }
 
 // SearchWithFallback retries primary and then tries other initialized engines.
 func (rs *ResilientSearcher) SearchWithFallback(ctx context.Context, primaryEngine SearchEngine, q Query) ([]SearchResult, string, ProxyExecutionMeta, error) {
-	return rs.searchWithFallback(ctx, primaryEngine, q, false)
+	return rs.SearchVerticalWithFallback(ctx, primaryEngine, q, VerticalWeb)
 }
 
 func (rs *ResilientSearcher) SearchImagePrimary(ctx context.Context, primaryEngine SearchEngine, q Query) ([]SearchResult, string, ProxyExecutionMeta, error) {
-	results, proxyMeta, err := rs.searchWithProtection(ctx, primaryEngine, q, true)
+	return rs.SearchVerticalPrimary(ctx, primaryEngine, q, VerticalImage)
+}
+
+func (rs *ResilientSearcher) SearchImageWithFallback(ctx context.Context, primaryEngine SearchEngine, q Query) ([]SearchResult, string, ProxyExecutionMeta, error) {
+	return rs.SearchVerticalWithFallback(ctx, primaryEngine, q, VerticalImage)
+}
+
+// SearchVerticalPrimary runs primaryEngine's vertical tab without fallback.
+func (rs *ResilientSearcher) SearchVerticalPrimary(ctx context.Context, primaryEngine SearchEngine, q Query, vertical Vertical) ([]SearchResult, string, ProxyExecutionMeta, error) {
+	results, proxyMeta, err := rs.searchWithProtection(ctx, primaryEngine, q, vertical)
 	if err != nil {
 		return nil, primaryEngine.Name(), proxyMeta, err
 	}
 	return results, primaryEngine.Name(), proxyMeta, nil
 }
 
-func (rs *ResilientSearcher) SearchImageWithFallback(ctx context.Context, primaryEngine SearchEngine, q Query) ([]SearchResult, string, ProxyExecutionMeta, error) {
-	return rs.searchWithFallback(ctx, primaryEngine, q, true)
-}
-
-func (rs *ResilientSearcher) searchWithFallback(ctx context.Context, primaryEngine SearchEngine, q Query, isImage bool) ([]SearchResult, string, ProxyExecutionMeta, error) {
+// SearchVerticalWithFallback retries primary and then tries the other
+// initialized engines that also serve vertical.
+func (rs *ResilientSearcher) SearchVerticalWithFallback(ctx context.Context, primaryEngine SearchEngine, q Query, vertical Vertical) ([]SearchResult, string, ProxyExecutionMeta, error) {
 	ctx = EnsureContext(ctx)
 
-	results, proxyMeta, err := rs.searchWithProtection(ctx, primaryEngine, q, isImage)
+	results, proxyMeta, err := rs.searchWithProtection(ctx, primaryEngine, q, vertical)
 	if err == nil {
 		return results, primaryEngine.Name(), proxyMeta, nil
 	}
@@ -129,11 +132,6 @@ func (rs *ResilientSearcher) searchWithFallback(ctx context.Context, primaryEngi
 		return nil, primaryEngine.Name(), proxyMeta, err
 	}
 
-	successMessage := "Fallback to %s succeeded with %d results"
-	if isImage {
-		successMessage = "Image fallback to %s succeeded with %d results"
-	}
-
 	WithRequestEngine(ctx, primaryEngine.Name()).
 		WithError(err).
 		Warn("Primary engine failed, trying fallbacks")
@@ -141,15 +139,16 @@ func (rs *ResilientSearcher) searchWithFallback(ctx context.Context, primaryEngi
 		if ctx.Err() != nil {
 			return nil, primaryEngine.Name(), proxyMeta, ctx.Err()
 		}
-		if fallbackEngine.Name() == primaryEngine.Name() || !fallbackEngine.IsInitialized() {
+		if fallbackEngine.Name() == primaryEngine.Name() || !fallbackEngine.IsInitialized() ||
+			!EngineSupportsVertical(fallbackEngine, vertical) {
 			continue
 		}
 
-		results, fallbackMeta, fallbackErr := rs.searchWithProtection(ctx, fallbackEngine, q, isImage)
+		results, fallbackMeta, fallbackErr := rs.searchWithProtection(ctx, fallbackEngine, q, vertical)
 		if fallbackErr == nil {
 			WithRequestEngine(ctx, fallbackEngine.Name()).
-				WithField("results_count", len(results)).
-				Infof(successMessage, fallbackEngine.Name(), len(results))
+				WithFields(logrus.Fields{"vertical": vertical, "results_count": len(results)}).
+				Infof("Fallback to %s succeeded with %d results", fallbackEngine.Name(), len(results))
 			return results, fallbackEngine.Name(), fallbackMeta, nil
 		}
 		WithRequestEngine(ctx, fallbackEngine.Name()).WithError(fallbackErr).Debug("Fallback engine also failed")
@@ -158,7 +157,7 @@ func (rs *ResilientSearcher) searchWithFallback(ctx context.Context, primaryEngi
 	return nil, primaryEngine.Name(), proxyMeta, ErrAllEnginesFailed
 }
 
-func (rs *ResilientSearcher) searchWithProtection(ctx context.Context, engine SearchEngine, q Query, isImage bool) ([]SearchResult, ProxyExecutionMeta, error) {
+func (rs *ResilientSearcher) searchWithProtection(ctx context.Context, engine SearchEngine, q Query, vertical Vertical) ([]SearchResult, ProxyExecutionMeta, error) {
 	ctx = EnsureContext(ctx)
 
 	if ctx.Err() != nil {
@@ -211,7 +210,7 @@ func (rs *ResilientSearcher) searchWithProtection(ctx context.Context, engine Se
 			lastProxyURL = proxyURL
 
 			requestCtx := proxyRequestContext(callCtx, engine.Name(), attemptQuery)
-			results, err := invokeEngine(requestCtx, engine, attemptQuery, isImage)
+			results, err := invokeEngine(requestCtx, engine, attemptQuery, vertical)
 
 			if reportToRegistry {
 				rs.reportProxyAttempt(engineCtx, proxyURL, err)
@@ -280,6 +279,7 @@ func shouldRecordCircuitFailure(err error) bool {
 	return err != nil &&
 		!IsContextDone(err) &&
 		!errors.Is(err, ErrProxyUnavailable) &&
+		!errors.Is(err, ErrUnsupportedVertical) &&
 		!errors.Is(err, ErrCircuitOpen)
 }
 
@@ -300,16 +300,58 @@ func normalizeLimiterWaitErr(ctx context.Context, err error) error {
 // invokeEngine is the single panic-recovery point for every engine call made
 // through the resilient pipeline (browser, raw, and any future engine method).
 // A rod/CDP panic surfaces as ErrEngineInternal instead of killing the process.
-func invokeEngine(ctx context.Context, engine SearchEngine, q Query, isImage bool) (results []SearchResult, err error) {
+func invokeEngine(ctx context.Context, engine SearchEngine, q Query, vertical Vertical) (results []SearchResult, err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
 			results = nil
 			err = RecoverEnginePanicWithContext(ctx, engine.Name(), recovered, nil)
 		}
 	}()
+	return SearchVertical(ctx, engine, q, vertical)
+}
 
-	if isImage {
+// SearchVertical calls the engine method serving vertical. Optional tabs the
+// engine lacks return ErrUnsupportedVertical.
+func SearchVertical(ctx context.Context, engine SearchEngine, q Query, vertical Vertical) ([]SearchResult, error) {
+	switch vertical {
+	case VerticalImage:
 		return engine.SearchImage(ctx, q)
+	case VerticalNews:
+		searcher, ok := engine.(NewsSearcher)
+		if !ok || !EngineSupportsVertical(engine, VerticalNews) {
+			return nil, fmt.Errorf("%w: %s has no news search", ErrUnsupportedVertical, engine.Name())
+		}
+		return searcher.SearchNews(ctx, q)
+	case VerticalVideo:
+		searcher, ok := engine.(VideoSearcher)
+		if !ok || !EngineSupportsVertical(engine, VerticalVideo) {
+			return nil, fmt.Errorf("%w: %s has no video search", ErrUnsupportedVertical, engine.Name())
+		}
+		return searcher.SearchVideos(ctx, q)
+	case VerticalShopping:
+		searcher, ok := engine.(ShoppingSearcher)
+		if !ok || !EngineSupportsVertical(engine, VerticalShopping) {
+			return nil, fmt.Errorf("%w: %s has no shopping search", ErrUnsupportedVertical, engine.Name())
+		}
+		return searcher.SearchShopping(ctx, q)
+	case VerticalLocal:
+		searcher, ok := engine.(LocalSearcher)
+		if !ok || !EngineSupportsVertical(engine, VerticalLocal) {
+			return nil, fmt.Errorf("%w: %s has no local search", ErrUnsupportedVertical, engine.Name())
+		}
+		return searcher.SearchLocal(ctx, q)
+	case VerticalScholar:
+		searcher, ok := engine.(ScholarSearcher)
+		if !ok || !EngineSupportsVertical(engine, VerticalScholar) {
+			return nil, fmt.Errorf("%w: %s has no scholar search", ErrUnsupportedVertical, engine.Name())
+		}
+		return searcher.SearchScholar(ctx, q)
+	case VerticalSuggest:
+		suggester, ok := engine.(Suggester)
+		if !ok || !EngineSupportsVertical(engine, VerticalSuggest) {
+			return nil, fmt.Errorf("%w: %s has no suggestions", ErrUnsupportedVertical, engine.Name())
+		}
+		return suggester.Suggest(ctx, q)
 	}
 	return engine.Search(ctx, q)
 }
@@ -317,26 +359,26 @@ func invokeEngine(ctx context.Context, engine SearchEngine, q Query, isImage boo
 // SearchAllParallel applies retry/circuit protections per engine for mega search.
 // Returns results, list of engines that responded, and list of engines that failed.
 func (rs *ResilientSearcher) SearchAllParallel(ctx context.Context, q Query, engines []SearchEngine) ([]MegaSearchResult, []string, []string) {
-	results, responded, failed, _ := rs.runParallelDetailed(ctx, q, engines, false)
-	return results, responded, failed
+	return rs.SearchAllVerticalParallel(ctx, q, engines, VerticalWeb)
 }
 
 func (rs *ResilientSearcher) SearchAllImageParallel(ctx context.Context, q Query, engines []SearchEngine) ([]MegaSearchResult, []string, []string) {
-	results, responded, failed, _ := rs.runParallelDetailed(ctx, q, engines, true)
-	return results, responded, failed
+	return rs.SearchAllVerticalParallel(ctx, q, engines, VerticalImage)
 }
 
-func (rs *ResilientSearcher) searchAllParallelDetailed(ctx context.Context, q Query, engines []SearchEngine) ([]MegaSearchResult, []string, []EngineErrorDetail) {
-	results, responded, _, errors := rs.runParallelDetailed(ctx, q, engines, false)
-	return results, responded, errors
+// SearchAllVerticalParallel is SearchAllParallel for any vertical; engines
+// without the tab are reported as failed.
+func (rs *ResilientSearcher) SearchAllVerticalParallel(ctx context.Context, q Query, engines []SearchEngine, vertical Vertical) ([]MegaSearchResult, []string, []string) {
+	results, responded, failed, _ := rs.runParallelDetailed(ctx, q, engines, vertical)
+	return results, responded, failed
 }
 
-func (rs *ResilientSearcher) searchAllImageParallelDetailed(ctx context.Context, q Query, engines []SearchEngine) ([]MegaSearchResult, []string, []EngineErrorDetail) {
-	results, responded, _, errors := rs.runParallelDetailed(ctx, q, engines, true)
+func (rs *ResilientSearcher) searchAllParallelDetailed(ctx context.Context, q Query, engines []SearchEngine, vertical Vertical) ([]MegaSearchResult, []string, []EngineErrorDetail) {
+	results, responded, _, errors := rs.runParallelDetailed(ctx, q, engines, vertical)
 	return results, responded, errors
 }
 
-func (rs *ResilientSearcher) searchAnyDetailed(ctx context.Context, q Query, engines []SearchEngine, isImage bool) ([]MegaSearchResult, []string, []EngineErrorDetail) {
+func (rs *ResilientSearcher) searchAnyDetailed(ctx context.Context, q Query, engines []SearchEngine, vertical Vertical) ([]MegaSearchResult, []string, []EngineErrorDetail) {
 	ctx = EnsureContext(ctx)
 
 	engineErrors := make([]EngineErrorDetail, 0, len(engines))
@@ -350,7 +392,7 @@ func (rs *ResilientSearcher) searchAnyDetailed(ctx context.Context, q Query, eng
 			continue
 		}
 
-		results, _, err := rs.searchWithProtection(ctx, engine, q, isImage)
+		results, _, err := rs.searchWithProtection(ctx, engine, q, vertical)
 		if err != nil {
 			engineErrors = append(engineErrors, engineErrorDetail(engine.Name(), err, q))
 			continue
@@ -369,7 +411,7 @@ func (rs *ResilientSearcher) searchAnyDetailed(ctx context.Context, q Query, eng
 	return []MegaSearchResult{}, []string{}, engineErrors
 }
 
-func (rs *ResilientSearcher) searchFastestDetailed(ctx context.Context, q Query, engines []SearchEngine, isImage bool) ([]MegaSearchResult, []string, []EngineErrorDetail) {
+func (rs *ResilientSearcher) searchFastestDetailed(ctx context.Context, q Query, engines []SearchEngine, vertical Vertical) ([]MegaSearchResult, []string, []EngineErrorDetail) {
 	ctx = EnsureContext(ctx)
 
 	var (
@@ -413,7 +455,7 @@ func (rs *ResilientSearcher) searchFastestDetailed(ctx context.Context, q Query,
 		fastest = candidates[0]
 	}
 
-	results, _, err := rs.searchWithProtection(ctx, fastest, q, isImage)
+	results, _, err := rs.searchWithProtection(ctx, fastest, q, vertical)
 	if err != nil {
 		return []MegaSearchResult{}, []string{}, []EngineErrorDetail{engineErrorDetail(fastest.Name(), err, q)}
 	}
@@ -425,7 +467,7 @@ func (rs *ResilientSearcher) searchFastestDetailed(ctx context.Context, q Query,
 	return mega, []string{fastest.Name()}, []EngineErrorDetail{}
 }
 
-func (rs *ResilientSearcher) runParallelDetailed(ctx context.Context, q Query, engines []SearchEngine, isImage bool) ([]MegaSearchResult, []string, []string, []EngineErrorDetail) {
+func (rs *ResilientSearcher) runParallelDetailed(ctx context.Context, q Query, engines []SearchEngine, vertical Vertical) ([]MegaSearchResult, []string, []string, []EngineErrorDetail) {
 	ctx = EnsureContext(ctx)
 
 	type engineResult struct {
@@ -456,7 +498,7 @@ func (rs *ResilientSearcher) runParallelDetailed(ctx context.Context, q Query, e
 
 		started++
 		go func(eng SearchEngine) {
-			results, _, err := rs.searchWithProtection(ctx, eng, q, isImage)
+			results, _, err := rs.searchWithProtection(ctx, eng, q, vertical)
 			if err != nil {
 				resultCh <- engineResult{name: eng.Name(), err: err}
 				return
@@ -8,6 +8,18 @@ type QueryEcho struct {
 	Lang             string   `json:"lang,omitempty"`
 	Region           string   `json:"region,omitempty"`
 	EnginesRequested []string `json:"engines_requested"`
+	// Safe echoes the requested SafeSearch level; SafeUnsupported names the
+	// requested engines that cannot honour it and run with their default.
+	Safe            SafeSearch `json:"safe,omitempty"`
+	SafeUnsupported []string   `json:"safe_unsupported,omitempty"`
+	// Device echoes the device the engines searched from: desktop when a
+	// desktop profile stood in for device=mobile. Desktop is the default.
+	Device Device `json:"device,omitempty"`
+	// Verbatim echoes verbatim=true; it is omitted otherwise.
+	// VerbatimUnsupported names the requested engines that have no verbatim
+	// switch on this vertical and may still correct the query.
+	Verbatim            bool     `json:"verbatim,omitempty"`
+	VerbatimUnsupported []string `json:"verbatim_unsupported,omitempty"`
 }
 
 // ResponseMeta carries request-level metadata for observability and debugging.
@@ -18,7 +30,13 @@ type ResponseMeta struct {
 	EnginesResponded []string            `json:"engines_responded,omitempty"`
 	EnginesFailed    []string            `json:"engines_failed"`
 	EngineErrors     []EngineErrorDetail `json:"engine_errors,omitempty"`
-	Version          string              `json:"version"`
+	// UnsupportedOperators maps an engine to the structured query operators
+	// it could not express; the search ran without them.
+	UnsupportedOperators map[string][]string `json:"unsupported_operators,omitempty"`
+	// Artifacts lists files stored for this response, such as screenshot=
+	// captures. They are served by GET /artifacts/{id} until they expire.
+	Artifacts []Artifact `json:"artifacts,omitempty"`
+	Version   string     `json:"version"`
 }
 
 // EngineErrorDetail is a client-facing, sanitized per-engine failure summary.
@@ -41,7 +59,10 @@ type Envelope struct {
 	Meta         ResponseMeta  `json:"meta"`
 	Results      []Result      `json:"results"`
 	SerpFeatures []SerpFeature `json:"serp_features"`
-	Pagination   Pagination    `json:"pagination"`
+	// SerpMeta holds each engine's page-level SERP information (result
+	// estimate, spelling correction, locale), keyed by engine.
+	SerpMeta   map[string]*SerpMeta `json:"serp_meta,omitempty"`
+	Pagination Pagination           `json:"pagination"`
 	// Clusters is only populated by /mega/search (see clusters.go).
 	Clusters *[]Cluster `json:"clusters,omitempty"`
 }
@@ -74,6 +95,57 @@ type ImageEnvelope struct {
 	Pagination Pagination    `json:"pagination"`
 }
 
+// NewsEnvelope is the top-level v2 response wrapper for news search endpoints.
+type NewsEnvelope struct {
+	Query      QueryEcho    `json:"query"`
+	Meta       ResponseMeta `json:"meta"`
+	Results    []NewsResult `json:"results"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+// VideoEnvelope is the top-level v2 response wrapper for video search endpoints.
+type VideoEnvelope struct {
+	Query      QueryEcho     `json:"query"`
+	Meta       ResponseMeta  `json:"meta"`
+	Results    []VideoResult `json:"results"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// ShoppingEnvelope is the top-level v2 response wrapper for shopping search
+// endpoints.
+type ShoppingEnvelope struct {
+	Query      QueryEcho       `json:"query"`
+	Meta       ResponseMeta    `json:"meta"`
+	Results    []ProductResult `json:"results"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+// LocalEnvelope is the top-level v2 response wrapper for local search
+// endpoints.
+type LocalEnvelope struct {
+	Query      QueryEcho     `json:"query"`
+	Meta       ResponseMeta  `json:"meta"`
+	Results    []LocalResult `json:"results"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// ScholarEnvelope is the top-level v2 response wrapper for scholar search
+// endpoints.
+type ScholarEnvelope struct {
+	Query      QueryEcho       `json:"query"`
+	Meta       ResponseMeta    `json:"meta"`
+	Results    []ScholarResult `json:"results"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+// SuggestEnvelope is the top-level v2 response wrapper for suggest endpoints.
+// Suggestions are a single list, so it carries no pagination.
+type SuggestEnvelope struct {
+	Query   QueryEcho          `json:"query"`
+	Meta    ResponseMeta       `json:"meta"`
+	Results []SuggestionResult `json:"results"`
+}
+
 const apiVersion = "2.1"
 
 // NewEnvelope builds a fresh Envelope pre-filled with query echo and an open
@@ -85,6 +157,9 @@ func NewEnvelope(q Query, requestID string, startedAt time.Time, engines []strin
 			Lang:             q.LangCode,
 			Region:           q.Region,
 			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
 		},
 		Meta: ResponseMeta{
 			RequestID:     requestID,
@@ -106,6 +181,9 @@ func NewImageEnvelope(q Query, requestID string, startedAt time.Time, engines []
 			Lang:             q.LangCode,
 			Region:           q.Region,
 			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
 		},
 		Meta: ResponseMeta{
 			RequestID:     requestID,
@@ -118,9 +196,148 @@ func NewImageEnvelope(q Query, requestID string, startedAt time.Time, engines []
 	}
 }
 
-// Finalize stamps the elapsed time and computes pagination fields.
+// NewNewsEnvelope builds a fresh NewsEnvelope.
+func NewNewsEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *NewsEnvelope {
+	return &NewsEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results:    []NewsResult{},
+		Pagination: Pagination{},
+	}
+}
+
+// NewVideoEnvelope builds a fresh VideoEnvelope.
+func NewVideoEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *VideoEnvelope {
+	return &VideoEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results:    []VideoResult{},
+		Pagination: Pagination{},
+	}
+}
+
+// NewShoppingEnvelope builds a fresh ShoppingEnvelope.
+func NewShoppingEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *ShoppingEnvelope {
+	return &ShoppingEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results:    []ProductResult{},
+		Pagination: Pagination{},
+	}
+}
+
+// NewLocalEnvelope builds a fresh LocalEnvelope.
+func NewLocalEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *LocalEnvelope {
+	return &LocalEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results:    []LocalResult{},
+		Pagination: Pagination{},
+	}
+}
+
+// NewScholarEnvelope builds a fresh ScholarEnvelope.
+func NewScholarEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *ScholarEnvelope {
+	return &ScholarEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+			Safe:             q.SafeSearch,
+			Device:           q.Device,
+			Verbatim:         q.Verbatim,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results:    []ScholarResult{},
+		Pagination: Pagination{},
+	}
+}
+
+// NewSuggestEnvelope builds a fresh SuggestEnvelope.
+func NewSuggestEnvelope(q Query, requestID string, startedAt time.Time, engines []string) *SuggestEnvelope {
+	return &SuggestEnvelope{
+		Query: QueryEcho{
+			Text:             q.Text,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engines,
+		},
+		Meta: ResponseMeta{
+			RequestID:     requestID,
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Results: []SuggestionResult{},
+	}
+}
+
+// Finalize stamps the elapsed time and computes pagination fields. An
+// ai_only query keeps only the AI summary features.
 func (e *Envelope) Finalize(startedAt time.Time, q Query) {
 	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+	if q.AIOnly {
+		e.keepAISummariesOnly()
+	}
+	e.summarizeLayout()
 
 	limit := q.Limit
 	if limit <= 0 {
@@ -134,6 +351,21 @@ func (e *Envelope) Finalize(startedAt time.Time, q Query) {
 	}
 }
 
+// keepAISummariesOnly narrows a features=ai_only response to its AI summary
+// features. The results they were attached to are dropped with the rest.
+func (e *Envelope) keepAISummariesOnly() {
+	e.Results = []Result{}
+	kept := e.SerpFeatures[:0]
+	for _, feature := range e.SerpFeatures {
+		if feature.Type != ResultTypeAISummary {
+			continue
+		}
+		feature.SourceResultIDs = nil
+		kept = append(kept, feature)
+	}
+	e.SerpFeatures = kept
+}
+
 func countNonAdResults(results []Result) int {
 	count := 0
 	for _, result := range results {
@@ -159,3 +391,88 @@ func (e *ImageEnvelope) Finalize(startedAt time.Time, q Query) {
 		NextStart: q.Start + limit,
 	}
 }
+
+// Finalize stamps the elapsed time and computes pagination fields.
+func (e *NewsEnvelope) Finalize(startedAt time.Time, q Query) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	page := q.Start/limit + 1
+	e.Pagination = Pagination{
+		Page:      page,
+		HasMore:   len(e.Results) >= limit,
+		NextStart: q.Start + limit,
+	}
+}
+
+// Finalize stamps the elapsed time and computes pagination fields.
+func (e *VideoEnvelope) Finalize(startedAt time.Time, q Query) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	page := q.Start/limit + 1
+	e.Pagination = Pagination{
+		Page:      page,
+		HasMore:   len(e.Results) >= limit,
+		NextStart: q.Start + limit,
+	}
+}
+
+// Finalize stamps the elapsed time and computes pagination fields.
+func (e *ShoppingEnvelope) Finalize(startedAt time.Time, q Query) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	page := q.Start/limit + 1
+	e.Pagination = Pagination{
+		Page:      page,
+		HasMore:   len(e.Results) >= limit,
+		NextStart: q.Start + limit,
+	}
+}
+
+// Finalize stamps the elapsed time and computes pagination fields.
+func (e *LocalEnvelope) Finalize(startedAt time.Time, q Query) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	page := q.Start/limit + 1
+	e.Pagination = Pagination{
+		Page:      page,
+		HasMore:   len(e.Results) >= limit,
+		NextStart: q.Start + limit,
+	}
+}
+
+// Finalize stamps the elapsed time and computes pagination fields.
+func (e *ScholarEnvelope) Finalize(startedAt time.Time, q Query) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	page := q.Start/limit + 1
+	e.Pagination = Pagination{
+		Page:      page,
+		HasMore:   len(e.Results) >= limit,
+		NextStart: q.Start + limit,
+	}
+}
+
+// Finalize stamps the elapsed time.
+func (e *SuggestEnvelope) Finalize(startedAt time.Time) {
+	e.Meta.TookMs = time.Since(startedAt).Milliseconds()
+}
@@ -26,6 +26,13 @@ var featureBaseURLs = map[string]string{
 	"duck":       "https://duckduckgo.com/",
 	"ddg":        "https://duckduckgo.com/",
 	"ecosia":     "https://www.ecosia.org/",
+	"yahoojp":    "https://search.yahoo.co.jp/",
+	"startpage":  "https://www.startpage.com/",
+	"mojeek":     "https://www.mojeek.com/",
+	"sogou":      "https://www.sogou.com/",
+	"so360":      "https://www.so.com/",
+	"seznam":     "https://search.seznam.cz/",
+	"qwant":      "https://lite.qwant.com/",
 	"yandex":     "https://www.yandex.com/",
 	"baidu":      "https://www.baidu.com/",
 }
@@ -75,30 +82,45 @@ func EnrichResult(raw SearchResult, ctx EnrichContext) Result {
 	}
 
 	result := Result{
-		ID:         buildResultID(ctx.Engine, normalizedURL),
-		Rank:       rank,
-		Type:       resultType,
-		Title:      raw.Title,
-		URL:        normalizedURL,
-		DisplayURL: displayURL,
-		Snippet:    raw.Description,
-		Domain:     domain,
-		Favicon:    favicon,
-		Engine:     ctx.Engine,
+		ID:          buildResultID(ctx.Engine, normalizedURL),
+		Rank:        rank,
+		Type:        resultType,
+		Title:       raw.Title,
+		URL:         normalizedURL,
+		DisplayURL:  displayURL,
+		Snippet:     raw.Description,
+		Domain:      domain,
+		Favicon:     favicon,
+		Engine:      ctx.Engine,
+		Sitelinks:   raw.Sitelinks,
+		RichSnippet: raw.RichSnippet,
+		Breadcrumbs: raw.Breadcrumbs,
 	}
 	if absolute > 0 {
 		result.Position = &Position{Absolute: absolute}
 	}
+	result.Position = withLayout(result.Position, raw.Layout)
 
 	result.DomainInfo = EnrichDomainInfo(domain)
 	result.Classification = ClassifyURL(normalizedURL, domain)
+	result.Provenance = buildProvenance(raw.Sources, ctx.Engine)
 
 	return result
 }
 
+// buildProvenance returns nil for ordinary engines so the field is omitted.
+func buildProvenance(sources []string, engine string) *Provenance {
+	if len(sources) == 0 {
+		return nil
+	}
+	return &Provenance{Via: engine, Engines: append([]string(nil), sources...)}
+}
+
 // AppendEnrichedSearchResult preserves the legacy results[] surface while
 // copying any extracted SERP features onto the top-level feature surface.
 func AppendEnrichedSearchResult(env *Envelope, raw SearchResult, ctx EnrichContext, extractedAt time.Time) {
+	env.AddSerpMeta(ctx.Engine, raw.SerpMeta)
+
 	var sourceResultID string
 	if raw.URL != "" || raw.Title != "" || raw.Description != "" || raw.Rank != 0 {
 		result := EnrichResult(raw, ctx)
@@ -124,6 +146,20 @@ func AppendEnrichedSearchResult(env *Envelope, raw SearchResult, ctx EnrichConte
 	}
 }
 
+// AddSerpMeta records engine's page-level SERP information; the first
+// non-empty meta seen for an engine wins.
+func (e *Envelope) AddSerpMeta(engine string, meta *SerpMeta) {
+	if meta.IsEmpty() {
+		return
+	}
+	if e.SerpMeta == nil {
+		e.SerpMeta = map[string]*SerpMeta{}
+	}
+	if _, ok := e.SerpMeta[engine]; !ok {
+		e.SerpMeta[engine] = meta
+	}
+}
+
 // EnrichSerpFeature stamps a raw feature with stable public fields.
 func EnrichSerpFeature(raw SerpFeature, engine string, sourceResultID string, extractedAt time.Time) SerpFeature {
 	feature := raw
@@ -146,6 +182,14 @@ func EnrichSerpFeature(raw SerpFeature, engine string, sourceResultID string, ex
 		}
 		feature.Items[i].Link = normalizeFeatureURL(feature.Items[i].Link, engine)
 	}
+	feature.Position = withLayout(feature.Position, feature.Layout)
+	if knowledge := feature.Knowledge; knowledge != nil {
+		knowledge.DescriptionURL = normalizeFeatureURL(knowledge.DescriptionURL, engine)
+		knowledge.Website = normalizeFeatureURL(knowledge.Website, engine)
+		for i := range knowledge.Profiles {
+			knowledge.Profiles[i].URL = normalizeFeatureURL(knowledge.Profiles[i].URL, engine)
+		}
+	}
 	if feature.ID == "" {
 		feature.ID = buildFeatureID(feature)
 	}
@@ -251,10 +295,176 @@ func EnrichImageResult(raw SearchResult, ctx EnrichContext) ImageResult {
 			PageURL: pageURL,
 			Domain:  sourceDomain,
 		},
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+}
+
+// EnrichNewsResult converts a raw engine result into the v2 NewsResult shape.
+func EnrichNewsResult(raw SearchResult, ctx EnrichContext) NewsResult {
+	normalizedURL := normalizeURL(raw.URL)
+	result := NewsResult{
+		ID:         buildNewsID(ctx.Engine, normalizedURL),
+		Rank:       raw.Rank,
+		Type:       ResultTypeNews,
+		Title:      raw.Title,
+		URL:        normalizedURL,
+		Snippet:    raw.Description,
+		Domain:     extractDomain(normalizedURL),
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+	if absolute := computeResultPosition(raw, ctx.Query.Start); absolute > 0 {
+		result.Position = &Position{Absolute: absolute}
+	}
+	if raw.News != nil {
+		result.Source = raw.News.Source
+		result.Thumbnail = raw.News.Thumbnail
+		if !raw.News.PublishedAt.IsZero() {
+			result.PublishedAt = raw.News.PublishedAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return result
+}
+
+// EnrichVideoResult converts a raw engine result into the v2 VideoResult shape.
+// The ID is keyed on the canonical video (e.g. the YouTube video ID), so the
+// same clip reached through different watch URLs keeps one ID per engine.
+func EnrichVideoResult(raw SearchResult, ctx EnrichContext) VideoResult {
+	normalizedURL := normalizeURL(raw.URL)
+	result := VideoResult{
+		ID:         buildVideoID(ctx.Engine, CanonicalVideoKey(normalizedURL)),
+		Rank:       raw.Rank,
+		Type:       ResultTypeVideo,
+		Title:      raw.Title,
+		URL:        normalizedURL,
+		Snippet:    raw.Description,
+		Domain:     extractDomain(normalizedURL),
+		Platform:   VideoPlatform(normalizedURL),
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+	if absolute := computeResultPosition(raw, ctx.Query.Start); absolute > 0 {
+		result.Position = &Position{Absolute: absolute}
+	}
+	if raw.Video != nil {
+		result.Thumbnail = raw.Video.Thumbnail
+		result.Channel = raw.Video.Channel
+		if raw.Video.Platform != "" {
+			result.Platform = raw.Video.Platform
+		}
+		if raw.Video.Duration > 0 {
+			result.Duration = FormatVideoDuration(raw.Video.Duration)
+			result.DurationSeconds = int(raw.Video.Duration / time.Second)
+		}
+		if !raw.Video.PublishedAt.IsZero() {
+			result.PublishedAt = raw.Video.PublishedAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return result
+}
+
+// EnrichSuggestion converts a raw engine suggestion into the v2
+// SuggestionResult shape.
+func EnrichSuggestion(raw SearchResult, ctx EnrichContext) SuggestionResult {
+	return SuggestionResult{
+		Text:   raw.Title,
+		Rank:   raw.Rank,
 		Engine: ctx.Engine,
 	}
 }
 
+// EnrichProductResult converts a raw engine result into the v2 ProductResult
+// shape.
+func EnrichProductResult(raw SearchResult, ctx EnrichContext) ProductResult {
+	normalizedURL := normalizeURL(raw.URL)
+	result := ProductResult{
+		ID:         buildProductID(ctx.Engine, normalizedURL),
+		Rank:       raw.Rank,
+		Type:       ResultTypeShopping,
+		Title:      raw.Title,
+		URL:        normalizedURL,
+		Domain:     extractDomain(normalizedURL),
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+	if absolute := computeResultPosition(raw, ctx.Query.Start); absolute > 0 {
+		result.Position = &Position{Absolute: absolute}
+	}
+	if raw.Product != nil {
+		result.Price = raw.Product.Price
+		result.Currency = raw.Product.Currency
+		result.Merchant = raw.Product.Merchant
+		result.Rating = raw.Product.Rating
+		result.ReviewCount = raw.Product.ReviewCount
+		result.Thumbnail = raw.Product.Thumbnail
+	}
+	return result
+}
+
+// EnrichLocalResult converts a raw engine result into the v2 LocalResult
+// shape.
+func EnrichLocalResult(raw SearchResult, ctx EnrichContext) LocalResult {
+	normalizedURL := normalizeURL(raw.URL)
+	result := LocalResult{
+		ID:         buildLocalID(ctx.Engine, raw.Title, normalizedURL),
+		Rank:       raw.Rank,
+		Type:       ResultTypeLocal,
+		Title:      raw.Title,
+		URL:        normalizedURL,
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+	if absolute := computeResultPosition(raw, ctx.Query.Start); absolute > 0 {
+		result.Position = &Position{Absolute: absolute}
+	}
+	if raw.Local != nil {
+		result.Rating = raw.Local.Rating
+		result.ReviewCount = raw.Local.ReviewCount
+		result.Category = raw.Local.Category
+		result.Address = raw.Local.Address
+		result.Phone = raw.Local.Phone
+		result.Hours = raw.Local.Hours
+		result.Website = raw.Local.Website
+		result.MapURL = raw.Local.MapURL
+		if raw.Local.Website != "" {
+			result.Domain = extractDomain(normalizeURL(raw.Local.Website))
+		}
+	}
+	return result
+}
+
+// EnrichScholarResult converts a raw engine result into the v2 ScholarResult
+// shape.
+func EnrichScholarResult(raw SearchResult, ctx EnrichContext) ScholarResult {
+	normalizedURL := normalizeURL(raw.URL)
+	result := ScholarResult{
+		ID:         buildScholarID(ctx.Engine, normalizedURL),
+		Rank:       raw.Rank,
+		Type:       ResultTypeScholar,
+		Title:      raw.Title,
+		URL:        normalizedURL,
+		Snippet:    raw.Description,
+		Domain:     extractDomain(normalizedURL),
+		Engine:     ctx.Engine,
+		Provenance: buildProvenance(raw.Sources, ctx.Engine),
+	}
+	if absolute := computeResultPosition(raw, ctx.Query.Start); absolute > 0 {
+		result.Position = &Position{Absolute: absolute}
+	}
+	if raw.Scholar != nil {
+		result.Authors = raw.Scholar.Authors
+		result.Venue = raw.Scholar.Venue
+		result.Year = raw.Scholar.Year
+		result.CitedBy = raw.Scholar.CitedBy
+		result.CitedByURL = raw.Scholar.CitedByURL
+		result.PDFURL = raw.Scholar.PDFURL
+		result.Versions = raw.Scholar.Versions
+		result.VersionsURL = raw.Scholar.VersionsURL
+	}
+	return result
+}
+
 // buildResultID returns a stable "s_<hex>" ID for web results.
 func buildResultID(engine, normalizedURL string) string {
 	return "s_" + shortMD5(engine+"|"+normalizedURL)
@@ -265,6 +475,32 @@ func buildImageID(engine, imageURL string) string {
 	return "i_" + shortMD5(engine+"|"+imageURL)
 }
 
+// buildNewsID returns a stable "n_<hex>" ID for news results.
+func buildNewsID(engine, normalizedURL string) string {
+	return "n_" + shortMD5(engine+"|"+normalizedURL)
+}
+
+// buildVideoID returns a stable "v_<hex>" ID for video results.
+func buildVideoID(engine, videoKey string) string {
+	return "v_" + shortMD5(engine+"|"+videoKey)
+}
+
+// buildProductID returns a stable "p_<hex>" ID for shopping results.
+func buildProductID(engine, normalizedURL string) string {
+	return "p_" + shortMD5(engine+"|"+normalizedURL)
+}
+
+// buildLocalID returns a stable "l_<hex>" ID for local results. The name is
+// part of the key because businesses without a website share map hosts.
+func buildLocalID(engine, name, normalizedURL string) string {
+	return "l_" + shortMD5(engine+"|"+strings.ToLower(name)+"|"+normalizedURL)
+}
+
+// buildScholarID returns a stable "a_<hex>" ID for scholar (article) results.
+func buildScholarID(engine, normalizedURL string) string {
+	return "a_" + shortMD5(engine+"|"+normalizedURL)
+}
+
 func shortMD5(value string) string {
 	h := md5.Sum([]byte(value))
 	return hex.EncodeToString(h[:responseIDBytes])
@@ -461,16 +697,17 @@ func parseImageDescription(desc string) imageDescriptionMeta {
 		break
 	}
 
-	if !isHTTPURL(meta.PageURL) {
+	if !IsHTTPURL(meta.PageURL) {
 		meta.PageURL = ""
 	}
-	if !isHTTPURL(meta.ThumbnailURL) {
+	if !IsHTTPURL(meta.ThumbnailURL) {
 		meta.ThumbnailURL = ""
 	}
 	return meta
 }
 
-func isHTTPURL(value string) bool {
+// IsHTTPURL reports whether value is an absolute http or https URL.
+func IsHTTPURL(value string) bool {
 	return strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")
 }
 
@@ -492,7 +729,7 @@ func ValidateResultType(t ResultType) (ResultType, string) {
 	case ResultTypeOrganic, ResultTypeAd, ResultTypeFeaturedSnippet,
 		ResultTypeKnowledgePanel, ResultTypePeopleAlsoAsk, ResultTypeVideo,
 		ResultTypeImage, ResultTypeNews, ResultTypeShopping,
-		ResultTypeLocal, ResultTypeAnswerBox, ResultTypeAISummary,
+		ResultTypeLocal, ResultTypeScholar, ResultTypeAnswerBox, ResultTypeAISummary,
 		ResultTypeRelatedQuestions, ResultTypeRelatedSearches,
 		ResultTypeSitelinks, ResultTypeVideos, ResultTypeImagesInline,
 		ResultTypeCalculator, ResultTypeWeather, ResultTypeDictionary:
@@ -14,6 +14,7 @@ const (
 	ResultTypeNews             ResultType = "news"
 	ResultTypeShopping         ResultType = "shopping"
 	ResultTypeLocal            ResultType = "local"
+	ResultTypeScholar          ResultType = "scholar"
 	ResultTypeAnswerBox        ResultType = "answer_box"
 	ResultTypeAISummary        ResultType = "ai_summary"
 	ResultTypeRelatedQuestions ResultType = "related_questions"
@@ -32,6 +33,20 @@ type Position struct {
 	// across both organic and ad blocks. Always emitted so SEO callers can plot
 	// rank vs. on-page position without inferring it from the result order.
 	Absolute int `json:"absolute"`
+	// PixelTop, PixelHeight and PixelWidth are the box the result or feature
+	// occupied on a browser-rendered SERP, and AboveFold whether it started
+	// inside the first viewport. All are omitted in raw and parse modes.
+	PixelTop    *int  `json:"pixel_top,omitempty"`
+	PixelHeight int   `json:"pixel_height,omitempty"`
+	PixelWidth  int   `json:"pixel_width,omitempty"`
+	AboveFold   *bool `json:"above_fold,omitempty"`
+}
+
+// Provenance records which upstream engines a metasearch adapter merged into
+// one result. Via is the adapter engine name, for example "searxng".
+type Provenance struct {
+	Via     string   `json:"via"`
+	Engines []string `json:"engines"`
 }
 
 // DomainInfo carries TLD-derived category signals for a result domain.
@@ -66,32 +81,51 @@ type FeatureItem struct {
 	Title string `json:"title,omitempty"`
 	Text  string `json:"text,omitempty"`
 	Link  string `json:"link,omitempty"`
+	// Parent is the Title of the item whose expansion revealed this one, for
+	// modules that grow as they are clicked (Google's people-also-ask).
+	Parent string `json:"parent,omitempty"`
+	// Depth is the 1-based level of the item in such a tree; 0 when flat.
+	Depth int `json:"depth,omitempty"`
+	// Product carries price, merchant and rating for product carousel items.
+	Product *ProductMeta `json:"product,omitempty"`
+	// Local carries the business listing for local pack items.
+	Local *LocalMeta `json:"local,omitempty"`
 }
 
 // FeatureLink is a source or citation associated with a SERP feature.
 type FeatureLink struct {
 	Title string `json:"title,omitempty"`
 	URL   string `json:"url,omitempty"`
+	// Source is the publisher a citation card names, e.g. "MDN Web Docs".
+	Source string `json:"source,omitempty"`
+	// Position is the 1-based order of a citation among the feature's cited
+	// sources; 0 for plain links.
+	Position int `json:"position,omitempty"`
 }
 
 // SerpFeature is a normalized non-organic SERP module surfaced separately
 // from rankable results.
 type SerpFeature struct {
-	ID              string        `json:"id"`
-	Engine          string        `json:"engine"`
-	Type            ResultType    `json:"type"`
-	Title           string        `json:"title,omitempty"`
-	Text            string        `json:"text,omitempty"`
-	Items           []FeatureItem `json:"items,omitempty"`
-	Links           []FeatureLink `json:"links,omitempty"`
-	SourceResultIDs []string      `json:"source_result_ids,omitempty"`
-	Position        *Position     `json:"position,omitempty"`
-	Confidence      float64       `json:"confidence,omitempty"`
-	ExtractedAt     string        `json:"extracted_at"`
+	ID              string         `json:"id"`
+	Engine          string         `json:"engine"`
+	Type            ResultType     `json:"type"`
+	Title           string         `json:"title,omitempty"`
+	Text            string         `json:"text,omitempty"`
+	Items           []FeatureItem  `json:"items,omitempty"`
+	Links           []FeatureLink  `json:"links,omitempty"`
+	Knowledge       *KnowledgeMeta `json:"knowledge,omitempty"`
+	SourceResultIDs []string       `json:"source_result_ids,omitempty"`
+	Position        *Position      `json:"position,omitempty"`
+	Confidence      float64        `json:"confidence,omitempty"`
+	ExtractedAt     string         `json:"extracted_at"`
+	// Layout is the module's box on a browser-rendered SERP; it surfaces as
+	// the pixel fields of Position.
+	Layout *LayoutBox `json:"-"`
 }
 
 // Result is the v2 normalized result returned in search responses. Optional
-// fields (Position, DomainInfo, Classification) are omitted when empty.
+// fields (Position, DomainInfo, Classification, Provenance and the nested
+// Sitelinks, RichSnippet and Breadcrumbs) are omitted when empty.
 type Result struct {
 	ID             string            `json:"id"`
 	Rank           int               `json:"rank"`
@@ -107,6 +141,10 @@ type Result struct {
 	DomainInfo     *DomainInfo       `json:"domain_info,omitempty"`
 	Classification *Classification   `json:"classification,omitempty"`
 	Extracted      *ExtractedContent `json:"extracted,omitempty"`
+	Provenance     *Provenance       `json:"provenance,omitempty"`
+	Sitelinks      []Sitelink        `json:"sitelinks,omitempty"`
+	RichSnippet    *RichSnippet      `json:"rich_snippet,omitempty"`
+	Breadcrumbs    []string          `json:"breadcrumbs,omitempty"`
 }
 
 // ImageData holds image-specific URL and dimension fields.
@@ -125,11 +163,128 @@ type ImageSource struct {
 
 // ImageResult is the v2 shape for image search results.
 type ImageResult struct {
-	ID     string      `json:"id"`
-	Rank   int         `json:"rank"`
-	Type   ResultType  `json:"type"`
-	Title  string      `json:"title"`
-	Image  ImageData   `json:"image"`
-	Source ImageSource `json:"source"`
-	Engine string      `json:"engine"`
+	ID         string      `json:"id"`
+	Rank       int         `json:"rank"`
+	Type       ResultType  `json:"type"`
+	Title      string      `json:"title"`
+	Image      ImageData   `json:"image"`
+	Source     ImageSource `json:"source"`
+	Engine     string      `json:"engine"`
+	Provenance *Provenance `json:"provenance,omitempty"`
+}
+
+// NewsResult is the v2 shape for news search results. PublishedAt is RFC3339
+// and omitted when the engine showed no parseable timestamp.
+type NewsResult struct {
+	ID          string      `json:"id"`
+	Rank        int         `json:"rank"`
+	Type        ResultType  `json:"type"`
+	Title       string      `json:"title"`
+	URL         string      `json:"url"`
+	Snippet     string      `json:"snippet"`
+	Domain      string      `json:"domain"`
+	Source      string      `json:"source,omitempty"`
+	PublishedAt string      `json:"published_at,omitempty"`
+	Thumbnail   string      `json:"thumbnail,omitempty"`
+	Position    *Position   `json:"position,omitempty"`
+	Engine      string      `json:"engine"`
+	Provenance  *Provenance `json:"provenance,omitempty"`
+}
+
+// VideoResult is the v2 shape for video search results. URL is the watch
+// page; Duration is the clock form ("12:34") and DurationSeconds its value.
+type VideoResult struct {
+	ID              string      `json:"id"`
+	Rank            int         `json:"rank"`
+	Type            ResultType  `json:"type"`
+	Title           string      `json:"title"`
+	URL             string      `json:"url"`
+	Snippet         string      `json:"snippet,omitempty"`
+	Domain          string      `json:"domain"`
+	Thumbnail       string      `json:"thumbnail,omitempty"`
+	Duration        string      `json:"duration,omitempty"`
+	DurationSeconds int         `json:"duration_seconds,omitempty"`
+	Channel         string      `json:"channel,omitempty"`
+	Platform        string      `json:"platform,omitempty"`
+	PublishedAt     string      `json:"published_at,omitempty"`
+	Position        *Position   `json:"position,omitempty"`
+	Engine          string      `json:"engine"`
+	Provenance      *Provenance `json:"provenance,omitempty"`
+}
+
+// ProductResult is the v2 shape for shopping search results. URL is the offer
+// page; Price is in Currency units and omitted when the card showed none.
+type ProductResult struct {
+	ID          string      `json:"id"`
+	Rank        int         `json:"rank"`
+	Type        ResultType  `json:"type"`
+	Title       string      `json:"title"`
+	URL         string      `json:"url"`
+	Domain      string      `json:"domain"`
+	Price       float64     `json:"price,omitempty"`
+	Currency    string      `json:"currency,omitempty"`
+	Merchant    string      `json:"merchant,omitempty"`
+	Rating      float64     `json:"rating,omitempty"`
+	ReviewCount int         `json:"review_count,omitempty"`
+	Thumbnail   string      `json:"thumbnail,omitempty"`
+	Position    *Position   `json:"position,omitempty"`
+	Engine      string      `json:"engine"`
+	Provenance  *Provenance `json:"provenance,omitempty"`
+}
+
+// LocalResult is one business listing in the v2 local search response
+// shape. URL is the business website when known, otherwise its map link.
+type LocalResult struct {
+	ID          string      `json:"id"`
+	Rank        int         `json:"rank"`
+	Type        ResultType  `json:"type"`
+	Title       string      `json:"title"`
+	URL         string      `json:"url"`
+	Domain      string      `json:"domain,omitempty"`
+	Rating      float64     `json:"rating,omitempty"`
+	ReviewCount int         `json:"review_count,omitempty"`
+	Category    string      `json:"category,omitempty"`
+	Address     string      `json:"address,omitempty"`
+	Phone       string      `json:"phone,omitempty"`
+	Hours       string      `json:"hours,omitempty"`
+	Website     string      `json:"website,omitempty"`
+	MapURL      string      `json:"map_url,omitempty"`
+	Position    *Position   `json:"position,omitempty"`
+	Engine      string      `json:"engine"`
+	Provenance  *Provenance `json:"provenance,omitempty"`
+}
+
+// ScholarResult is the v2 shape for scholar search results. URL is the
+// paper's landing page; PDFURL links the full text when the engine found a
+// copy. Extracted holds the fetched paper when the request set extract.
+type ScholarResult struct {
+	ID          string            `json:"id"`
+	Rank        int               `json:"rank"`
+	Type        ResultType        `json:"type"`
+	Title       string            `json:"title"`
+	URL         string            `json:"url"`
+	Snippet     string            `json:"snippet,omitempty"`
+	Domain      string            `json:"domain"`
+	Authors     []string          `json:"authors,omitempty"`
+	Venue       string            `json:"venue,omitempty"`
+	Year        int               `json:"year,omitempty"`
+	CitedBy     int               `json:"cited_by,omitempty"`
+	CitedByURL  string            `json:"cited_by_url,omitempty"`
+	PDFURL      string            `json:"pdf_url,omitempty"`
+	Versions    int               `json:"versions,omitempty"`
+	VersionsURL string            `json:"versions_url,omitempty"`
+	Position    *Position         `json:"position,omitempty"`
+	Engine      string            `json:"engine"`
+	Extracted   *ExtractedContent `json:"extracted,omitempty"`
+	Provenance  *Provenance       `json:"provenance,omitempty"`
+}
+
+// SuggestionResult is one autocomplete suggestion in the v2 response shape.
+type SuggestionResult struct {
+	Text   string `json:"text"`
+	Rank   int    `json:"rank"`
+	Engine string `json:"engine"`
+	// Engines lists every engine that suggested the phrase; set only on
+	// deduplicated /mega/suggest results.
+	Engines []string `json:"engines,omitempty"`
 }
@@ -0,0 +1,282 @@
+package core
+
+import (
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-rod/rod"
+)
+
+// Sitelink is one deep link an engine nests under an organic result, such as
+// the "Docs · Download · Blog" row below a site's home page.
+type Sitelink struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Snippet string `json:"snippet,omitempty"`
+}
+
+// RichSnippet is the structured data an engine prints inside an organic
+// snippet: review stars, a price, the page date and inline FAQ rows.
+type RichSnippet struct {
+	// Rating is the average star rating on a 5-point scale.
+	Rating      float64 `json:"rating,omitempty"`
+	ReviewCount int     `json:"review_count,omitempty"`
+	// Price is zero when the snippet showed no parseable price; Currency is
+	// the ISO 4217 code derived from its symbol.
+	Price    float64 `json:"price,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+	// Date is the page date as shown ("Mar 5, 2024", "3 days ago") and
+	// PublishedAt its RFC3339 form. Text that does not parse as a date is
+	// dropped.
+	Date        string `json:"date,omitempty"`
+	PublishedAt string `json:"published_at,omitempty"`
+	// FAQ lists inline question rows; each item's Title is the question and
+	// Text the answer.
+	FAQ []FeatureItem `json:"faq,omitempty"`
+}
+
+// ResultExtrasSelectors locates the structured parts engines nest inside an
+// organic result block. Every field is optional; engines set the ones their
+// markup has.
+type ResultExtrasSelectors struct {
+	// Scope widens the lookup to the closest ancestor of the result block
+	// matching it, for layouts that render sitelinks beside the block.
+	Scope string
+	// Sitelink matches one sitelink; SitelinkLink and SitelinkSnippet are
+	// looked up inside it. A match that is itself an anchor is the link.
+	Sitelink        string
+	SitelinkLink    string
+	SitelinkSnippet string
+	// Breadcrumb is the display path, for example "example.com › docs › api".
+	Breadcrumb string
+	// RichLine is a combined "Rating: 4.5 · 120 reviews · $19.99" line; its
+	// parts are told apart like the separate fields below.
+	RichLine string
+	Rating   string
+	Reviews  string
+	Price    string
+	Date     string
+	// FAQ matches one inline question row; FAQQuestion and FAQAnswer are
+	// looked up inside it.
+	FAQ         string
+	FAQQuestion string
+	FAQAnswer   string
+}
+
+// breadcrumbSeparators split a display path into its parts.
+var breadcrumbSeparators = []string{"›", "»", " > "}
+
+// snippetDateSeparators end the date engines print at the start of a
+// snippet: "Mar 5, 2024 — Learn how to ...".
+var snippetDateSeparators = []string{"—", "·", " - ", "..."}
+
+// reviewMarkers identify the review-count part of a rich line. Only the
+// plural "ratings" counts: "4.8 rating" and "Rating 4.5" are ratings.
+var reviewMarkers = []string{"review", "vote", "ratings", "bewertung", "avis", "reseña", "отзыв", "оцен", "評価", "评价", "评论", "件の"}
+
+// ApplyResultExtras fills res.Sitelinks, res.Breadcrumbs and res.RichSnippet
+// from the organic result block item. resolve turns raw sitelink hrefs into
+// absolute URLs and may return "" to drop one; nil keeps hrefs as they are.
+func ApplyResultExtras(res *SearchResult, item *goquery.Selection, sel ResultExtrasSelectors, resolve func(string) string) {
+	if res == nil || item == nil || item.Length() == 0 {
+		return
+	}
+	scope := item
+	if sel.Scope != "" {
+		if closest := item.Closest(sel.Scope); closest.Length() > 0 {
+			scope = closest
+		}
+	}
+
+	res.Sitelinks = parseSitelinks(scope, sel, res.URL, resolve)
+	if sel.Breadcrumb != "" {
+		res.Breadcrumbs = ParseBreadcrumbs(scope.Find(sel.Breadcrumb).First().Text())
+	}
+	res.RichSnippet = parseRichSnippet(scope, sel, res.Description)
+}
+
+// ApplyElementExtras is ApplyResultExtras for the browser path: it snapshots
+// the rod result element (or its Scope ancestor) and parses the snapshot
+// with the same selectors.
+func ApplyElementExtras(res *SearchResult, el *rod.Element, sel ResultExtrasSelectors, resolve func(string) string) {
+	if res == nil || el == nil || sel == (ResultExtrasSelectors{}) {
+		return
+	}
+	root := el
+	if sel.Scope != "" {
+		if parents, err := el.Parents(sel.Scope); err == nil && len(parents) > 0 {
+			root = parents.First()
+		}
+	}
+	html, err := root.HTML()
+	if err != nil {
+		return
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return
+	}
+	ApplyResultExtras(res, doc.Find("body").Children().First(), sel, resolve)
+}
+
+func parseSitelinks(scope *goquery.Selection, sel ResultExtrasSelectors, resultURL string, resolve func(string) string) []Sitelink {
+	if sel.Sitelink == "" {
+		return nil
+	}
+	var sitelinks []Sitelink
+	seen := map[string]bool{resultURL: true}
+	scope.Find(sel.Sitelink).Each(func(_ int, node *goquery.Selection) {
+		link := node
+		if sel.SitelinkLink != "" && !node.Is("a") {
+			link = node.Find(sel.SitelinkLink).First()
+		}
+		href := strings.TrimSpace(link.AttrOr("href", ""))
+		if href != "" && resolve != nil {
+			href = resolve(href)
+		}
+		title := NormalizeWhitespace(firstNonEmpty(link.Text(), link.AttrOr("aria-label", "")))
+		if !strings.HasPrefix(href, "http") || title == "" || seen[href] {
+			return
+		}
+		seen[href] = true
+		sitelink := Sitelink{Title: title, URL: href}
+		if sel.SitelinkSnippet != "" {
+			sitelink.Snippet = NormalizeWhitespace(node.Find(sel.SitelinkSnippet).First().Text())
+		}
+		sitelinks = append(sitelinks, sitelink)
+	})
+	return sitelinks
+}
+
+// ParseBreadcrumbs splits a display path such as "https://example.com › docs
+// › api" into its parts. Ellipsis-only parts are dropped, and text without a
+// separator is not a path, so it returns nil.
+func ParseBreadcrumbs(text string) []string {
+	text = NormalizeWhitespace(text)
+	separator := ""
+	for _, candidate := range breadcrumbSeparators {
+		if strings.Contains(text, candidate) {
+			separator = candidate
+			break
+		}
+	}
+	if separator == "" {
+		return nil
+	}
+	var parts []string
+	for _, part := range strings.Split(text, separator) {
+		part = strings.TrimSpace(part)
+		if strings.Trim(part, ".…") == "" {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) < 2 {
+		return nil
+	}
+	return parts
+}
+
+func parseRichSnippet(scope *goquery.Selection, sel ResultExtrasSelectors, description string) *RichSnippet {
+	meta := &RichSnippet{}
+	if sel.RichLine != "" {
+		for _, part := range strings.FieldsFunc(scope.Find(sel.RichLine).First().Text(), func(r rune) bool { return r == '·' || r == '•' || r == '|' }) {
+			ApplyRichSnippetPart(meta, part)
+		}
+	}
+	if sel.Rating != "" {
+		rating := scope.Find(sel.Rating).First()
+		if value := ParseRating(firstNonEmpty(rating.AttrOr("aria-label", ""), rating.Text())); value > 0 {
+			meta.Rating = value
+		}
+	}
+	if sel.Reviews != "" {
+		if count := ParseReviewCount(scope.Find(sel.Reviews).First().Text()); count > 0 {
+			meta.ReviewCount = count
+		}
+	}
+	if sel.Price != "" {
+		if price, currency, ok := ParsePrice(scope.Find(sel.Price).First().Text()); ok && currency != "" {
+			meta.Price, meta.Currency = price, currency
+		}
+	}
+	if sel.Date != "" {
+		if date := strings.Trim(NormalizeWhitespace(scope.Find(sel.Date).First().Text()), " ·—-"); date != "" {
+			meta.Date = date
+		}
+	}
+	if meta.Date == "" {
+		meta.Date = snippetDate(description)
+	}
+	if meta.Date != "" {
+		// Date selectors also match the first snippet span on undated rows, so
+		// only text that reads as a date is kept.
+		if published, ok := ParsePublishedTime(meta.Date, time.Now()); ok {
+			meta.PublishedAt = published.Format(time.RFC3339)
+		} else {
+			meta.Date = ""
+		}
+	}
+	if sel.FAQ != "" {
+		scope.Find(sel.FAQ).Each(func(_ int, row *goquery.Selection) {
+			question := NormalizeWhitespace(row.Find(sel.FAQQuestion).First().Text())
+			if question == "" {
+				return
+			}
+			meta.FAQ = append(meta.FAQ, FeatureItem{
+				Title: question,
+				Text:  NormalizeWhitespace(row.Find(sel.FAQAnswer).First().Text()),
+			})
+		})
+	}
+
+	if meta.Rating == 0 && meta.ReviewCount == 0 && meta.Price == 0 && meta.Date == "" && len(meta.FAQ) == 0 {
+		return nil
+	}
+	return meta
+}
+
+// ApplyRichSnippetPart files one part of a rich line into meta: a rating
+// ("Rating: 4.5", "4,7/5"), a review count ("1,234 reviews"), a price
+// ("$19.99") or a date. Unrecognised parts are ignored.
+func ApplyRichSnippetPart(meta *RichSnippet, part string) {
+	part = NormalizeWhitespace(part)
+	lower := strings.ToLower(part)
+	switch {
+	case part == "":
+	case containsAny(lower, reviewMarkers) && !strings.Contains(lower, ":") && meta.ReviewCount == 0:
+		meta.ReviewCount = ParseReviewCount(part)
+	case (strings.Contains(lower, "rating") || strings.Contains(lower, "★") || strings.Contains(lower, "/5") || strings.Contains(lower, "рейтинг")) && meta.Rating == 0:
+		value := part
+		if _, after, found := strings.Cut(part, ":"); found {
+			value = after
+		}
+		meta.Rating = ParseRating(strings.TrimSpace(value))
+	case PriceCurrency(part) != "" && meta.Price == 0:
+		if price, currency, ok := ParsePrice(part); ok {
+			meta.Price, meta.Currency = price, currency
+		}
+	case meta.Date == "":
+		if _, ok := ParsePublishedTime(part, time.Now()); ok {
+			meta.Date = part
+		}
+	}
+}
+
+// snippetDate returns the date an engine printed at the start of the snippet
+// ("Mar 5, 2024 — ..."), or "" when the snippet does not start with one.
+func snippetDate(description string) string {
+	description = NormalizeWhitespace(description)
+	for _, separator := range snippetDateSeparators {
+		head, _, found := strings.Cut(description, separator)
+		head = strings.TrimSpace(head)
+		if !found || head == "" || len(head) > 32 {
+			continue
+		}
+		if _, ok := ParsePublishedTime(head, time.Now()); ok {
+			return head
+		}
+	}
+	return ""
+}
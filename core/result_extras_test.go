@@ -0,0 +1,131 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParseBreadcrumbs(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string][]string{
+		"https://example.com › docs › api":         {"https://example.com", "docs", "api"},
+		"developer.mozilla.org›en-US/docs/Fetch_…": {"developer.mozilla.org", "en-US/docs/Fetch_…"},
+		"example.com » blog » 2024":                {"example.com", "blog", "2024"},
+		"http://test.test› ...":                    nil,
+		"Ca. 372.260 Aufrufe · vor 2 Jahren":       nil,
+		"example.com":                              nil,
+	}
+	for text, want := range cases {
+		if got := ParseBreadcrumbs(text); !reflect.DeepEqual(got, want) {
+			t.Fatalf("ParseBreadcrumbs(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestApplyResultExtras(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="wrap">
+<div class="result">
+  <a href="https://example.com/"><h3>Example</h3></a>
+  <cite>https://example.com › shop › widgets</cite>
+  <div class="meta">Rating: 4.5 · 1,234 reviews · $19.99</div>
+  <div class="faq"><b>Is it free?</b><p>Yes, for personal use.</p></div>
+</div>
+<ul class="links">
+  <li><a href="/docs">Docs</a><span>Read the manual</span></li>
+  <li><a href="https://example.com/">Example</a></li>
+  <li><a href="/docs">Docs again</a></li>
+  <li><a href="javascript:void(0)">Menu</a></li>
+</ul>
+</div>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	sel := ResultExtrasSelectors{
+		Scope:           "div.wrap",
+		Sitelink:        "ul.links li",
+		SitelinkLink:    "a",
+		SitelinkSnippet: "span",
+		Breadcrumb:      "cite",
+		RichLine:        "div.meta",
+		FAQ:             "div.faq",
+		FAQQuestion:     "b",
+		FAQAnswer:       "p",
+	}
+	res := SearchResult{URL: "https://example.com/", Description: "Mar 5, 2024 — Widgets for every budget."}
+	ApplyResultExtras(&res, doc.Find("div.result"), sel, func(href string) string {
+		if strings.HasPrefix(href, "/") {
+			return "https://example.com" + href
+		}
+		return href
+	})
+
+	wantLinks := []Sitelink{{Title: "Docs", URL: "https://example.com/docs", Snippet: "Read the manual"}}
+	if !reflect.DeepEqual(res.Sitelinks, wantLinks) {
+		t.Fatalf("sitelinks must skip the result URL, duplicates and script links, got %+v", res.Sitelinks)
+	}
+	if !reflect.DeepEqual(res.Breadcrumbs, []string{"https://example.com", "shop", "widgets"}) {
+		t.Fatalf("unexpected breadcrumbs: %q", res.Breadcrumbs)
+	}
+	rich := res.RichSnippet
+	if rich == nil || rich.Rating != 4.5 || rich.ReviewCount != 1234 || rich.Price != 19.99 || rich.Currency != "USD" {
+		t.Fatalf("unexpected rich snippet: %+v", rich)
+	}
+	if rich.Date != "Mar 5, 2024" || rich.PublishedAt != "2024-03-05T00:00:00Z" {
+		t.Fatalf("expected the leading snippet date, got %q / %q", rich.Date, rich.PublishedAt)
+	}
+	if len(rich.FAQ) != 1 || rich.FAQ[0].Title != "Is it free?" || rich.FAQ[0].Text != "Yes, for personal use." {
+		t.Fatalf("unexpected FAQ rows: %+v", rich.FAQ)
+	}
+
+	plain := SearchResult{URL: "https://example.com/", Description: "In 2020, OpenAI · released a model."}
+	ApplyResultExtras(&plain, doc.Find("div.result"), ResultExtrasSelectors{Date: "h3"}, nil)
+	if plain.RichSnippet != nil || plain.Sitelinks != nil || plain.Breadcrumbs != nil {
+		t.Fatalf("expected no extras for an undated plain result, got %+v", plain)
+	}
+}
+
+func TestApplyRichSnippetPart(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		part string
+		want RichSnippet
+	}{
+		{"4.8 rating", RichSnippet{Rating: 4.8}},
+		{"Rating 4.5", RichSnippet{Rating: 4.5}},
+		{"Rating: 4,7/5", RichSnippet{Rating: 4.7}},
+		{"1,234 ratings", RichSnippet{ReviewCount: 1234}},
+		{"56 reviews", RichSnippet{ReviewCount: 56}},
+		{"$19.99", RichSnippet{Price: 19.99, Currency: "USD"}},
+	}
+	for _, tc := range cases {
+		var got RichSnippet
+		ApplyRichSnippetPart(&got, tc.part)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ApplyRichSnippetPart(%q) = %+v, want %+v", tc.part, got, tc.want)
+		}
+	}
+}
+
+func TestEnrichResultCopiesResultExtras(t *testing.T) {
+	t.Parallel()
+
+	raw := SearchResult{
+		Rank:        1,
+		URL:         "https://example.com/",
+		Title:       "Example",
+		Sitelinks:   []Sitelink{{Title: "Docs", URL: "https://example.com/docs"}},
+		Breadcrumbs: []string{"example.com", "docs"},
+		RichSnippet: &RichSnippet{Rating: 4.5},
+	}
+	result := EnrichResult(raw, EnrichContext{Engine: "google"})
+	if len(result.Sitelinks) != 1 || len(result.Breadcrumbs) != 2 || result.RichSnippet == nil || result.RichSnippet.Rating != 4.5 {
+		t.Fatalf("expected sitelinks, breadcrumbs and rich snippet on the result, got %+v", result)
+	}
+}
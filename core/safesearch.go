@@ -0,0 +1,52 @@
+package core
+
+import "fmt"
+
+// SafeSearch is the adult-content filter level requested for a query. The
+// zero value sends nothing, leaving each engine on its own market default.
+type SafeSearch string
+
+const (
+	SafeSearchOff      SafeSearch = "off"
+	SafeSearchModerate SafeSearch = "moderate"
+	SafeSearchStrict   SafeSearch = "strict"
+)
+
+// ParseSafeSearch validates a safe= value. An empty value is accepted and
+// means the engine default.
+func ParseSafeSearch(raw string) (SafeSearch, error) {
+	switch level := SafeSearch(raw); level {
+	case "", SafeSearchOff, SafeSearchModerate, SafeSearchStrict:
+		return level, nil
+	}
+	return "", fmt.Errorf("unknown safe level %q: accepted values are off, moderate, strict", raw)
+}
+
+// SafeSearchSupporter is implemented by engines (or their raw and pooled
+// wrappers) that can map a SafeSearch level onto their own URL parameters.
+type SafeSearchSupporter interface {
+	SupportsSafeSearch(SafeSearch) bool
+}
+
+// EngineSupportsSafeSearch reports whether engine honours level. The engine
+// default always counts as honoured; an engine that does not implement
+// SafeSearchSupporter honours no explicit level.
+func EngineSupportsSafeSearch(engine SearchEngine, level SafeSearch) bool {
+	if level == "" {
+		return true
+	}
+	supporter, ok := engine.(SafeSearchSupporter)
+	return ok && supporter.SupportsSafeSearch(level)
+}
+
+// SafeSearchUnsupported lists the engines that cannot honour level, for the
+// query echo. It returns nil when every engine can.
+func SafeSearchUnsupported(engines []SearchEngine, level SafeSearch) []string {
+	var names []string
+	for _, engine := range engines {
+		if !EngineSupportsSafeSearch(engine, level) {
+			names = append(names, engine.Name())
+		}
+	}
+	return names
+}
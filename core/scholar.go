@@ -0,0 +1,105 @@
+package core
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ScholarMeta is the citation data a scholarly search engine shows for each
+// paper. It rides on SearchResult for scholar-tab results.
+type ScholarMeta struct {
+	Authors []string `json:"authors,omitempty"`
+	// Venue is the journal, conference or book the paper appeared in.
+	Venue string `json:"venue,omitempty"`
+	Year  int    `json:"year,omitempty"`
+	// CitedBy is the engine's citation count; CitedByURL lists the citing
+	// papers.
+	CitedBy    int    `json:"cited_by,omitempty"`
+	CitedByURL string `json:"cited_by_url,omitempty"`
+	// PDFURL is a direct full-text link when the engine found one.
+	PDFURL string `json:"pdf_url,omitempty"`
+	// Versions counts the copies the engine clustered; VersionsURL lists
+	// them.
+	Versions    int    `json:"versions,omitempty"`
+	VersionsURL string `json:"versions_url,omitempty"`
+}
+
+var scholarYearPattern = regexp.MustCompile(`\b(1[5-9]\d{2}|20\d{2})\b`)
+
+// ParseScholarByline splits a Google Scholar style byline such as
+// "A Vaswani, N Shazeer… - Advances in neural information processing
+// systems, 2017 - proceedings.neurips.cc" into authors, venue and year. The
+// trailing host part is ignored; the venue is empty when the middle part
+// holds only the year.
+func ParseScholarByline(text string) (authors []string, venue string, year int) {
+	parts := strings.Split(NormalizeWhitespace(strings.ReplaceAll(text, " ", " ")), " - ")
+	if len(parts) == 0 || parts[0] == "" {
+		return nil, "", 0
+	}
+	authors = SplitScholarAuthors(parts[0])
+	if len(parts) < 2 {
+		return authors, "", 0
+	}
+
+	venue, year = splitScholarVenueYear(parts[1])
+	return authors, venue, year
+}
+
+// SplitScholarAuthors splits an author list on commas, dropping the "…"
+// Google appends to truncated lists.
+func SplitScholarAuthors(text string) []string {
+	var authors []string
+	for _, name := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == '，' || r == ';' }) {
+		name = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(name), "…."))
+		if name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+// splitScholarVenueYear reads "Nature, 2015" or "2015" into venue and year.
+func splitScholarVenueYear(text string) (string, int) {
+	text = strings.TrimSpace(text)
+	matches := scholarYearPattern.FindAllStringIndex(text, -1)
+	if len(matches) == 0 {
+		return strings.TrimRight(text, "…"), 0
+	}
+	last := matches[len(matches)-1]
+	year, _ := strconv.Atoi(text[last[0]:last[1]])
+	venue := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(text[:last[0]]), ",，"))
+	return strings.TrimRight(venue, "…"), year
+}
+
+// ParseScholarYear returns the last plausible publication year in text, or 0.
+func ParseScholarYear(text string) int {
+	_, year := splitScholarVenueYear(text)
+	return year
+}
+
+// ParseScholarCount reads the number from labels like "Cited by 1,234",
+// "All 12 versions" or "被引量：356".
+func ParseScholarCount(text string) int {
+	return int(ParseResultCount(text))
+}
+
+// ScholarYearRange converts a DateInterval ("20170101..20201231") into the
+// inclusive publication year range scholarly engines filter by.
+func ScholarYearRange(dateInterval string) (from, to int, err error) {
+	bounds := strings.Split(dateInterval, "..")
+	if len(bounds) != 2 || len(bounds[0]) < 4 || len(bounds[1]) < 4 {
+		return 0, 0, errors.New("incorrect date interval provided")
+	}
+	if from, err = strconv.Atoi(bounds[0][:4]); err != nil {
+		return 0, 0, errors.New("incorrect date interval provided")
+	}
+	if to, err = strconv.Atoi(bounds[1][:4]); err != nil {
+		return 0, 0, errors.New("incorrect date interval provided")
+	}
+	if from > to {
+		return 0, 0, errors.New("incorrect date interval provided")
+	}
+	return from, to, nil
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseScholarByline(t *testing.T) {
+	t.Parallel()
+
+	authors, venue, year := ParseScholarByline("A Vaswani, N Shazeer, N Parmar… - Advances in neural information processing systems, 2017 - proceedings.neurips.cc")
+	if !reflect.DeepEqual(authors, []string{"A Vaswani", "N Shazeer", "N Parmar"}) || venue != "Advances in neural information processing systems" || year != 2017 {
+		t.Fatalf("unexpected byline parse: %v %q %d", authors, venue, year)
+	}
+
+	authors, venue, year = ParseScholarByline("Y LeCun, Y Bengio, G Hinton - 2015 - nature.com")
+	if len(authors) != 3 || venue != "" || year != 2015 {
+		t.Fatalf("expected year-only venue part, got %v %q %d", authors, venue, year)
+	}
+
+	if authors, venue, year = ParseScholarByline("J Smith"); len(authors) != 1 || venue != "" || year != 0 {
+		t.Fatalf("expected authors only, got %v %q %d", authors, venue, year)
+	}
+}
+
+func TestScholarYearRange(t *testing.T) {
+	t.Parallel()
+
+	from, to, err := ScholarYearRange("20170301..20201231")
+	if err != nil || from != 2017 || to != 2020 {
+		t.Fatalf("ScholarYearRange() = %d, %d, %v", from, to, err)
+	}
+	for _, bad := range []string{"2017", "2017..", "abcd0101..20200101", "20200101..20170101"} {
+		if _, _, err := ScholarYearRange(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestParseScholarCount(t *testing.T) {
+	t.Parallel()
+
+	for text, want := range map[string]int{
+		"Cited by 152,341": 152341,
+		"All 71 versions":  71,
+		"被引量：356":          356,
+		"Related articles": 0,
+	} {
+		if got := ParseScholarCount(text); got != want {
+			t.Fatalf("ParseScholarCount(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
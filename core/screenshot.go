@@ -0,0 +1,110 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/karust/openserp/core/fpcheck"
+)
+
+// ScreenshotMode selects what screenshot= captures of a browser-rendered
+// SERP. The zero value captures nothing.
+type ScreenshotMode string
+
+const (
+	ScreenshotFull     ScreenshotMode = "full"
+	ScreenshotViewport ScreenshotMode = "viewport"
+)
+
+// ArtifactKindScreenshot is the Artifact.Kind of SERP screenshots.
+const ArtifactKindScreenshot = "screenshot"
+
+// ParseScreenshotMode validates a screenshot= value. An empty value is
+// accepted and disables capture.
+func ParseScreenshotMode(raw string) (ScreenshotMode, error) {
+	switch mode := ScreenshotMode(raw); mode {
+	case "", ScreenshotFull, ScreenshotViewport:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown screenshot mode %q: accepted values are full, viewport", raw)
+}
+
+// Screenshot is a PNG one engine captured during a request.
+type Screenshot struct {
+	Engine string
+	Data   []byte
+}
+
+type screenshotContextKey struct{}
+
+type screenshotTracker struct {
+	mode  ScreenshotMode
+	mu    sync.Mutex
+	shots map[string][]byte
+}
+
+// WithScreenshotCapture asks browser engines searching with ctx to capture
+// their SERP in mode. An empty mode returns ctx unchanged.
+func WithScreenshotCapture(ctx context.Context, mode ScreenshotMode) context.Context {
+	ctx = EnsureContext(ctx)
+	if mode == "" || screenshotTrackerFromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, screenshotContextKey{}, &screenshotTracker{mode: mode, shots: map[string][]byte{}})
+}
+
+// CaptureScreenshot records page for the engine in ctx when the request
+// asked for a screenshot. Engines call it once the results have loaded; a
+// later call for the same engine (a retry) replaces the earlier capture.
+// Capture failures are logged and never fail the search.
+func CaptureScreenshot(ctx context.Context, page *rod.Page) {
+	tracker := screenshotTrackerFromContext(ctx)
+	if tracker == nil || page == nil {
+		return
+	}
+	data, err := fpcheck.CaptureScreenshot(page, tracker.mode == ScreenshotFull)
+	if err != nil {
+		WithRequest(ctx).WithError(err).Warn("SERP screenshot failed")
+		return
+	}
+	recordScreenshot(ctx, data)
+}
+
+func recordScreenshot(ctx context.Context, data []byte) {
+	tracker := screenshotTrackerFromContext(ctx)
+	if tracker == nil {
+		return
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.shots[engineFromContext(ctx)] = data
+}
+
+// ScreenshotsFromContext returns the screenshots captured so far, sorted by
+// engine.
+func ScreenshotsFromContext(ctx context.Context) []Screenshot {
+	tracker := screenshotTrackerFromContext(ctx)
+	if tracker == nil {
+		return nil
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	out := make([]Screenshot, 0, len(tracker.shots))
+	for engine, data := range tracker.shots {
+		out = append(out, Screenshot{Engine: engine, Data: data})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Engine < out[j].Engine })
+	return out
+}
+
+func screenshotTrackerFromContext(ctx context.Context) *screenshotTracker {
+	if ctx == nil {
+		return nil
+	}
+	tracker, _ := ctx.Value(screenshotContextKey{}).(*screenshotTracker)
+	return tracker
+}
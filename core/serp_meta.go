@@ -0,0 +1,139 @@
+package core
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// SerpMeta is page-level information an engine prints around its results.
+// Every field is optional: engines and locales show different subsets.
+type SerpMeta struct {
+	// TotalResults is the engine's own estimate, e.g. "About 1,230,000
+	// results". It is an estimate, not a count of reachable results.
+	TotalResults int64 `json:"total_results,omitempty"`
+	// SearchTimeSeconds is the time the engine reports it took.
+	SearchTimeSeconds float64 `json:"search_time_seconds,omitempty"`
+	// CorrectedQuery is set when the engine replaced the query with a
+	// spelling correction ("Showing results for …"); SuggestedQuery when it
+	// only proposed one ("Did you mean …").
+	CorrectedQuery string `json:"corrected_query,omitempty"`
+	SuggestedQuery string `json:"suggested_query,omitempty"`
+	// EffectiveQuery is the query the engine ran, as shown in its search box
+	// or correction notice.
+	EffectiveQuery string `json:"effective_query,omitempty"`
+	// Locale is the SERP language from <html lang>, e.g. "de-RU".
+	Locale string `json:"locale,omitempty"`
+	// AISummary reports whether the engine's generative answer was present,
+	// absent or still loading when the page was read. Engines that never
+	// show one leave it empty.
+	AISummary AISummaryStatus `json:"ai_summary,omitempty"`
+	// Layout summarises where results and features were drawn. Browser mode
+	// only.
+	Layout *SerpLayout `json:"layout,omitempty"`
+}
+
+// IsEmpty reports whether no field was recovered.
+func (m *SerpMeta) IsEmpty() bool {
+	return m == nil || *m == SerpMeta{}
+}
+
+// AttachSerpMetaToFirstResult carries meta on the first result, the way
+// parsers carry SERP features, so it survives the []SearchResult interface.
+// Unlike features it never creates a carrier row: the locale is present on
+// every page, and a carrier would hide "no parseable results" from callers.
+func AttachSerpMetaToFirstResult(results []SearchResult, meta *SerpMeta) []SearchResult {
+	if meta.IsEmpty() || len(results) == 0 {
+		return results
+	}
+	results[0].SerpMeta = meta
+	return results
+}
+
+// FinishSerpMeta fills the fields every engine derives the same way: the
+// locale from <html lang>, and the effective query from a correction or the
+// search box value.
+func FinishSerpMeta(doc *goquery.Document, meta *SerpMeta, searchBoxQuery string) *SerpMeta {
+	if meta == nil {
+		meta = &SerpMeta{}
+	}
+	if meta.Locale == "" && doc != nil {
+		meta.Locale = strings.TrimSpace(doc.Find("html").First().AttrOr("lang", ""))
+	}
+	if meta.EffectiveQuery == "" {
+		meta.EffectiveQuery = meta.CorrectedQuery
+	}
+	if meta.EffectiveQuery == "" {
+		meta.EffectiveQuery = strings.TrimSpace(searchBoxQuery)
+	}
+	return meta
+}
+
+// resultCountPattern matches a number in a results line, including
+// thousands separators (",", ".", thin and non-breaking spaces) and an
+// optional magnitude word ("5 млн", "约1亿").
+var resultCountPattern = regexp.MustCompile(`(\d[\d,.\x{00a0}\x{202f} ]*\d|\d)\s*(тыс|млн|млрд|万|亿)?`)
+
+var resultCountMagnitudes = map[string]int64{
+	"тыс":  1_000,
+	"млн":  1_000_000,
+	"млрд": 1_000_000_000,
+	"万":    10_000,
+	"亿":    100_000_000,
+}
+
+// ParseResultCount extracts the total from a results line such as "About
+// 1,230,000 results", "Ungefähr 114.000.000 Ergebnisse", "Нашлось 5 млн
+// результатов" or "百度为您找到相关结果约100,000,000个". Later pages prefix the
+// line with the page number ("Page 2 of about 1,230,000 results"), so the
+// largest number wins. It returns 0 when the line holds no number.
+func ParseResultCount(text string) int64 {
+	var total int64
+	for _, match := range resultCountPattern.FindAllStringSubmatch(text, -1) {
+		total = max(total, parseResultCountMatch(match))
+	}
+	return total
+}
+
+// parseResultCountMatch converts one resultCountPattern match to a count.
+func parseResultCountMatch(match []string) int64 {
+	number := strings.TrimSpace(match[1])
+	multiplier, hasMagnitude := resultCountMagnitudes[match[2]]
+	if hasMagnitude {
+		// "1,5 млн" is a decimal, not a grouped integer.
+		value, err := strconv.ParseFloat(strings.ReplaceAll(number, ",", "."), 64)
+		if err != nil {
+			return 0
+		}
+		return int64(value * float64(multiplier))
+	}
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+	total, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return total
+}
+
+var searchTimePattern = regexp.MustCompile(`\d+(?:[.,]\d+)?`)
+
+// ParseSearchTime extracts the seconds from a timing line such as "(0.25
+// seconds)", "(0,25 Sek.)" or "0.42s". It returns 0 when there is no number.
+func ParseSearchTime(text string) float64 {
+	match := searchTimePattern.FindString(text)
+	if match == "" {
+		return 0
+	}
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", "."), 64)
+	if err != nil {
+		return 0
+	}
+	return seconds
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseResultCount(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		text string
+		want int64
+	}{
+		{"About 1,230,000 results", 1230000},
+		{"Ungefähr 114.000.000 Ergebnisse", 114000000},
+		{"Environ 2 340 000 résultats", 2340000},
+		{"Нашлось 5 млн результатов", 5000000},
+		{"Нашлось 1,5 тыс. результатов", 1500},
+		{"百度为您找到相关结果约100,000,000个", 100000000},
+		{"About 0 results", 0},
+		{"Page 2 of about 1,230,000 results (0.42 seconds)", 1230000},
+		{"Seite 3 von ungefähr 114.000.000 Ergebnissen", 114000000},
+		{"", 0},
+	}
+	for _, tc := range cases {
+		if got := ParseResultCount(tc.text); got != tc.want {
+			t.Errorf("ParseResultCount(%q) = %d, want %d", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestParseSearchTime(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]float64{
+		"(0.42 seconds)": 0.42,
+		"(0,25 Sek.)":    0.25,
+		"":               0,
+	}
+	for text, want := range cases {
+		if got := ParseSearchTime(text); got != want {
+			t.Errorf("ParseSearchTime(%q) = %v, want %v", text, got, want)
+		}
+	}
+}
+
+func TestAttachSerpMetaNeedsAResult(t *testing.T) {
+	t.Parallel()
+
+	meta := &SerpMeta{Locale: "en"}
+	if got := AttachSerpMetaToFirstResult(nil, meta); len(got) != 0 {
+		t.Fatalf("meta created a carrier row: %+v", got)
+	}
+	got := AttachSerpMetaToFirstResult([]SearchResult{{URL: "https://a.test"}}, meta)
+	if got[0].SerpMeta != meta {
+		t.Fatalf("meta not attached to first result: %+v", got[0])
+	}
+}
+
+func TestAppendEnrichedSearchResultCollectsSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	env := NewEnvelope(Query{Text: "golang"}, "req-1", time.Now(), []string{"google", "bing"})
+	first := &SerpMeta{TotalResults: 10, CorrectedQuery: "golang"}
+	AppendEnrichedSearchResult(env, SearchResult{Rank: 1, URL: "https://a.test", SerpMeta: first}, EnrichContext{Engine: "google"}, time.Now())
+	AppendEnrichedSearchResult(env, SearchResult{Rank: 2, URL: "https://b.test"}, EnrichContext{Engine: "google"}, time.Now())
+	AppendEnrichedSearchResult(env, SearchResult{Rank: 1, URL: "https://c.test", SerpMeta: &SerpMeta{}}, EnrichContext{Engine: "bing"}, time.Now())
+
+	if env.SerpMeta["google"] != first {
+		t.Fatalf("google meta = %+v, want %+v", env.SerpMeta["google"], first)
+	}
+	if _, ok := env.SerpMeta["bing"]; ok {
+		t.Fatal("empty bing meta should be dropped")
+	}
+	if env.Results[0].URL != "https://a.test" || len(env.Results) != 3 {
+		t.Fatalf("results changed by meta: %+v", env.Results)
+	}
+}
@@ -88,11 +88,12 @@ type ServerOptions struct {
 	// mega request exceeds this deadline, engines that have already
 	// responded contribute their results and slower engines are reported
 	// as failed with a context-deadline error. Zero disables the bound
-	// (legacy behavior — wait until the slowest engine finishes).
+	// (legacy behavior — wait until the slowest engine finishes). It also
+	// bounds /research/expand, which returns the partial tree on expiry.
 	MegaTimeout time.Duration
 	// RequestTimeout bounds wall-clock time of any request that does not
-	// manage its own deadline budget (/mega/* uses MegaTimeout, /extract has
-	// a per-batch budget). Exceeding it returns 504 request_timeout. Zero
+	// manage its own deadline budget (/mega/* and /research/* use
+	// MegaTimeout, /extract has a per-batch budget). Exceeding it returns 504 request_timeout. Zero
 	// disables the bound. The serve command derives it from the engine
 	// timeout and retry budget via RequestTimeoutForRetries instead of
 	// exposing a separate config knob.
@@ -101,6 +102,14 @@ type ServerOptions struct {
 	BrowserResolver BrowserResolver
 	// Extract configures the URL extraction endpoint and search enrichment.
 	Extract extractpkg.Config
+	// Artifacts stores screenshot= captures served by GET /artifacts/{id}.
+	// Nil uses a LocalArtifactStore in DefaultArtifactDir.
+	Artifacts ArtifactStore
+	// ArchiveMode selects which web searches keep their SERP documents in
+	// Archive for POST /replay/{request_id}. Nil Archive uses a
+	// LocalPageArchive in DefaultArchiveDir.
+	ArchiveMode ArchiveMode
+	Archive     PageArchive
 }
 
 type BrowserResolver func(proxyURL string) (*Browser, error)
@@ -158,6 +167,15 @@ func NewServerWithOptions(host string, port int, opts ServerOptions, searchEngin
 	if opts.AllowEndpointFallback {
 		logrus.Warn("Dedicated endpoint fallback is enabled")
 	}
+	if serv.opts.Artifacts == nil {
+		serv.opts.Artifacts = NewLocalArtifactStore(DefaultArtifactDir, DefaultArtifactTTL)
+	}
+	if serv.opts.Archive == nil {
+		serv.opts.Archive = NewLocalPageArchive(DefaultArchiveDir, DefaultArchiveTTL)
+	}
+	if opts.ArchiveMode != ArchiveOff {
+		logrus.WithField("archive_mode", opts.ArchiveMode).Info("SERP archive enabled")
+	}
 	if opts.CacheTTL > 0 && opts.CacheMaxSize > 0 {
 		serv.cache = NewResponseCache(opts.CacheTTL, opts.CacheMaxSize)
 		logrus.WithFields(logrus.Fields{
@@ -188,6 +206,8 @@ func NewServerWithOptions(host string, port int, opts ServerOptions, searchEngin
 	app.Get("/stats/cache", serv.handleCacheStats)
 	app.Get("/stats/proxy", serv.handleProxyStats)
 	app.Get("/stats/cb", serv.handleCircuitBreakerStats)
+	app.Get("/artifacts/:id", serv.handleArtifact)
+	app.Post("/replay/:request_id", serv.handleReplay)
 	if opts.EnableDebugEndpoints {
 		app.Get("/debug/fingerprint-check", serv.handleFingerprintCheck)
 	}
@@ -197,18 +217,19 @@ func NewServerWithOptions(host string, port int, opts ServerOptions, searchEngin
 
 		endpointName := engineEndpointName(locEngine.Name())
 
-		searchHandler := func(c *fiber.Ctx) error {
-			return serv.handleDedicatedEndpoint(c, locEngine, false)
-		}
-		imageHandler := func(c *fiber.Ctx) error {
-			return serv.handleDedicatedEndpoint(c, locEngine, true)
-		}
-
-		serv.app.Get(fmt.Sprintf("/%s/search", endpointName), searchHandler)
-		serv.app.Get(fmt.Sprintf("/%s/image", endpointName), imageHandler)
-		if canonicalName := strings.ToLower(locEngine.Name()); canonicalName != endpointName {
-			serv.app.Get(fmt.Sprintf("/%s/search", canonicalName), searchHandler)
-			serv.app.Get(fmt.Sprintf("/%s/image", canonicalName), imageHandler)
+		// Optional tabs such as news, videos, shopping, local, scholar and suggest are routed only for engines that have them.
+		for _, vertical := range Verticals {
+			if !EngineSupportsVertical(locEngine, vertical) {
+				continue
+			}
+			locVertical := vertical
+			handler := func(c *fiber.Ctx) error {
+				return serv.handleDedicatedEndpoint(c, locEngine, locVertical)
+			}
+			serv.app.Get(fmt.Sprintf("/%s/%s", endpointName, vertical), handler)
+			if canonicalName := strings.ToLower(locEngine.Name()); canonicalName != endpointName {
+				serv.app.Get(fmt.Sprintf("/%s/%s", canonicalName, vertical), handler)
+			}
 		}
 	}
 
@@ -230,16 +251,21 @@ func NewServerWithOptions(host string, port int, opts ServerOptions, searchEngin
 		}
 	}
 
-	serv.app.Get("/mega/search", serv.handleMegaSearch)
-	serv.app.Get("/mega/image", serv.handleMegaImage)
+	for _, vertical := range Verticals {
+		locVertical := vertical
+		serv.app.Get(fmt.Sprintf("/mega/%s", vertical), func(c *fiber.Ctx) error {
+			return serv.handleMegaEndpoint(c, locVertical)
+		})
+	}
 	serv.app.Get("/mega/engines", serv.handleListEngines)
 	serv.app.Get("/extract", serv.handleExtract)
 	serv.app.Post("/extract", serv.handleExtract)
+	serv.app.Post("/research/expand", serv.handleResearchExpand)
 
 	return &serv
 }
 
-func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, isImage bool) error {
+func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, vertical Vertical) error {
 	startedAt := time.Now()
 	requestCtx := withRequestUsage(c.UserContext(), engine.Name())
 	c.SetUserContext(requestCtx)
@@ -264,25 +290,36 @@ func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, isIm
 		return err
 	}
 
+	if vertical == VerticalSuggest && q.Text == "" {
+		return errInvalidParam("text is required for suggestions")
+	}
+	if q.Screenshot != "" && vertical != VerticalWeb {
+		return errInvalidParam("screenshot is only supported for web search")
+	}
+	if !EngineSupportsDevice(engine, q.Device, vertical) {
+		return errInvalidParam(fmt.Sprintf("device=%s is not supported by %s for %s: it has no selectors for that layout", q.Device, engine.Name(), vertical))
+	}
+
 	format, err := resolveFormat(c)
 	if err != nil {
 		return err
 	}
 
-	requestCtx = WithQueryHash(c.UserContext(), QueryHashFromQuery(q))
+	requestCtx = WithScreenshotCapture(WithQueryHash(c.UserContext(), QueryHashFromQuery(q)), q.Screenshot)
+	if s.opts.ArchiveMode != ArchiveOff && vertical == VerticalWeb {
+		requestCtx = WithPageArchive(requestCtx, q)
+	}
 	c.SetUserContext(requestCtx)
 
 	requestID := RequestIDFromContext(requestCtx)
 
-	action := "search"
-	if isImage {
-		action = "image"
-	}
+	action := string(vertical)
 	WithRequest(requestCtx).
 		WithField("action", action).
 		Debugf("Starting %s request for query: %s", action, q.Text)
 
-	if format == "json" && !q.Extract && !ShouldBypassCacheForProxyMarket(q) {
+	// Screenshots are captured per request, so they skip the cache both ways.
+	if format == "json" && !q.Extract && q.Screenshot == "" && !ShouldBypassCacheForProxyMarket(q) {
 		if hit, err := s.tryServeCacheHit(
 			c,
 			startedAt,
@@ -297,47 +334,6 @@ func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, isIm
 
 	engineNames := []string{engine.Name()}
 
-	if isImage {
-		var (
-			res        []SearchResult
-			usedEngine string
-			proxyMeta  ProxyExecutionMeta
-			searchErr  error
-		)
-		if s.opts.AllowEndpointFallback {
-			res, usedEngine, proxyMeta, searchErr = s.resilient.SearchImageWithFallback(requestCtx, engine, q)
-		} else {
-			res, usedEngine, proxyMeta, searchErr = s.resilient.SearchImagePrimary(requestCtx, engine, q)
-		}
-		s.applyProxyHeaders(c, proxyMeta)
-		if searchErr != nil {
-			WithRequest(requestCtx).WithFields(logrus.Fields{"action": action}).WithError(searchErr).Error("Search failed")
-			return searchAPIError(searchErr, usedEngine, q, proxyMeta)
-		}
-
-		env := NewImageEnvelope(q, requestID, startedAt, engineNames)
-		if usedEngine != "" && usedEngine != engine.Name() {
-			env.Meta.EnginesFailed = []string{engine.Name()}
-		}
-		ectx := EnrichContext{Engine: usedEngine, Query: q}
-		for _, r := range res {
-			env.Results = append(env.Results, EnrichImageResult(r, ectx))
-		}
-		env.Finalize(startedAt, q)
-
-		if format == "json" {
-			cacheStatus := s.cacheEnvelopeIfEligible(engine.Name(), usedEngine, action, q, env)
-			if cacheStatus != "" {
-				c.Set("X-Cache", cacheStatus)
-			}
-		}
-		if usedEngine != "" && usedEngine != engine.Name() {
-			c.Set("X-Fallback-Engine", usedEngine)
-		}
-		WithRequest(requestCtx).WithFields(logrus.Fields{"action": action, "results_count": len(res)}).Info("Search completed")
-		return sendImageEnvelope(c, format, env)
-	}
-
 	var (
 		res        []SearchResult
 		usedEngine string
@@ -345,33 +341,42 @@ func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, isIm
 		searchErr  error
 	)
 	if s.opts.AllowEndpointFallback {
-		res, usedEngine, proxyMeta, searchErr = s.resilient.SearchWithFallback(requestCtx, engine, q)
+		res, usedEngine, proxyMeta, searchErr = s.resilient.SearchVerticalWithFallback(requestCtx, engine, q, vertical)
 	} else {
-		res, usedEngine, proxyMeta, searchErr = s.resilient.SearchPrimary(requestCtx, engine, q)
+		res, usedEngine, proxyMeta, searchErr = s.resilient.SearchVerticalPrimary(requestCtx, engine, q, vertical)
 	}
 	s.applyProxyHeaders(c, proxyMeta)
+	s.archivePages(requestCtx, searchErr != nil)
 
 	if searchErr != nil {
 		WithRequest(requestCtx).WithFields(logrus.Fields{"action": action}).WithError(searchErr).Error("Search failed")
 		return searchAPIError(searchErr, usedEngine, q, proxyMeta)
 	}
+	if vertical == VerticalNews {
+		res = FilterNewsByDate(res, q.DateInterval)
+	}
 
-	env := NewEnvelope(q, requestID, startedAt, engineNames)
+	env := s.newVerticalEnvelope(vertical, q, requestID, startedAt, engineNames)
+	env.reportGaps([]SearchEngine{engine}, q, vertical)
+	env.echoDevice(requestCtx, q.Device)
 	if usedEngine != "" && usedEngine != engine.Name() {
-		env.Meta.EnginesFailed = []string{engine.Name()}
+		env.meta.EnginesFailed = []string{engine.Name()}
 	}
 	ectx := EnrichContext{Engine: usedEngine, Query: q}
 	for _, r := range res {
-		AppendEnrichedSearchResult(env, r, ectx, startedAt)
+		env.add(r, ectx)
+	}
+	env.finalize(q)
+	if web, ok := env.payload.(*Envelope); ok {
+		s.storeScreenshots(requestCtx, web)
 	}
-	env.Finalize(startedAt, q)
 
-	if q.Extract {
-		s.enrichEnvelopeWithExtraction(requestCtx, env, q, format)
+	if q.Extract && env.extract != nil {
+		env.extract(requestCtx, q, format)
 	}
 
-	if format == "json" && !q.Extract {
-		cacheStatus := s.cacheEnvelopeIfEligible(engine.Name(), usedEngine, action, q, env)
+	if format == "json" && !q.Extract && q.Screenshot == "" {
+		cacheStatus := s.cacheEnvelopeIfEligible(engine.Name(), usedEngine, action, q, env.payload)
 		if cacheStatus != "" {
 			c.Set("X-Cache", cacheStatus)
 		}
@@ -385,7 +390,7 @@ func (s *Server) handleDedicatedEndpoint(c *fiber.Ctx, engine SearchEngine, isIm
 		completionCtx = WithEngine(completionCtx, usedEngine)
 	}
 	WithRequest(completionCtx).WithFields(logrus.Fields{"action": action, "results_count": len(res)}).Info("Search completed")
-	return sendEnvelope(c, format, env)
+	return env.send(c, format)
 }
 
 func (s *Server) handleParseEndpoint(c *fiber.Ctx, parser HTMLParser) error {
@@ -476,6 +481,8 @@ func mapSearchError(err error) searchErrorSpec {
 		return searchErrorSpec{status: fiber.StatusBadGateway, code: "all_engines_failed", message: "all search engines failed"}
 	case errors.Is(err, ErrCircuitOpen):
 		return searchErrorSpec{status: fiber.StatusServiceUnavailable, code: "circuit_open", message: "engine circuit breaker is open"}
+	case errors.Is(err, ErrUnsupportedVertical):
+		return searchErrorSpec{status: fiber.StatusNotImplemented, code: "unsupported_vertical", message: "engine does not support this search type"}
 	case errors.Is(err, context.DeadlineExceeded):
 		return searchErrorSpec{status: fiber.StatusGatewayTimeout, code: "request_timeout", message: "request timed out"}
 	case errors.Is(err, context.Canceled):
@@ -930,15 +937,7 @@ type megaRunConfig struct {
 	Merge  bool
 }
 
-func (s *Server) handleMegaSearch(c *fiber.Ctx) error {
-	return s.handleMegaEndpoint(c, "search")
-}
-
-func (s *Server) handleMegaImage(c *fiber.Ctx) error {
-	return s.handleMegaEndpoint(c, "image")
-}
-
-func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
+func (s *Server) handleMegaEndpoint(c *fiber.Ctx, vertical Vertical) error {
 	startedAt := time.Now()
 	requestCtx := withRequestUsage(c.UserContext(), "mega")
 	c.SetUserContext(requestCtx)
@@ -955,12 +954,23 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 		return err
 	}
 
+	action := string(vertical)
+	if vertical == VerticalSuggest && q.Text == "" {
+		return errInvalidParam("text is required for suggestions")
+	}
+	if q.Screenshot != "" && vertical != VerticalWeb {
+		return errInvalidParam("screenshot is only supported for web search")
+	}
+
 	format, err := resolveFormat(c)
 	if err != nil {
 		return err
 	}
 
-	requestCtx = WithQueryHash(c.UserContext(), QueryHashFromQuery(q))
+	requestCtx = WithScreenshotCapture(WithQueryHash(c.UserContext(), QueryHashFromQuery(q)), q.Screenshot)
+	if s.opts.ArchiveMode != ArchiveOff && vertical == VerticalWeb {
+		requestCtx = WithPageArchive(requestCtx, q)
+	}
 	c.SetUserContext(requestCtx)
 
 	requestID := RequestIDFromContext(requestCtx)
@@ -973,6 +983,19 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 	if len(enginesToUse) == 0 {
 		return &APIError{HTTPStatus: 400, Reason: ReasonNoEngines, Message: "no valid search engines specified"}
 	}
+	enginesToUse = enginesSupportingVertical(enginesToUse, vertical)
+	if len(enginesToUse) == 0 {
+		message := fmt.Sprintf("none of the specified engines supports %s search", vertical)
+		if vertical == VerticalSuggest {
+			message = "none of the specified engines supports suggestions"
+		}
+		return &APIError{HTTPStatus: 400, Reason: ReasonNoEngines, Message: message}
+	}
+	enginesToUse = enginesSupportingDevice(enginesToUse, q.Device, vertical)
+	if len(enginesToUse) == 0 {
+		message := fmt.Sprintf("none of the specified engines supports device=%s for %s", q.Device, vertical)
+		return &APIError{HTTPStatus: 400, Reason: ReasonNoEngines, Message: message}
+	}
 
 	engineNames := make([]string, len(enginesToUse))
 	for i, engine := range enginesToUse {
@@ -986,7 +1009,7 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 		"mode":    runCfg.Mode,
 	}).Debugf("Starting mega %s request for query: %s", action, q.Text)
 
-	if format == "json" && !q.Extract && !ShouldBypassCacheForProxyMarket(q) && runCfg.Mode != megaModeFast {
+	if format == "json" && !q.Extract && q.Screenshot == "" && !ShouldBypassCacheForProxyMarket(q) && runCfg.Mode != megaModeFast {
 		cacheHitCandidates := []cacheHitCandidate{
 			{
 				key:        s.buildMegaCacheKey(action, enginesToUse, q, runCfg),
@@ -1021,20 +1044,17 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 	)
 	switch runCfg.Mode {
 	case megaModeAny:
-		rawResults, responded, engineErrors = s.resilient.searchAnyDetailed(runCtx, q, enginesToUse, action == "image")
+		rawResults, responded, engineErrors = s.resilient.searchAnyDetailed(runCtx, q, enginesToUse, vertical)
 	case megaModeFast:
-		rawResults, responded, engineErrors = s.resilient.searchFastestDetailed(runCtx, q, enginesToUse, action == "image")
+		rawResults, responded, engineErrors = s.resilient.searchFastestDetailed(runCtx, q, enginesToUse, vertical)
 	default:
-		if action == "image" {
-			rawResults, responded, engineErrors = s.resilient.searchAllImageParallelDetailed(runCtx, q, enginesToUse)
-		} else {
-			rawResults, responded, engineErrors = s.resilient.searchAllParallelDetailed(runCtx, q, enginesToUse)
-		}
+		rawResults, responded, engineErrors = s.resilient.searchAllParallelDetailed(runCtx, q, enginesToUse, vertical)
 	}
 
 	rawResults = s.applyMegaMergePolicy(rawResults, enginesToUse, runCfg)
 
 	enginesFailed := engineErrorNames(engineErrors)
+	s.archivePages(requestCtx, len(engineErrors) > 0)
 	if len(responded) == 0 {
 		err := fmt.Errorf("%w: %s", ErrAllEnginesFailed, strings.Join(enginesFailed, ","))
 		apiErr := searchAPIError(err, "mega", q, ProxyExecutionMeta{})
@@ -1048,48 +1068,42 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 		return apiErr
 	}
 
-	if action == "image" {
-		imageResults := rawResults
-		if runCfg.Dedupe {
-			imageResults = s.deduplicateMegaResults(imageResults)
-		}
-		env := NewImageEnvelope(q, requestID, startedAt, engineNames)
-		env.Meta.EnginesResponded = responded
-		env.Meta.EnginesFailed = enginesFailed
-		env.Meta.EngineErrors = engineErrors
-		for _, r := range imageResults {
-			ectx := EnrichContext{Engine: r.Engine, Query: q}
-			env.Results = append(env.Results, EnrichImageResult(r.SearchResult, ectx))
-		}
-		env.Finalize(startedAt, q)
-
-		if format == "json" && s.cache != nil && runCfg.Mode != megaModeFast {
-			c.Set("X-Cache", s.cacheMegaImageResults(action, enginesToUse, q, env, runCfg))
-		}
-		WithRequest(requestCtx).WithFields(logrus.Fields{
-			"action": action, "engines_count": len(enginesToUse), "results_count": len(env.Results),
-		}).Info("Mega search completed")
-		return sendImageEnvelope(c, format, env)
+	if vertical == VerticalNews {
+		rawResults = filterMegaNewsByDate(rawResults, q.DateInterval)
 	}
-
-	webResults := rawResults
+	megaResults := rawResults
 	if runCfg.Dedupe {
-		webResults = s.deduplicateMegaResults(webResults)
+		megaResults = s.dedupeMegaVertical(vertical, megaResults)
+	}
+	env := s.newVerticalEnvelope(vertical, q, requestID, startedAt, engineNames)
+	env.reportGaps(enginesToUse, q, vertical)
+	env.echoDevice(requestCtx, q.Device)
+	env.meta.EnginesResponded = responded
+	env.meta.EnginesFailed = enginesFailed
+	env.meta.EngineErrors = engineErrors
+	web, isWeb := env.payload.(*Envelope)
+	if isWeb {
+		// Dedupe can drop the first result an engine attached its meta to.
+		for _, r := range rawResults {
+			web.AddSerpMeta(r.Engine, r.SerpMeta)
+		}
 	}
-	env := NewEnvelope(q, requestID, startedAt, engineNames)
-	env.Meta.EnginesResponded = responded
-	env.Meta.EnginesFailed = enginesFailed
-	env.Meta.EngineErrors = engineErrors
-	for _, r := range webResults {
+	for _, r := range megaResults {
 		ectx := EnrichContext{Engine: r.Engine, Query: q}
-		AppendEnrichedSearchResult(env, r.SearchResult, ectx, startedAt)
+		env.add(r.SearchResult, ectx)
 	}
-	env.Finalize(startedAt, q)
-	if q.Extract {
-		s.enrichEnvelopeWithExtraction(requestCtx, env, q, format)
+	if runCfg.Dedupe && env.merge != nil {
+		env.merge()
+	}
+	env.finalize(q)
+	if isWeb {
+		s.storeScreenshots(requestCtx, web)
+	}
+	if q.Extract && env.extract != nil {
+		env.extract(requestCtx, q, format)
 	}
 
-	if runCfg.Merge {
+	if isWeb && runCfg.Merge && !q.AIOnly {
 		allEnriched := make([]Result, 0, len(rawResults))
 		for _, r := range rawResults {
 			ectx := EnrichContext{Engine: r.Engine, Query: q}
@@ -1097,20 +1111,20 @@ func (s *Server) handleMegaEndpoint(c *fiber.Ctx, action string) error {
 		}
 		clusters := BuildClusters(allEnriched, len(enginesToUse))
 		if len(clusters) > 0 {
-			env.Clusters = &clusters
+			web.Clusters = &clusters
 		}
 	}
 
-	if format == "json" && s.cache != nil && !q.Extract && runCfg.Mode != megaModeFast {
-		c.Set("X-Cache", s.cacheMegaEnvelopeResults(action, enginesToUse, q, env, runCfg))
+	if format == "json" && s.cache != nil && !q.Extract && q.Screenshot == "" && runCfg.Mode != megaModeFast {
+		c.Set("X-Cache", s.cacheMegaPayload(action, enginesToUse, q, env.payload, env.count(), runCfg))
 	}
 
 	WithRequest(requestCtx).WithFields(logrus.Fields{
 		"action":        action,
 		"engines_count": len(enginesToUse),
-		"results_count": len(env.Results),
+		"results_count": env.count(),
 	}).Info("Mega search completed")
-	return sendEnvelope(c, format, env)
+	return env.send(c, format)
 }
 
 func engineErrorNames(details []EngineErrorDetail) []string {
@@ -1319,6 +1333,58 @@ func (s *Server) deduplicateMegaResults(results []MegaSearchResult) []MegaSearch
 	return deduped
 }
 
+// deduplicateMegaNews collapses the same story across engines: first by
+// normalized URL, then by normalized headline, since syndicated copies of one
+// article often live at different URLs.
+func (s *Server) deduplicateMegaNews(results []MegaSearchResult) []MegaSearchResult {
+	byURL := s.deduplicateMegaResults(results)
+	seenTitles := make(map[string]bool, len(byURL))
+	deduped := make([]MegaSearchResult, 0, len(byURL))
+	for _, result := range byURL {
+		title := NormalizeNewsTitle(result.Title)
+		if title != "" && seenTitles[title] {
+			continue
+		}
+		seenTitles[title] = true
+		deduped = append(deduped, result)
+	}
+	return deduped
+}
+
+// deduplicateMegaScholar collapses the same paper across engines. Google
+// Scholar and Baidu Xueshu link different landing pages for one paper, so the
+// title match used for news stories applies here too.
+func (s *Server) deduplicateMegaScholar(results []MegaSearchResult) []MegaSearchResult {
+	return s.deduplicateMegaNews(results)
+}
+
+// deduplicateMegaVideos collapses the same clip across engines by its
+// canonical video key, so a YouTube video found as youtu.be/ID on one engine
+// and youtube.com/watch?v=ID on another appears once.
+func (s *Server) deduplicateMegaVideos(results []MegaSearchResult) []MegaSearchResult {
+	byKey := make(map[string]int, len(results))
+	deduped := make([]MegaSearchResult, 0, len(results))
+	for _, result := range results {
+		key := CanonicalVideoKey(result.URL)
+		if key == "" {
+			continue
+		}
+		if idx, exists := byKey[key]; exists {
+			if betterMegaResult(result, deduped[idx]) {
+				deduped[idx] = result
+			}
+			continue
+		}
+		byKey[key] = len(deduped)
+		deduped = append(deduped, result)
+	}
+
+	sort.SliceStable(deduped, func(i, j int) bool {
+		return resultLess(deduped[i].SearchResult, deduped[j].SearchResult)
+	})
+	return deduped
+}
+
 func betterMegaResult(candidate, current MegaSearchResult) bool {
 	if candidate.Rank > 0 && (current.Rank <= 0 || candidate.Rank < current.Rank) {
 		return true
@@ -1386,30 +1452,9 @@ func (s *Server) cacheJSON(cacheKey string, payload interface{}) bool {
 	return true
 }
 
-func (s *Server) cacheMegaEnvelopeResults(action string, enginesToUse []SearchEngine, q Query, env *Envelope, cfg megaRunConfig) string {
-	if s.cache == nil {
-		return ""
-	}
-	if ShouldBypassCacheForProxyMarket(q) {
-		s.cache.RecordBypass()
-		return "BYPASS"
-	}
-	if len(env.Results) == 0 {
-		s.cache.RecordBypass()
-		return "BYPASS"
-	}
-	cacheEngines := s.megaCacheableEngines(enginesToUse)
-	if len(cacheEngines) == 0 {
-		s.cache.RecordBypass()
-		return "BYPASS"
-	}
-	if s.cacheJSON(s.buildMegaCacheKey(action, cacheEngines, q, cfg), env) {
-		return "MISS"
-	}
-	return "BYPASS"
-}
-
-func (s *Server) cacheMegaImageResults(action string, enginesToUse []SearchEngine, q Query, env *ImageEnvelope, cfg megaRunConfig) string {
+// cacheMegaPayload caches any mega envelope shape; resultCount gates empty
+// responses out of the cache.
+func (s *Server) cacheMegaPayload(action string, enginesToUse []SearchEngine, q Query, env interface{}, resultCount int, cfg megaRunConfig) string {
 	if s.cache == nil {
 		return ""
 	}
@@ -1417,7 +1462,7 @@ func (s *Server) cacheMegaImageResults(action string, enginesToUse []SearchEngin
 		s.cache.RecordBypass()
 		return "BYPASS"
 	}
-	if len(env.Results) == 0 {
+	if resultCount == 0 {
 		s.cache.RecordBypass()
 		return "BYPASS"
 	}
@@ -1630,6 +1675,108 @@ func sendEnvelope(c *fiber.Ctx, format string, env *Envelope) error {
 	}
 }
 
+// sendNewsEnvelope is sendEnvelope for NewsEnvelope.
+func sendNewsEnvelope(c *fiber.Ctx, format string, env *NewsEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownNews(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextNews(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONNews(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
+// sendVideoEnvelope is sendEnvelope for VideoEnvelope.
+func sendVideoEnvelope(c *fiber.Ctx, format string, env *VideoEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownVideos(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextVideos(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONVideos(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
+// sendSuggestEnvelope is sendEnvelope for SuggestEnvelope.
+func sendSuggestEnvelope(c *fiber.Ctx, format string, env *SuggestEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownSuggestions(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextSuggestions(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONSuggestions(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
+// sendShoppingEnvelope is sendEnvelope for ShoppingEnvelope.
+func sendShoppingEnvelope(c *fiber.Ctx, format string, env *ShoppingEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownShopping(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextShopping(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONShopping(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
+// sendLocalEnvelope is sendEnvelope for LocalEnvelope.
+func sendLocalEnvelope(c *fiber.Ctx, format string, env *LocalEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownLocal(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextLocal(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONLocal(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
+// sendScholarEnvelope is sendEnvelope for ScholarEnvelope.
+func sendScholarEnvelope(c *fiber.Ctx, format string, env *ScholarEnvelope) error {
+	switch format {
+	case "markdown":
+		c.Set("Content-Type", "text/markdown; charset=utf-8")
+		return c.Send(RenderMarkdownScholar(env))
+	case "text":
+		c.Set("Content-Type", "text/plain; charset=utf-8")
+		return c.Send(RenderTextScholar(env))
+	case "ndjson":
+		c.Set("Content-Type", "application/x-ndjson; charset=utf-8")
+		return c.Send(RenderNDJSONScholar(env))
+	default:
+		return c.JSON(env)
+	}
+}
+
 // sendImageEnvelope is sendEnvelope for ImageEnvelope.
 func sendImageEnvelope(c *fiber.Ctx, format string, env *ImageEnvelope) error {
 	switch format {
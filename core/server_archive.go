@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// handleReplay re-runs the current HTML parsers over the pages archived for
+// a request, so selector fixes can be checked against the SERP that broke
+// them without querying the engine again.
+func (s *Server) handleReplay(c *fiber.Ctx) error {
+	startedAt := time.Now()
+	requestCtx := c.UserContext()
+
+	format, err := resolveFormat(c)
+	if err != nil {
+		return err
+	}
+
+	archivedID := c.Params("request_id")
+	archived, err := s.opts.Archive.Load(requestCtx, archivedID)
+	if errors.Is(err, ErrArchiveNotFound) {
+		return &APIError{HTTPStatus: fiber.StatusNotFound, Reason: ReasonArchiveNotFound, Message: "no archived pages for request " + archivedID}
+	}
+	if err != nil {
+		WithRequest(requestCtx).WithError(err).Error("Archive read failed")
+		return err
+	}
+
+	env := ReplayArchivedPages(archived, s.htmlParseFunc, RequestIDFromContext(requestCtx), startedAt)
+	WithRequest(requestCtx).WithFields(logrus.Fields{
+		"archived_request_id": archivedID,
+		"pages":               len(archived.Pages),
+		"results_count":       len(env.Results),
+	}).Info("Replay completed")
+	return sendEnvelope(c, format, env)
+}
+
+// htmlParseFunc resolves an archived page's engine to the HTMLParser of the
+// server's engine with that name.
+func (s *Server) htmlParseFunc(engine string) (HTMLParseFunc, bool) {
+	for _, candidate := range s.searchEngines {
+		parser, ok := candidate.(HTMLParser)
+		if ok && strings.EqualFold(parser.Name(), engine) {
+			return parser.ParseHTML, true
+		}
+	}
+	return nil, false
+}
+
+// archivePages saves the SERP documents recorded during the request when the
+// archive mode asks for them: always in ArchiveAll, only after an engine
+// failed in ArchiveErrors. A failed save is logged; the search response is
+// still sent.
+func (s *Server) archivePages(ctx context.Context, failed bool) {
+	if s.opts.ArchiveMode == ArchiveOff || (s.opts.ArchiveMode == ArchiveErrors && !failed) {
+		return
+	}
+	archived := ArchivedRequestFromContext(ctx)
+	if len(archived.Pages) == 0 {
+		return
+	}
+	requestID := RequestIDFromContext(ctx)
+	if err := s.opts.Archive.Save(ctx, requestID, archived); err != nil {
+		WithRequest(ctx).WithError(err).Warn("SERP archive failed")
+		return
+	}
+	WithRequest(ctx).WithField("pages", len(archived.Pages)).Debug("SERP pages archived")
+}
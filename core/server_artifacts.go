@@ -0,0 +1,39 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *Server) handleArtifact(c *fiber.Ctx) error {
+	data, contentType, err := s.opts.Artifacts.Open(c.UserContext(), c.Params("id"))
+	if errors.Is(err, ErrArtifactNotFound) {
+		return &APIError{HTTPStatus: fiber.StatusNotFound, Reason: ReasonArtifactNotFound, Message: "artifact not found or expired"}
+	}
+	if err != nil {
+		WithRequest(c.UserContext()).WithError(err).Error("Artifact read failed")
+		return err
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "private, max-age=3600")
+	return c.Send(data)
+}
+
+// storeScreenshots moves the screenshots engines captured during the request
+// into the artifact store and lists them in env.Meta.Artifacts. A failed
+// store is logged; the search response is still sent.
+func (s *Server) storeScreenshots(ctx context.Context, env *Envelope) {
+	for _, shot := range ScreenshotsFromContext(ctx) {
+		artifact, err := s.opts.Artifacts.Put(ctx, "image/png", shot.Data)
+		if err != nil {
+			WithRequest(ctx).WithFields(logrus.Fields{"engine": shot.Engine}).WithError(err).Warn("Screenshot store failed")
+			continue
+		}
+		artifact.Kind = ArtifactKindScreenshot
+		artifact.Engine = shot.Engine
+		env.Meta.Artifacts = append(env.Meta.Artifacts, artifact)
+	}
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var testPNG = []byte("\x89PNG\r\n\x1a\nserp")
+
+func TestLocalArtifactStoreRoundTripAndExpiry(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := NewLocalArtifactStore(dir, time.Hour)
+	ctx := context.Background()
+
+	artifact, err := store.Put(ctx, "image/png", testPNG)
+	if err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if artifact.URL != "/artifacts/"+artifact.ID || artifact.Bytes != len(testPNG) || artifact.ExpiresAt == "" {
+		t.Fatalf("unexpected artifact: %+v", artifact)
+	}
+	data, contentType, err := store.Open(ctx, artifact.ID)
+	if err != nil || contentType != "image/png" || !bytes.Equal(data, testPNG) {
+		t.Fatalf("open = %q, %q, %v", data, contentType, err)
+	}
+
+	for _, id := range []string{"", "../config", "00000000-0000-0000-0000-000000000000"} {
+		if _, _, err := store.Open(ctx, id); !errors.Is(err, ErrArtifactNotFound) {
+			t.Fatalf("Open(%q) err = %v, want ErrArtifactNotFound", id, err)
+		}
+	}
+	if _, err := store.Put(ctx, "application/pdf", testPNG); err == nil {
+		t.Fatal("expected unsupported content types to be rejected")
+	}
+
+	// Age the file past the TTL: the next Open prunes it and reports it gone.
+	path := filepath.Join(dir, artifact.ID+".png")
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	unrelated := filepath.Join(dir, "notes.png")
+	if err := os.WriteFile(unrelated, testPNG, 0o644); err != nil {
+		t.Fatalf("write unrelated file: %v", err)
+	}
+	if err := os.Chtimes(unrelated, old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	if _, _, err := store.Open(ctx, artifact.ID); !errors.Is(err, ErrArtifactNotFound) {
+		t.Fatalf("expired artifact err = %v, want ErrArtifactNotFound", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected expired artifact to be pruned, stat err = %v", err)
+	}
+
+	// Put prunes as well, without the expired artifact being read first.
+	second, err := store.Put(ctx, "image/png", testPNG)
+	if err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	path = filepath.Join(dir, second.ID+".png")
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	if _, err := store.Put(ctx, "image/png", testPNG); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected Put to prune the expired artifact, stat err = %v", err)
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Fatalf("files the store did not write must be kept: %v", err)
+	}
+}
+
+func TestInitFromContextScreenshot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantMode   ScreenshotMode
+	}{
+		{"?text=q", http.StatusOK, ""},
+		{"?text=q&screenshot=full", http.StatusOK, ScreenshotFull},
+		{"?text=q&screenshot=Viewport", http.StatusOK, ScreenshotViewport},
+		{"?text=q&screenshot=true", http.StatusBadRequest, ""},
+	}
+
+	app := fiber.New()
+	app.Get("/probe", func(c *fiber.Ctx) error {
+		q := Query{}
+		if err := q.InitFromContext(c); err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		c.Set("X-Screenshot", string(q.Screenshot))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/probe"+tt.query, nil), -1)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s: status = %d, want %d", tt.query, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus == http.StatusOK && resp.Header.Get("X-Screenshot") != string(tt.wantMode) {
+			t.Fatalf("%s: Screenshot = %q, want %q", tt.query, resp.Header.Get("X-Screenshot"), tt.wantMode)
+		}
+	}
+}
+
+func TestSearchScreenshotIsStoredAndServed(t *testing.T) {
+	var searches int
+	google := &engineMock{name: "google", initialized: true, searchFn: func(ctx context.Context, q Query) ([]SearchResult, error) {
+		searches++
+		recordScreenshot(WithEngine(ctx, "google"), testPNG)
+		return []SearchResult{{Rank: 1, URL: "https://example.com/", Title: "Example"}}, nil
+	}}
+
+	opts := DefaultServerOptions()
+	opts.Artifacts = NewLocalArtifactStore(t.TempDir(), time.Hour)
+	srv := NewServerWithOptions("127.0.0.1", 7197, opts, google)
+
+	var env Envelope
+	for i := 0; i < 2; i++ {
+		resp := request(t, srv, "/google/search?text=golang&screenshot=full")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+			t.Fatalf("decode envelope: %v", err)
+		}
+	}
+	if searches != 2 {
+		t.Fatalf("screenshot requests must not be served from cache, engine ran %d times", searches)
+	}
+	if len(env.Meta.Artifacts) != 1 {
+		t.Fatalf("expected one artifact, got %+v", env.Meta.Artifacts)
+	}
+	artifact := env.Meta.Artifacts[0]
+	if artifact.Kind != ArtifactKindScreenshot || artifact.Engine != "google" || artifact.ContentType != "image/png" {
+		t.Fatalf("unexpected artifact: %+v", artifact)
+	}
+
+	resp := request(t, srv, artifact.URL)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "image/png" {
+		t.Fatalf("GET %s = %d %s", artifact.URL, resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	if body, _ := io.ReadAll(resp.Body); !bytes.Equal(body, testPNG) {
+		t.Fatalf("unexpected artifact body %q", body)
+	}
+
+	if resp := request(t, srv, "/artifacts/00000000-0000-0000-0000-000000000000"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown artifact, got %d", resp.StatusCode)
+	}
+	if resp := request(t, srv, "/google/image?text=golang&screenshot=full"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for screenshot outside web search, got %d", resp.StatusCode)
+	}
+
+	plain := request(t, srv, "/google/search?text=golang")
+	var plainEnv Envelope
+	if err := json.NewDecoder(plain.Body).Decode(&plainEnv); err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if len(plainEnv.Meta.Artifacts) != 0 {
+		t.Fatalf("requests without screenshot= must not store artifacts, got %+v", plainEnv.Meta.Artifacts)
+	}
+}
@@ -238,6 +238,17 @@ func (s *Server) enrichEnvelopeWithExtraction(ctx context.Context, env *Envelope
 	EnrichEnvelopeWithExtraction(ctx, env, q, format, s.newExtractor(), s.opts.Extract)
 }
 
+func (s *Server) enrichScholarEnvelopeWithExtraction(ctx context.Context, env *ScholarEnvelope, q Query, format string) {
+	EnrichScholarEnvelopeWithExtraction(ctx, env, q, format, s.newExtractor(), s.opts.Extract)
+}
+
+// extractTarget is one result queued for extraction: the URL to fetch and
+// the result field that receives the outcome.
+type extractTarget struct {
+	url  string
+	slot **ExtractedContent
+}
+
 // EnrichEnvelopeWithExtraction fills env.Results[*].Extracted by running the
 // extractor over the top organic results, with candidate fill-in when a top
 // result fails. It is shared by the HTTP search handler and the CLI so both
@@ -245,8 +256,35 @@ func (s *Server) enrichEnvelopeWithExtraction(ctx context.Context, env *Envelope
 // extractor and cfg are supplied by the caller (the server reuses its
 // long-lived browser pool; the CLI builds a one-shot browser).
 func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, format string, extractor extractpkg.Extractor, cfg extractpkg.Config) {
+	if env == nil {
+		return
+	}
+	targets := make([]extractTarget, len(env.Results))
+	for i := range env.Results {
+		targets[i] = extractTarget{url: env.Results[i].URL, slot: &env.Results[i].Extracted}
+	}
+	runExtraction(ctx, targets, q, format, extractor, cfg)
+}
+
+// EnrichScholarEnvelopeWithExtraction is EnrichEnvelopeWithExtraction for
+// scholar results. The PDF is fetched when the engine found one, since it
+// carries the full paper; otherwise the landing page is used.
+func EnrichScholarEnvelopeWithExtraction(ctx context.Context, env *ScholarEnvelope, q Query, format string, extractor extractpkg.Extractor, cfg extractpkg.Config) {
+	if env == nil {
+		return
+	}
+	targets := make([]extractTarget, len(env.Results))
+	for i := range env.Results {
+		targets[i] = extractTarget{url: firstNonEmpty(env.Results[i].PDFURL, env.Results[i].URL), slot: &env.Results[i].Extracted}
+	}
+	runExtraction(ctx, targets, q, format, extractor, cfg)
+}
+
+// runExtraction extracts the top q.ExtractTop targets, then walks up to three
+// further candidates until that many succeeded.
+func runExtraction(ctx context.Context, targets []extractTarget, q Query, format string, extractor extractpkg.Extractor, cfg extractpkg.Config) {
 	cfg = cfg.Normalized()
-	if env == nil || !q.Extract || !cfg.Enabled {
+	if !q.Extract || !cfg.Enabled {
 		return
 	}
 	// One representation per result, chosen by the response format: plain text for
@@ -257,12 +295,12 @@ func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, f
 		contentFormat = "text"
 	}
 	limit := clampExtractTop(q.ExtractTop)
-	if limit > len(env.Results) {
-		limit = len(env.Results)
+	if limit > len(targets) {
+		limit = len(targets)
 	}
 	candidateLimit := limit + 3
-	if candidateLimit > len(env.Results) {
-		candidateLimit = len(env.Results)
+	if candidateLimit > len(targets) {
+		candidateLimit = len(targets)
 	}
 
 	// Per-fetch timeouts bound a single URL; this aggregate deadline bounds the
@@ -274,14 +312,14 @@ func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, f
 	ctx, cancel := context.WithTimeout(ctx, cfg.BatchTimeout(candidateLimit))
 	defer cancel()
 
-	extractOne := func(idx int) {
+	extractOne := func(target extractTarget) {
 		// Skip the fetch entirely if the batch budget is already spent.
 		if err := ctx.Err(); err != nil {
-			env.Results[idx].Extracted = &ExtractedContent{Error: SanitizeExtractError(err)}
+			*target.slot = &ExtractedContent{Error: SanitizeExtractError(err)}
 			return
 		}
 		req := extractpkg.ExtractRequest{
-			URL:      env.Results[idx].URL,
+			URL:      target.url,
 			Mode:     extractpkg.Mode(q.ExtractMode),
 			ProxyURL: q.ProxyURL,
 			LangCode: q.LangCode,
@@ -291,7 +329,7 @@ func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, f
 		}
 		result, err := extractor.Extract(ctx, req)
 		if err != nil {
-			env.Results[idx].Extracted = &ExtractedContent{Error: SanitizeExtractError(err)}
+			*target.slot = &ExtractedContent{Error: SanitizeExtractError(err)}
 			return
 		}
 		content := result.Markdown
@@ -299,10 +337,10 @@ func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, f
 			content = result.Text
 		}
 		if !ExtractedContentLooksUseful(content) {
-			env.Results[idx].Extracted = &ExtractedContent{Error: "empty extracted content"}
+			*target.slot = &ExtractedContent{Error: "empty extracted content"}
 			return
 		}
-		env.Results[idx].Extracted = &ExtractedContent{
+		*target.slot = &ExtractedContent{
 			Title:     result.Title,
 			Format:    contentFormat,
 			Content:   content,
@@ -314,26 +352,26 @@ func EnrichEnvelopeWithExtraction(ctx context.Context, env *Envelope, q Query, f
 	sem := make(chan struct{}, cfg.MaxConcurrent)
 	var wg sync.WaitGroup
 	for i := 0; i < limit; i++ {
-		if strings.TrimSpace(env.Results[i].URL) == "" {
+		if strings.TrimSpace(targets[i].url) == "" {
 			continue
 		}
 		wg.Add(1)
 		sem <- struct{}{}
-		go func(idx int) {
+		go func(target extractTarget) {
 			defer wg.Done()
 			defer func() { <-sem }()
-			extractOne(idx)
-		}(i)
+			extractOne(target)
+		}(targets[i])
 	}
 	wg.Wait()
 
-	successes := extractedSuccessCount(env.Results[:limit])
+	successes := extractedSuccessCount(targets[:limit])
 	for i := limit; successes < limit && i < candidateLimit; i++ {
-		if strings.TrimSpace(env.Results[i].URL) == "" {
+		if strings.TrimSpace(targets[i].url) == "" {
 			continue
 		}
-		extractOne(i)
-		if extractedResultSucceeded(env.Results[i]) {
+		extractOne(targets[i])
+		if extractedContentSucceeded(*targets[i].slot) {
 			successes++
 		}
 	}
@@ -348,20 +386,20 @@ func ExtractedContentLooksUseful(content string) bool {
 	return len([]rune(strings.TrimSpace(content))) >= minUsefulExtractRunes
 }
 
-func extractedSuccessCount(results []Result) int {
+func extractedSuccessCount(targets []extractTarget) int {
 	count := 0
-	for _, result := range results {
-		if extractedResultSucceeded(result) {
+	for _, target := range targets {
+		if extractedContentSucceeded(*target.slot) {
 			count++
 		}
 	}
 	return count
 }
 
-func extractedResultSucceeded(result Result) bool {
-	return result.Extracted != nil &&
-		result.Extracted.Error == "" &&
-		ExtractedContentLooksUseful(result.Extracted.Content)
+func extractedContentSucceeded(extracted *ExtractedContent) bool {
+	return extracted != nil &&
+		extracted.Error == "" &&
+		ExtractedContentLooksUseful(extracted.Content)
 }
 
 func sendExtractResult(c *fiber.Ctx, format string, result *extractpkg.ExtractResult) error {
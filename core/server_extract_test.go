@@ -61,6 +61,46 @@ func TestEnrichEnvelopeWithExtractionRetriesThinAndFailedCandidates(t *testing.T
 	}
 }
 
+func TestEnrichScholarEnvelopeWithExtractionPrefersPDF(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/paper.pdf":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`<html><body><article><h1>Full paper</h1><p>This full paper text is long enough to count as extracted content and must come from the PDF link rather than the landing page.</p></article></body></html>`))
+		case "/landing":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`<html><body><article><h1>Landing page</h1><p>This landing page text is long enough to count as extracted content but is only used when no PDF link was found.</p></article></body></html>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer target.Close()
+
+	cfg := extractpkg.Config{
+		Enabled:              true,
+		DefaultMode:          string(extractpkg.ModeFast),
+		Timeout:              time.Second,
+		MaxBytes:             256 * 1024,
+		MaxConcurrent:        2,
+		AllowPrivateNetworks: true,
+	}
+	s := &Server{opts: ServerOptions{Extract: cfg}}
+	env := &ScholarEnvelope{Results: []ScholarResult{
+		{URL: target.URL + "/landing", PDFURL: target.URL + "/paper.pdf"},
+		{URL: target.URL + "/landing"},
+	}}
+	q := Query{Extract: true, ExtractTop: 2, ExtractMode: string(extractpkg.ModeFast)}
+
+	s.enrichScholarEnvelopeWithExtraction(context.Background(), env, q, "json")
+
+	if env.Results[0].Extracted == nil || !strings.Contains(env.Results[0].Extracted.Content, "Full paper") {
+		t.Fatalf("first result extracted = %+v, want the PDF content", env.Results[0].Extracted)
+	}
+	if env.Results[1].Extracted == nil || !strings.Contains(env.Results[1].Extracted.Content, "Landing page") {
+		t.Fatalf("second result extracted = %+v, want the landing page content", env.Results[1].Extracted)
+	}
+}
+
 func TestExtractRejectsLinkLocalAddressByDefault(t *testing.T) {
 	opts := DefaultServerOptions()
 	opts.Extract = extractpkg.DefaultConfig()
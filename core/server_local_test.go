@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// localEngineMock adds a local tab to engineMock.
+type localEngineMock struct {
+	*engineMock
+	localFn func(context.Context, Query) ([]SearchResult, error)
+}
+
+func (e *localEngineMock) SearchLocal(ctx context.Context, q Query) ([]SearchResult, error) {
+	return e.localFn(ctx, q)
+}
+
+func localItem(rank int, url, name, address string) SearchResult {
+	return SearchResult{
+		Rank:  rank,
+		URL:   url,
+		Title: name,
+		Local: &LocalMeta{Rating: 4.5, ReviewCount: 820, Category: "Coffee shop", Address: address, Phone: "(415) 555-0100", Website: url},
+	}
+}
+
+func TestLocalEndpointReturnsLocalResults(t *testing.T) {
+	google := &localEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		localFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{localItem(1, "https://bluebottlecoffee.com/", "Blue Bottle Coffee", "1 Ferry Building")}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7252, opts, google, duck)
+
+	resp := request(t, srv, "/google/local?text=coffee&region=US")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/local, got %d", resp.StatusCode)
+	}
+	var env LocalEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode local envelope: %v", err)
+	}
+	if len(env.Results) != 1 {
+		t.Fatalf("expected 1 local result, got %d", len(env.Results))
+	}
+	got := env.Results[0]
+	if got.Type != ResultTypeLocal || got.ID[:2] != "l_" || got.Domain != "bluebottlecoffee.com" {
+		t.Fatalf("unexpected local result: %+v", got)
+	}
+	if got.Address != "1 Ferry Building" || got.Phone != "(415) 555-0100" || got.Rating != 4.5 || got.ReviewCount != 820 {
+		t.Fatalf("unexpected local listing: %+v", got)
+	}
+	if got.Position == nil || got.Position.Absolute != 1 {
+		t.Fatalf("expected absolute position 1, got %+v", got.Position)
+	}
+
+	if resp := request(t, srv, "/duck/local?text=coffee"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected /duck/local to be unrouted, got %d", resp.StatusCode)
+	}
+	if resp := request(t, srv, "/mega/local?text=coffee&engines=duckduckgo"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected /mega/local without local engines to fail with 400, got %d", resp.StatusCode)
+	}
+}
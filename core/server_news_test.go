@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newsEngineMock adds a news tab to engineMock.
+type newsEngineMock struct {
+	*engineMock
+	newsFn func(context.Context, Query) ([]SearchResult, error)
+}
+
+func (e *newsEngineMock) SearchNews(ctx context.Context, q Query) ([]SearchResult, error) {
+	return e.newsFn(ctx, q)
+}
+
+func newsItem(rank int, url, title, source string, published time.Time) SearchResult {
+	return SearchResult{
+		Rank:  rank,
+		URL:   url,
+		Title: title,
+		News:  &NewsMeta{Source: source, PublishedAt: published},
+	}
+}
+
+func TestNewsRouteRegisteredOnlyForNewsEngines(t *testing.T) {
+	google := &newsEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		newsFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{newsItem(1, "https://news.example/a", "Story", "Example Times", time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC))}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7190, opts, google, duck)
+
+	resp := request(t, srv, "/google/news?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/news, got %d", resp.StatusCode)
+	}
+	var env NewsEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode news envelope: %v", err)
+	}
+	if len(env.Results) != 1 {
+		t.Fatalf("expected 1 news result, got %d", len(env.Results))
+	}
+	got := env.Results[0]
+	if got.Type != ResultTypeNews || got.Source != "Example Times" || got.PublishedAt != "2024-03-05T08:00:00Z" {
+		t.Fatalf("unexpected news result: %+v", got)
+	}
+	if got.ID[:2] != "n_" || got.Domain != "news.example" {
+		t.Fatalf("unexpected news id/domain: %+v", got)
+	}
+
+	for _, path := range []string{"/duck/news?text=golang", "/duckduckgo/news?text=golang"} {
+		if resp := request(t, srv, path); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected %s to be unrouted, got %d", path, resp.StatusCode)
+		}
+	}
+}
+
+func TestDedicatedNewsEndpointAppliesDateFilter(t *testing.T) {
+	google := &newsEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		newsFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				newsItem(1, "https://news.example/old", "Old", "Wire", time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC)),
+				newsItem(2, "https://news.example/new", "New", "Wire", time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC)),
+			}, nil
+		},
+	}
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7191, opts, google)
+
+	resp := request(t, srv, "/google/news?text=golang&date=20240301..20240310")
+	var env NewsEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode news envelope: %v", err)
+	}
+	if len(env.Results) != 1 || env.Results[0].Title != "New" {
+		t.Fatalf("expected only the in-range story, got %+v", env.Results)
+	}
+}
+
+func TestMegaNewsDedupesAcrossEnginesAndSkipsNonNewsEngines(t *testing.T) {
+	published := time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC)
+	google := &newsEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		newsFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				newsItem(1, "https://wire.example/story?utm_source=g", "Go 1.22 released", "Wire", published),
+				newsItem(2, "https://blog.example/post", "Unrelated post", "Blog", published),
+			}, nil
+		},
+	}
+	bing := &newsEngineMock{
+		engineMock: &engineMock{name: "bing", initialized: true},
+		newsFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				newsItem(1, "https://wire.example/story", "Go 1.22 released", "Wire", published),
+				newsItem(2, "https://mirror.example/syndicated", "Go 1.22 Released!", "Mirror", published),
+			}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	opts.Resilience.Retry.MaxRetries = 0
+	srv := NewServerWithOptions("127.0.0.1", 7192, opts, google, bing, duck)
+
+	resp := request(t, srv, "/mega/news?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /mega/news, got %d", resp.StatusCode)
+	}
+	var env NewsEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode news envelope: %v", err)
+	}
+	if len(env.Query.EnginesRequested) != 2 {
+		t.Fatalf("expected non-news engines to be skipped, got %v", env.Query.EnginesRequested)
+	}
+	if len(env.Results) != 2 {
+		t.Fatalf("expected URL and title dedupe to leave 2 stories, got %+v", env.Results)
+	}
+	if duck.searchCalls != 0 {
+		t.Fatalf("expected duckduckgo not to be queried, got %d calls", duck.searchCalls)
+	}
+
+	if resp := request(t, srv, "/mega/news?text=golang&engines=duck"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 when no selected engine has news, got %d", resp.StatusCode)
+	}
+}
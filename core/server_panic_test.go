@@ -24,13 +24,13 @@ func TestInvokeEngineRecoversPanics(t *testing.T) {
 		},
 	}
 
-	for _, isImage := range []bool{false, true} {
-		results, err := invokeEngine(context.Background(), engine, Query{Text: "golang"}, isImage)
+	for _, vertical := range []Vertical{VerticalWeb, VerticalImage} {
+		results, err := invokeEngine(context.Background(), engine, Query{Text: "golang"}, vertical)
 		if results != nil {
-			t.Fatalf("isImage=%v: expected nil results after panic, got %v", isImage, results)
+			t.Fatalf("vertical=%s: expected nil results after panic, got %v", vertical, results)
 		}
 		if !errors.Is(err, ErrEngineInternal) {
-			t.Fatalf("isImage=%v: expected ErrEngineInternal, got %v", isImage, err)
+			t.Fatalf("vertical=%s: expected ErrEngineInternal, got %v", vertical, err)
 		}
 	}
 }
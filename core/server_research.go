@@ -0,0 +1,239 @@
+package core
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultExpandDepth       = 2
+	maxExpandDepth           = 4
+	defaultExpandBudget      = 50
+	maxExpandBudget          = 500
+	defaultExpandMaxKeywords = 200
+	maxExpandMaxKeywords     = 2000
+	maxExpandSeeds           = 20
+)
+
+type expandPayload struct {
+	Seeds       []string `json:"seeds"`
+	Engines     []string `json:"engines"`
+	Sources     []string `json:"sources"`
+	Depth       int      `json:"depth"`
+	Budget      int      `json:"budget"`
+	MaxKeywords int      `json:"max_keywords"`
+	Alphabet    bool     `json:"alphabet"`
+	Lang        string   `json:"lang"`
+	Region      string   `json:"region"`
+	Format      string   `json:"format"`
+}
+
+func (s *Server) handleResearchExpand(c *fiber.Ctx) error {
+	startedAt := time.Now()
+	requestCtx := withRequestUsage(c.UserContext(), "research")
+	c.SetUserContext(requestCtx)
+	defer setNetworkBytesHeader(c, requestCtx)
+	defer setBrowserProfileHeader(c, requestCtx)
+
+	var body expandPayload
+	if err := c.BodyParser(&body); err != nil {
+		return errInvalidParam(fmt.Sprintf("invalid request body: %v", err))
+	}
+	format, err := resolveExpandFormat(c, body.Format)
+	if err != nil {
+		return err
+	}
+	opts, err := expandOptionsFromPayload(body)
+	if err != nil {
+		return err
+	}
+
+	q := Query{
+		LangCode: strings.TrimSpace(body.Lang),
+		Region:   strings.TrimSpace(body.Region),
+		Limit:    defaultQueryLimit,
+		Filter:   true,
+	}
+	if err := q.initProxyFromHeaders(c); err != nil {
+		return err
+	}
+	if err := s.validateRequestProxyURL(&q); err != nil {
+		return err
+	}
+
+	engines := s.resolveEngines(requestCtx, strings.Join(body.Engines, ","))
+	if len(engines) == 0 {
+		return &APIError{HTTPStatus: 400, Reason: ReasonNoEngines, Message: "no valid search engines specified"}
+	}
+	if !expandNeedsWebSearch(opts.Sources) {
+		engines = enginesSupportingVertical(engines, VerticalSuggest)
+		if len(engines) == 0 {
+			return &APIError{HTTPStatus: 400, Reason: ReasonNoEngines, Message: "none of the specified engines supports suggestions"}
+		}
+	}
+
+	engineNames := make([]string, len(engines))
+	for i, engine := range engines {
+		engineNames[i] = engine.Name()
+	}
+	s.applyProxyHeaders(c, s.resilient.ResolveMegaProxyMeta(q, engines))
+	WithRequest(requestCtx).WithFields(logrus.Fields{
+		"engines": strings.Join(engineNames, ","),
+		"depth":   opts.Depth,
+		"budget":  opts.Budget,
+	}).Debugf("Starting keyword expansion for seeds: %s", strings.Join(opts.Seeds, ", "))
+
+	runCtx := requestCtx
+	if s.opts.MegaTimeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(requestCtx, s.opts.MegaTimeout)
+		defer cancel()
+	}
+
+	env := &ExpandEnvelope{
+		Query: ExpandEcho{
+			Seeds:            opts.Seeds,
+			Lang:             q.LangCode,
+			Region:           q.Region,
+			EnginesRequested: engineNames,
+			Sources:          opts.Sources,
+			Depth:            opts.Depth,
+			Budget:           opts.Budget,
+			MaxKeywords:      opts.MaxKeywords,
+			Alphabet:         opts.Alphabet,
+		},
+		Meta: ResponseMeta{
+			RequestID:     RequestIDFromContext(requestCtx),
+			RequestedAt:   startedAt.UTC().Format(time.RFC3339),
+			EnginesFailed: []string{},
+			Version:       apiVersion,
+		},
+		Keywords: []ExpandKeyword{},
+		Edges:    []ExpandEdge{},
+	}
+	s.resilient.ExpandKeywords(runCtx, q, engines, opts, env)
+	env.Meta.TookMs = time.Since(startedAt).Milliseconds()
+
+	return sendExpandEnvelope(c, format, env)
+}
+
+// expandOptionsFromPayload applies defaults and bounds to a request body.
+func expandOptionsFromPayload(body expandPayload) (ExpandOptions, error) {
+	var opts ExpandOptions
+	seen := map[string]bool{}
+	for _, seed := range body.Seeds {
+		seed = NormalizeWhitespace(seed)
+		key := SuggestionKey(seed)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		opts.Seeds = append(opts.Seeds, seed)
+	}
+	if len(opts.Seeds) == 0 {
+		return opts, errInvalidParam("seeds must contain at least one keyword")
+	}
+	if len(opts.Seeds) > maxExpandSeeds {
+		return opts, errInvalidParam(fmt.Sprintf("seeds must contain at most %d keywords", maxExpandSeeds))
+	}
+
+	var err error
+	if opts.Depth, err = boundedExpandParam("depth", body.Depth, defaultExpandDepth, maxExpandDepth); err != nil {
+		return opts, err
+	}
+	if opts.Budget, err = boundedExpandParam("budget", body.Budget, defaultExpandBudget, maxExpandBudget); err != nil {
+		return opts, err
+	}
+	if opts.MaxKeywords, err = boundedExpandParam("max_keywords", body.MaxKeywords, defaultExpandMaxKeywords, maxExpandMaxKeywords); err != nil {
+		return opts, err
+	}
+	if opts.MaxKeywords < len(opts.Seeds) {
+		return opts, errInvalidParam("max_keywords must be at least the number of seeds")
+	}
+
+	for _, raw := range body.Sources {
+		source, err := ParseExpandSource(strings.ToLower(strings.TrimSpace(raw)))
+		if err != nil {
+			return opts, errInvalidParam(err.Error())
+		}
+		if !containsExpandSource(opts.Sources, source) {
+			opts.Sources = append(opts.Sources, source)
+		}
+	}
+	if len(opts.Sources) == 0 {
+		opts.Sources = []ExpandSource{ExpandSourceSuggest, ExpandSourceRelated, ExpandSourcePAA}
+	}
+	opts.Alphabet = body.Alphabet
+	return opts, nil
+}
+
+// boundedExpandParam treats zero as "use the default" and rejects values
+// outside 1..max.
+func boundedExpandParam(name string, value int, fallback int, max int) (int, error) {
+	if value == 0 {
+		return fallback, nil
+	}
+	if value < 1 || value > max {
+		return 0, errInvalidParam(fmt.Sprintf("%s must be between 1 and %d", name, max))
+	}
+	return value, nil
+}
+
+func containsExpandSource(sources []ExpandSource, source ExpandSource) bool {
+	for _, s := range sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+func expandNeedsWebSearch(sources []ExpandSource) bool {
+	return containsExpandSource(sources, ExpandSourceRelated) || containsExpandSource(sources, ExpandSourcePAA)
+}
+
+// resolveExpandFormat picks json or csv from ?format=, the body, or the
+// Accept header.
+func resolveExpandFormat(c *fiber.Ctx, bodyFormat string) (string, error) {
+	raw := strings.ToLower(strings.TrimSpace(firstNonEmpty(c.Query("format"), bodyFormat)))
+	if raw == "" {
+		raw = "json"
+		if strings.Contains(strings.ToLower(c.Get("Accept")), "text/csv") {
+			raw = "csv"
+		}
+	}
+	switch raw {
+	case "json", "csv":
+		return raw, nil
+	}
+	return "", &APIError{HTTPStatus: 400, Reason: ReasonUnknownFormat,
+		Message: fmt.Sprintf("unknown format %q: accepted values are json, csv", raw)}
+}
+
+func sendExpandEnvelope(c *fiber.Ctx, format string, env *ExpandEnvelope) error {
+	if format == "csv" {
+		c.Set("Content-Type", "text/csv; charset=utf-8")
+		return c.Send(RenderExpandCSV(env))
+	}
+	return c.JSON(env)
+}
+
+// RenderExpandCSV writes the expansion tree as one row per keyword, seeds
+// first, in discovery order.
+func RenderExpandCSV(env *ExpandEnvelope) []byte {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	_ = w.Write([]string{"keyword", "depth", "parent", "engine", "source"})
+	for _, k := range env.Keywords {
+		_ = w.Write([]string{k.Keyword, strconv.Itoa(k.Depth), k.Parent, k.Engine, string(k.Source)})
+	}
+	w.Flush()
+	return []byte(b.String())
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func postExpand(t *testing.T, s *Server, path string, body string) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("request failed for %s: %v", path, err)
+	}
+	return resp
+}
+
+func TestResearchExpandEndpoint(t *testing.T) {
+	google := newExpandEngineMock("google",
+		map[string][]string{"golang": {"golang tutorial", "golang generics"}},
+		map[string][]string{"golang": {"go language"}},
+	)
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 0, opts, google)
+
+	resp := postExpand(t, srv, "/research/expand", `{"seeds":["golang"],"depth":1}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /research/expand, got %d", resp.StatusCode)
+	}
+	var env ExpandEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode expand envelope: %v", err)
+	}
+	if len(env.Keywords) != 4 || len(env.Edges) != 3 || env.Stats.Calls != 2 {
+		t.Fatalf("unexpected expansion: keywords=%+v edges=%+v stats=%+v", env.Keywords, env.Edges, env.Stats)
+	}
+	if len(env.Query.Sources) != 3 || env.Query.Budget != defaultExpandBudget {
+		t.Fatalf("expected defaults in query echo, got %+v", env.Query)
+	}
+
+	resp = postExpand(t, srv, "/research/expand?format=csv", `{"seeds":["golang"],"depth":1,"sources":["suggest"]}`)
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Fatalf("expected CSV content type, got %q", ct)
+	}
+	rows, err := csv.NewReader(resp.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("read CSV: %v", err)
+	}
+	if len(rows) != 4 || rows[0][0] != "keyword" || rows[2][2] != "golang" || rows[2][4] != "suggest" {
+		t.Fatalf("unexpected CSV rows: %v", rows)
+	}
+}
+
+func TestResearchExpandValidatesBody(t *testing.T) {
+	srv := NewServerWithOptions("127.0.0.1", 0, DefaultServerOptions(), &engineMock{name: "duckduckgo", initialized: true})
+
+	cases := []string{
+		`{"seeds":[" "]}`,
+		`{"seeds":["golang"],"depth":9}`,
+		`{"seeds":["golang"],"sources":["ads"]}`,
+		`{"seeds":["golang"],"format":"xml"}`,
+		`{"seeds":["golang"],"sources":["suggest"]}`,
+	}
+	for _, body := range cases {
+		if resp := postExpand(t, srv, "/research/expand", body); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", body, resp.StatusCode)
+		}
+	}
+}
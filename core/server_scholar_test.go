@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// scholarEngineMock adds a scholar search to engineMock.
+type scholarEngineMock struct {
+	*engineMock
+	scholarFn func(context.Context, Query) ([]SearchResult, error)
+}
+
+func (e *scholarEngineMock) SearchScholar(ctx context.Context, q Query) ([]SearchResult, error) {
+	return e.scholarFn(ctx, q)
+}
+
+func scholarItem(rank int, url, title string, citedBy int) SearchResult {
+	return SearchResult{
+		Rank:        rank,
+		URL:         url,
+		Title:       title,
+		Description: "The dominant sequence transduction models are based on complex recurrent networks.",
+		Scholar: &ScholarMeta{
+			Authors: []string{"A Vaswani", "N Shazeer"},
+			Venue:   "NeurIPS",
+			Year:    2017,
+			CitedBy: citedBy,
+			PDFURL:  "https://arxiv.org/pdf/1706.03762",
+		},
+	}
+}
+
+func TestScholarEndpointReturnsScholarResults(t *testing.T) {
+	var gotQuery Query
+	google := &scholarEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		scholarFn: func(_ context.Context, q Query) ([]SearchResult, error) {
+			gotQuery = q
+			return []SearchResult{scholarItem(1, "https://proceedings.neurips.cc/paper/2017/attention", "Attention is all you need", 152341)}, nil
+		},
+	}
+	baidu := &scholarEngineMock{
+		engineMock: &engineMock{name: "baidu", initialized: true},
+		scholarFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{scholarItem(1, "https://xueshu.baidu.com/usercenter/paper/show?paperid=1", "Attention Is All You Need", 98000)}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7253, opts, google, baidu, duck)
+
+	resp := request(t, srv, "/google/scholar?text=attention&date=20170101..20201231")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/scholar, got %d", resp.StatusCode)
+	}
+	if gotQuery.DateInterval != "20170101..20201231" {
+		t.Fatalf("expected the date interval to reach the engine, got %q", gotQuery.DateInterval)
+	}
+	var env ScholarEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode scholar envelope: %v", err)
+	}
+	if len(env.Results) != 1 {
+		t.Fatalf("expected 1 scholar result, got %d", len(env.Results))
+	}
+	got := env.Results[0]
+	if got.Type != ResultTypeScholar || got.ID[:2] != "a_" || got.Domain != "proceedings.neurips.cc" {
+		t.Fatalf("unexpected scholar result: %+v", got)
+	}
+	if len(got.Authors) != 2 || got.Venue != "NeurIPS" || got.Year != 2017 || got.CitedBy != 152341 || got.PDFURL == "" {
+		t.Fatalf("unexpected citation data: %+v", got)
+	}
+
+	resp = request(t, srv, "/mega/scholar?text=attention&engines=google,baidu,duckduckgo")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /mega/scholar, got %d", resp.StatusCode)
+	}
+	var mega ScholarEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&mega); err != nil {
+		t.Fatalf("decode mega scholar envelope: %v", err)
+	}
+	if len(mega.Results) != 1 {
+		t.Fatalf("expected the same paper from both engines to dedupe by title, got %d results", len(mega.Results))
+	}
+
+	if resp := request(t, srv, "/duck/scholar?text=attention"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected /duck/scholar to be unrouted, got %d", resp.StatusCode)
+	}
+}
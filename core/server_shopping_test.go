@@ -0,0 +1,104 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// shoppingEngineMock adds a shopping tab to engineMock.
+type shoppingEngineMock struct {
+	*engineMock
+	shoppingFn func(context.Context, Query) ([]SearchResult, error)
+}
+
+func (e *shoppingEngineMock) SearchShopping(ctx context.Context, q Query) ([]SearchResult, error) {
+	return e.shoppingFn(ctx, q)
+}
+
+func productItem(rank int, url, title, merchant string, price float64) SearchResult {
+	return SearchResult{
+		Rank:    rank,
+		URL:     url,
+		Title:   title,
+		Product: &ProductMeta{Price: price, Currency: "USD", Merchant: merchant, Rating: 4.5, ReviewCount: 120},
+	}
+}
+
+func TestShoppingEndpointReturnsProductResults(t *testing.T) {
+	google := &shoppingEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		shoppingFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{productItem(1, "https://www.bestbuy.com/site/pixel-8", "Google Pixel 8", "Best Buy", 499.99)}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7250, opts, google, duck)
+
+	resp := request(t, srv, "/google/shopping?text=pixel+8")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/shopping, got %d", resp.StatusCode)
+	}
+	var env ShoppingEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode shopping envelope: %v", err)
+	}
+	if len(env.Results) != 1 {
+		t.Fatalf("expected 1 product result, got %d", len(env.Results))
+	}
+	got := env.Results[0]
+	if got.Type != ResultTypeShopping || got.Price != 499.99 || got.Currency != "USD" || got.ID[:2] != "p_" {
+		t.Fatalf("unexpected product result: %+v", got)
+	}
+	if got.Merchant != "Best Buy" || got.Rating != 4.5 || got.ReviewCount != 120 || got.Domain != "bestbuy.com" {
+		t.Fatalf("unexpected product metadata: %+v", got)
+	}
+
+	if resp := request(t, srv, "/duck/shopping?text=pixel"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected /duck/shopping to be unrouted, got %d", resp.StatusCode)
+	}
+}
+
+func TestMegaShoppingSkipsEnginesWithoutShopping(t *testing.T) {
+	google := &shoppingEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		shoppingFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				productItem(1, "https://www.bestbuy.com/site/pixel-8", "Google Pixel 8", "Best Buy", 499.99),
+				productItem(2, "https://store.google.com/product/pixel_8", "Pixel 8", "Google Store", 699),
+			}, nil
+		},
+	}
+	bing := &shoppingEngineMock{
+		engineMock: &engineMock{name: "bing", initialized: true},
+		shoppingFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				productItem(1, "https://www.bestbuy.com/site/pixel-8", "Google Pixel 8 128GB", "Best Buy", 499.99),
+			}, nil
+		},
+	}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	opts.Resilience.Retry.MaxRetries = 0
+	srv := NewServerWithOptions("127.0.0.1", 7251, opts, google, bing, &engineMock{name: "duckduckgo", initialized: true})
+
+	resp := request(t, srv, "/mega/shopping?text=pixel+8")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /mega/shopping, got %d", resp.StatusCode)
+	}
+	var env ShoppingEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode shopping envelope: %v", err)
+	}
+	if len(env.Results) != 2 {
+		t.Fatalf("expected the shared offer to dedupe to 2 products, got %+v", env.Results)
+	}
+	if len(env.Query.EnginesRequested) != 2 {
+		t.Fatalf("expected engines without a shopping tab to be skipped, got %v", env.Query.EnginesRequested)
+	}
+}
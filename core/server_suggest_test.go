@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// suggestEngineMock adds an autocomplete endpoint to engineMock.
+type suggestEngineMock struct {
+	*engineMock
+	suggestions []string
+}
+
+func (e *suggestEngineMock) Suggest(_ context.Context, q Query) ([]SearchResult, error) {
+	return SuggestionResults(e.suggestions, q.Limit), nil
+}
+
+func TestSuggestEndpoint(t *testing.T) {
+	google := &suggestEngineMock{
+		engineMock:  &engineMock{name: "google", initialized: true},
+		suggestions: []string{"golang tutorial", "golang generics"},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7195, opts, google, duck)
+
+	resp := request(t, srv, "/google/suggest?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/suggest, got %d", resp.StatusCode)
+	}
+	var env SuggestEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode suggest envelope: %v", err)
+	}
+	if len(env.Results) != 2 || env.Results[0].Text != "golang tutorial" || env.Results[0].Engine != "google" || env.Results[1].Rank != 2 {
+		t.Fatalf("unexpected suggestions: %+v", env.Results)
+	}
+
+	if resp := request(t, srv, "/google/suggest?site=go.dev"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 without text, got %d", resp.StatusCode)
+	}
+	if resp := request(t, srv, "/duck/suggest?text=golang"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected /duck/suggest to be unrouted, got %d", resp.StatusCode)
+	}
+}
+
+func TestMegaSuggestMergesAcrossEngines(t *testing.T) {
+	google := &suggestEngineMock{
+		engineMock:  &engineMock{name: "google", initialized: true},
+		suggestions: []string{"golang tutorial", "golang generics"},
+	}
+	bing := &suggestEngineMock{
+		engineMock:  &engineMock{name: "bing", initialized: true},
+		suggestions: []string{"Golang Generics", "golang jobs"},
+	}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	opts.Resilience.Retry.MaxRetries = 0
+	srv := NewServerWithOptions("127.0.0.1", 7196, opts, google, bing, &engineMock{name: "duckduckgo", initialized: true})
+
+	resp := request(t, srv, "/mega/suggest?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /mega/suggest, got %d", resp.StatusCode)
+	}
+	var env SuggestEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode suggest envelope: %v", err)
+	}
+	if len(env.Results) != 3 {
+		t.Fatalf("expected the shared phrase to merge into 3 suggestions, got %+v", env.Results)
+	}
+	for _, r := range env.Results {
+		if SuggestionKey(r.Text) == "golang generics" && len(r.Engines) != 2 {
+			t.Fatalf("expected merged suggestion to list both engines, got %+v", r)
+		}
+	}
+
+	if resp := request(t, srv, "/mega/suggest?text=golang&engines=duckduckgo"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 when no engine has suggestions, got %d", resp.StatusCode)
+	}
+}
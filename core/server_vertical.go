@@ -0,0 +1,168 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// verticalEnvelope is one vertical's response envelope behind the steps the
+// dedicated and mega handlers run on every vertical: fill, finalize, cache
+// and send. Only the result type, enricher and renderer differ.
+type verticalEnvelope struct {
+	// payload is the concrete envelope (*Envelope, *NewsEnvelope, ...) that
+	// is cached and serialized.
+	payload interface{}
+	meta    *ResponseMeta
+	// query is nil for suggestions, which report no SafeSearch or operator
+	// gaps.
+	query    *QueryEcho
+	add      func(r SearchResult, ectx EnrichContext)
+	count    func() int
+	finalize func(q Query)
+	send     func(c *fiber.Ctx, format string) error
+	// extract fetches result pages for extract=true; nil when the vertical
+	// has no page content to extract.
+	extract func(ctx context.Context, q Query, format string)
+	// merge folds duplicates after enrichment, for suggestions whose
+	// duplicates only show once normalized. The other verticals dedupe raw
+	// results, see dedupeMegaVertical.
+	merge func()
+}
+
+// newVerticalEnvelope builds the empty envelope for vertical.
+func (s *Server) newVerticalEnvelope(vertical Vertical, q Query, requestID string, startedAt time.Time, engines []string) verticalEnvelope {
+	switch vertical {
+	case VerticalImage:
+		env := NewImageEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichImageResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendImageEnvelope(c, format, env) },
+		}
+	case VerticalNews:
+		env := NewNewsEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichNewsResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendNewsEnvelope(c, format, env) },
+		}
+	case VerticalVideo:
+		env := NewVideoEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichVideoResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendVideoEnvelope(c, format, env) },
+		}
+	case VerticalSuggest:
+		env := NewSuggestEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichSuggestion(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(Query) { env.Finalize(startedAt) },
+			send:     func(c *fiber.Ctx, format string) error { return sendSuggestEnvelope(c, format, env) },
+			merge:    func() { env.Results = mergeSuggestions(env.Results) },
+		}
+	case VerticalShopping:
+		env := NewShoppingEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichProductResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendShoppingEnvelope(c, format, env) },
+		}
+	case VerticalLocal:
+		env := NewLocalEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichLocalResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendLocalEnvelope(c, format, env) },
+		}
+	case VerticalScholar:
+		env := NewScholarEnvelope(q, requestID, startedAt, engines)
+		return verticalEnvelope{
+			payload: env, meta: &env.Meta, query: &env.Query,
+			add: func(r SearchResult, ectx EnrichContext) {
+				env.Results = append(env.Results, EnrichScholarResult(r, ectx))
+			},
+			count:    func() int { return len(env.Results) },
+			finalize: func(q Query) { env.Finalize(startedAt, q) },
+			send:     func(c *fiber.Ctx, format string) error { return sendScholarEnvelope(c, format, env) },
+			extract: func(ctx context.Context, q Query, format string) {
+				s.enrichScholarEnvelopeWithExtraction(ctx, env, q, format)
+			},
+		}
+	}
+
+	env := NewEnvelope(q, requestID, startedAt, engines)
+	return verticalEnvelope{
+		payload: env, meta: &env.Meta, query: &env.Query,
+		add: func(r SearchResult, ectx EnrichContext) {
+			AppendEnrichedSearchResult(env, r, ectx, startedAt)
+		},
+		count:    func() int { return len(env.Results) },
+		finalize: func(q Query) { env.Finalize(startedAt, q) },
+		send:     func(c *fiber.Ctx, format string) error { return sendEnvelope(c, format, env) },
+		extract: func(ctx context.Context, q Query, format string) {
+			s.enrichEnvelopeWithExtraction(ctx, env, q, format)
+		},
+	}
+}
+
+// reportGaps records the requested engines that cannot honour SafeSearch,
+// verbatim or every query operator on vertical.
+func (ve verticalEnvelope) reportGaps(engines []SearchEngine, q Query, vertical Vertical) {
+	if ve.query == nil {
+		return
+	}
+	ve.query.SafeUnsupported = SafeSearchUnsupported(engines, q.SafeSearch)
+	ve.query.VerbatimUnsupported = VerbatimUnsupported(engines, q.Verbatim, vertical)
+	ve.meta.UnsupportedOperators = unsupportedOperatorsByEngine(engines, q, vertical)
+}
+
+// echoDevice echoes the device the engines searched from rather than the one
+// requested, so a desktop profile standing in for device=mobile is visible.
+func (ve verticalEnvelope) echoDevice(ctx context.Context, requested Device) {
+	if ve.query != nil {
+		ve.query.Device = usedDevice(ctx, requested)
+	}
+}
+
+// dedupeMegaVertical drops cross-engine duplicates the way vertical compares
+// them. Suggestions pass through; they are merged after enrichment.
+func (s *Server) dedupeMegaVertical(vertical Vertical, results []MegaSearchResult) []MegaSearchResult {
+	switch vertical {
+	case VerticalNews:
+		return s.deduplicateMegaNews(results)
+	case VerticalScholar:
+		return s.deduplicateMegaScholar(results)
+	case VerticalSuggest:
+		return results
+	case VerticalVideo:
+		return s.deduplicateMegaVideos(results)
+	}
+	return s.deduplicateMegaResults(results)
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// videoEngineMock adds a video tab to engineMock.
+type videoEngineMock struct {
+	*engineMock
+	videosFn func(context.Context, Query) ([]SearchResult, error)
+}
+
+func (e *videoEngineMock) SearchVideos(ctx context.Context, q Query) ([]SearchResult, error) {
+	return e.videosFn(ctx, q)
+}
+
+func videoItem(rank int, url, title, channel string, duration time.Duration) SearchResult {
+	return SearchResult{
+		Rank:  rank,
+		URL:   url,
+		Title: title,
+		Video: &VideoMeta{Channel: channel, Duration: duration, Thumbnail: "https://i.ytimg.com/vi/x/hqdefault.jpg"},
+	}
+}
+
+func TestVideosEndpointReturnsVideoResults(t *testing.T) {
+	google := &videoEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		videosFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{videoItem(1, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "Go concurrency", "GopherCon", 754*time.Second)}, nil
+		},
+	}
+	duck := &engineMock{name: "duckduckgo", initialized: true}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	srv := NewServerWithOptions("127.0.0.1", 7193, opts, google, duck)
+
+	resp := request(t, srv, "/google/videos?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /google/videos, got %d", resp.StatusCode)
+	}
+	var env VideoEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode video envelope: %v", err)
+	}
+	if len(env.Results) != 1 {
+		t.Fatalf("expected 1 video result, got %d", len(env.Results))
+	}
+	got := env.Results[0]
+	if got.Type != ResultTypeVideo || got.Duration != "12:34" || got.DurationSeconds != 754 {
+		t.Fatalf("unexpected video result: %+v", got)
+	}
+	if got.Channel != "GopherCon" || got.Platform != "youtube" || got.ID[:2] != "v_" {
+		t.Fatalf("unexpected video metadata: %+v", got)
+	}
+
+	if resp := request(t, srv, "/duck/videos?text=golang"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected /duck/videos to be unrouted, got %d", resp.StatusCode)
+	}
+}
+
+func TestMegaVideosDedupesByCanonicalVideo(t *testing.T) {
+	google := &videoEngineMock{
+		engineMock: &engineMock{name: "google", initialized: true},
+		videosFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				videoItem(1, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "Go concurrency", "GopherCon", 0),
+				videoItem(2, "https://vimeo.com/123456789", "Go tour", "Go Team", 0),
+			}, nil
+		},
+	}
+	bing := &videoEngineMock{
+		engineMock: &engineMock{name: "bing", initialized: true},
+		videosFn: func(_ context.Context, _ Query) ([]SearchResult, error) {
+			return []SearchResult{
+				videoItem(1, "https://youtu.be/dQw4w9WgXcQ", "Go concurrency patterns", "GopherCon", 0),
+				videoItem(2, "https://www.youtube.com/shorts/abcdefghijk", "Go in 60 seconds", "Fireship", 0),
+			}, nil
+		},
+	}
+
+	opts := DefaultServerOptions()
+	opts.CacheTTL = 0
+	opts.Resilience.Retry.MaxRetries = 0
+	srv := NewServerWithOptions("127.0.0.1", 7194, opts, google, bing, &engineMock{name: "duckduckgo", initialized: true})
+
+	resp := request(t, srv, "/mega/videos?text=golang")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from /mega/videos, got %d", resp.StatusCode)
+	}
+	var env VideoEnvelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("decode video envelope: %v", err)
+	}
+	if len(env.Results) != 3 {
+		t.Fatalf("expected the shared YouTube clip to dedupe to 3 videos, got %+v", env.Results)
+	}
+	if len(env.Query.EnginesRequested) != 2 {
+		t.Fatalf("expected engines without a video tab to be skipped, got %v", env.Query.EnginesRequested)
+	}
+}
@@ -0,0 +1,235 @@
+package core
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ProductMeta is the offer data a shopping tab or product carousel shows next
+// to each product. It rides on SearchResult for shopping-tab results and on
+// FeatureItem for inline product carousels.
+type ProductMeta struct {
+	// Price is zero when the card showed no parseable price.
+	Price float64 `json:"price,omitempty"`
+	// Currency is the ISO 4217 code derived from the price symbol.
+	Currency string `json:"currency,omitempty"`
+	// Merchant is the store selling the offer, for example "Amazon.com".
+	Merchant string `json:"merchant,omitempty"`
+	// Rating is the average star rating on a 5-point scale.
+	Rating      float64 `json:"rating,omitempty"`
+	ReviewCount int     `json:"review_count,omitempty"`
+	// Thumbnail is the product image URL.
+	Thumbnail string `json:"thumbnail,omitempty"`
+}
+
+// currencySymbols maps price markers to ISO 4217 codes. Longer markers are
+// listed first so "US$" wins over "$".
+var currencySymbols = []struct {
+	marker string
+	code   string
+}{
+	{"US$", "USD"}, {"CA$", "CAD"}, {"C$", "CAD"}, {"A$", "AUD"}, {"R$", "BRL"},
+	{"CN¥", "CNY"}, {"руб", "RUB"}, {"грн", "UAH"}, {"тг", "KZT"},
+	{"zł", "PLN"}, {"Kč", "CZK"}, {"元", "CNY"}, {"円", "JPY"},
+	{"$", "USD"}, {"€", "EUR"}, {"£", "GBP"}, {"¥", "JPY"}, {"￥", "JPY"},
+	{"₽", "RUB"}, {"₹", "INR"}, {"₩", "KRW"}, {"₺", "TRY"}, {"₴", "UAH"},
+}
+
+var (
+	isoCurrencyPattern = regexp.MustCompile(`\b(USD|EUR|GBP|JPY|CNY|RUB|INR|KRW|TRY|UAH|KZT|PLN|CZK|CAD|AUD|BRL|CHF|SEK|NOK|DKK)\b`)
+	// priceNumberPattern matches the first amount including thousands and
+	// decimal separators: "1,299.99", "1.299,99", "1 299", "12'500".
+	priceNumberPattern = regexp.MustCompile(`\d[\d\x{00a0}\x{202f} ,.']*`)
+	ratingPattern      = regexp.MustCompile(`\d(?:[.,]\d+)?`)
+	reviewCountPattern = regexp.MustCompile(`(\d[\d,.\x{00a0}\x{202f} ]*)\s*([kK]\b|тыс)?`)
+)
+
+// ParsePrice extracts the amount and ISO currency from a price label such as
+// "$1,299.99", "1 299 ₽", "12,50 €" or "EUR 12.50". For ranges ("$10–$20") it
+// returns the lower bound. ok is false when the label holds no amount.
+func ParsePrice(text string) (amount float64, currency string, ok bool) {
+	text = strings.TrimSpace(text)
+	currency = PriceCurrency(text)
+
+	raw := strings.TrimRight(priceNumberPattern.FindString(text), " ,.'\u00a0\u202f")
+	if raw == "" {
+		return 0, currency, false
+	}
+	amount, err := strconv.ParseFloat(normalizePriceNumber(raw), 64)
+	if err != nil {
+		return 0, currency, false
+	}
+	return amount, currency, true
+}
+
+// PriceCurrency returns the ISO code of the first currency marker in text, or
+// "" when there is none.
+func PriceCurrency(text string) string {
+	if code := isoCurrencyPattern.FindString(text); code != "" {
+		return code
+	}
+	best, bestAt := "", -1
+	for _, symbol := range currencySymbols {
+		if at := strings.Index(text, symbol.marker); at >= 0 && (bestAt < 0 || at < bestAt) {
+			best, bestAt = symbol.code, at
+		}
+	}
+	return best
+}
+
+// normalizePriceNumber turns a localized amount into a ParseFloat input. The
+// last "," or "." is the decimal separator only when one or two digits follow
+// it; every other separator groups thousands.
+func normalizePriceNumber(raw string) string {
+	var digits strings.Builder
+	decimalAt := -1
+	if at := strings.LastIndexAny(raw, ",."); at >= 0 {
+		tail := len(raw) - at - 1
+		if tail == 1 || tail == 2 {
+			decimalAt = at
+		}
+	}
+	for i, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case i == decimalAt:
+			digits.WriteByte('.')
+		}
+	}
+	return digits.String()
+}
+
+// ParseRating extracts a star rating from "4.5", "4,7 out of 5" or "Rated 4.2
+// stars". Values outside 0-5 are rejected.
+func ParseRating(text string) float64 {
+	match := ratingPattern.FindString(text)
+	if match == "" {
+		return 0
+	}
+	rating, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", "."), 64)
+	if err != nil || rating < 0 || rating > 5 {
+		return 0
+	}
+	return rating
+}
+
+// ParseReviewCount extracts a review count from "(1,234)", "1.2K reviews" or
+// "1,2 тыс. отзывов".
+func ParseReviewCount(text string) int {
+	match := reviewCountPattern.FindStringSubmatch(text)
+	if match == nil {
+		return 0
+	}
+	number := strings.TrimSpace(match[1])
+	if match[2] != "" {
+		value, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimRight(number, ",."), ",", "."), 64)
+		if err != nil {
+			return 0
+		}
+		return int(value * 1000)
+	}
+	return int(ParseResultCount(number))
+}
+
+// FormatProductPrice renders a price for text output: "1299.99 USD". It
+// returns "" for a zero price.
+func FormatProductPrice(price float64, currency string) string {
+	if price <= 0 {
+		return ""
+	}
+	label := strconv.FormatFloat(price, 'f', -1, 64)
+	if currency != "" {
+		label += " " + currency
+	}
+	return label
+}
+
+// ProductCardSelectors locates the fields of one product card. Engines use the
+// same shape for their shopping tab and for inline product carousels.
+type ProductCardSelectors struct {
+	Link      string
+	Title     string
+	Price     string
+	Merchant  string
+	Rating    string
+	Reviews   string
+	Thumbnail string
+}
+
+// ParseProductCard reads one product card. resolve turns the raw link href
+// into an absolute offer URL and may return "" to drop the card; ok is false
+// when the card has no title or link.
+func ParseProductCard(card *goquery.Selection, sel ProductCardSelectors, resolve func(string) string) (title, link string, meta *ProductMeta, ok bool) {
+	href := strings.TrimSpace(card.Find(sel.Link).First().AttrOr("href", ""))
+	if href == "" && card.Is(sel.Link) {
+		href = strings.TrimSpace(card.AttrOr("href", ""))
+	}
+	if href != "" && resolve != nil {
+		href = resolve(href)
+	}
+	title = NormalizeWhitespace(card.Find(sel.Title).First().Text())
+	if !strings.HasPrefix(href, "http") || title == "" {
+		return "", "", nil, false
+	}
+
+	meta = &ProductMeta{
+		Merchant: NormalizeWhitespace(card.Find(sel.Merchant).First().Text()),
+	}
+	if price, currency, found := ParsePrice(card.Find(sel.Price).First().Text()); found {
+		meta.Price, meta.Currency = price, currency
+	}
+	if sel.Rating != "" {
+		rating := card.Find(sel.Rating).First()
+		meta.Rating = ParseRating(firstNonEmpty(rating.AttrOr("aria-label", ""), rating.Text()))
+	}
+	if sel.Reviews != "" {
+		meta.ReviewCount = ParseReviewCount(card.Find(sel.Reviews).First().Text())
+	}
+	if sel.Thumbnail != "" {
+		img := card.Find(sel.Thumbnail).First()
+		src := strings.TrimSpace(firstNonEmpty(img.AttrOr("data-src", ""), img.AttrOr("src", "")))
+		if strings.HasPrefix(src, "//") {
+			src = "https:" + src
+		}
+		if strings.HasPrefix(src, "http") {
+			meta.Thumbnail = src
+		}
+	}
+	return title, href, meta, true
+}
+
+// ExtractProductCarousel turns each inline product carousel matched by
+// container into a shopping SerpFeature with one item per card.
+func ExtractProductCarousel(doc *goquery.Document, container, card string, sel ProductCardSelectors, resolve func(string) string) []SerpFeature {
+	var features []SerpFeature
+	doc.Find(container).Each(func(_ int, block *goquery.Selection) {
+		var items []FeatureItem
+		block.Find(card).Each(func(_ int, node *goquery.Selection) {
+			title, link, meta, ok := ParseProductCard(node, sel, resolve)
+			if !ok {
+				return
+			}
+			items = append(items, FeatureItem{
+				Title:   title,
+				Text:    FormatProductPrice(meta.Price, meta.Currency),
+				Link:    link,
+				Product: meta,
+			})
+		})
+		if len(items) == 0 {
+			return
+		}
+		features = append(features, SerpFeature{
+			Type:       ResultTypeShopping,
+			Title:      "Products",
+			Items:      items,
+			Confidence: 0.7,
+			Layout:     SelectionLayout(block),
+		})
+	})
+	return DeduplicateSerpFeatures(features)
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParsePrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text     string
+		amount   float64
+		currency string
+	}{
+		{"$1,299.99", 1299.99, "USD"},
+		{"1 299 ₽", 1299, "RUB"},
+		{"от 12 500 руб.", 12500, "RUB"},
+		{"12,50 €", 12.5, "EUR"},
+		{"EUR 1.299,00", 1299, "EUR"},
+		{"£45", 45, "GBP"},
+		{"US$10 – US$20", 10, "USD"},
+		{"¥12,800", 12800, "JPY"},
+	}
+	for _, tt := range tests {
+		amount, currency, ok := ParsePrice(tt.text)
+		if !ok || amount != tt.amount || currency != tt.currency {
+			t.Errorf("ParsePrice(%q) = %v, %q, %v; want %v, %q", tt.text, amount, currency, ok, tt.amount, tt.currency)
+		}
+	}
+
+	if _, _, ok := ParsePrice("Free shipping"); ok {
+		t.Fatal("expected no price in a label without digits")
+	}
+}
+
+func TestParseRatingAndReviewCount(t *testing.T) {
+	t.Parallel()
+
+	if got := ParseRating("Rated 4.6 out of 5"); got != 4.6 {
+		t.Fatalf("ParseRating() = %v", got)
+	}
+	if got := ParseRating("4,8"); got != 4.8 {
+		t.Fatalf("ParseRating() with decimal comma = %v", got)
+	}
+	if got := ParseRating("7"); got != 0 {
+		t.Fatalf("expected out-of-range rating to be rejected, got %v", got)
+	}
+
+	for text, want := range map[string]int{"(1,234)": 1234, "2.3K reviews": 2300, "1,2 тыс. отзывов": 1200, "87 отзывов": 87} {
+		if got := ParseReviewCount(text); got != want {
+			t.Errorf("ParseReviewCount(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
+
+func TestExtractProductCarousel(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="ads">
+<div class="card"><a href="/offer/1">x</a><b>Pixel 8</b><i>$499.00</i><u>Best Buy</u><img src="//img.example.com/p.jpg"></div>
+<div class="card"><b>No link</b><i>$1</i></div>
+</div>`))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	sel := ProductCardSelectors{Link: "a", Title: "b", Price: "i", Merchant: "u", Thumbnail: "img"}
+	features := ExtractProductCarousel(doc, "div.ads", "div.card", sel, func(href string) string {
+		return "https://shop.example.com" + href
+	})
+	if len(features) != 1 || len(features[0].Items) != 1 {
+		t.Fatalf("expected one carousel with one product, got %+v", features)
+	}
+	item := features[0].Items[0]
+	if features[0].Type != ResultTypeShopping || item.Link != "https://shop.example.com/offer/1" || item.Text != "499 USD" {
+		t.Fatalf("unexpected carousel item: %+v", item)
+	}
+	if item.Product.Merchant != "Best Buy" || item.Product.Thumbnail != "https://img.example.com/p.jpg" {
+		t.Fatalf("unexpected product metadata: %+v", item.Product)
+	}
+}
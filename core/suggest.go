@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FetchSuggestions runs a raw GET against an autocomplete endpoint and turns
+// the strings parse extracts into ranked suggestions capped at query.Limit.
+// Suggest endpoints are plain JSON APIs, so every engine uses the raw client
+// regardless of runtime; profile headers and the query proxy still apply.
+func FetchSuggestions(ctx context.Context, suggestURL string, query Query, parse func([]byte) ([]string, error)) ([]SearchResult, error) {
+	res, err := RawSearchRequest(ctx, suggestURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer DrainAndCloseResponse(res)
+
+	body, err := ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrParser, err)
+	}
+	return SuggestionResults(texts, query.Limit), nil
+}
+
+// SuggestionResults converts suggestion strings into ranked results. Blank
+// entries and case-insensitive repeats are dropped; limit <= 0 keeps all.
+func SuggestionResults(texts []string, limit int) []SearchResult {
+	results := []SearchResult{}
+	seen := map[string]bool{}
+	for _, text := range texts {
+		text = NormalizeWhitespace(text)
+		key := SuggestionKey(text)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		results = append(results, SearchResult{Rank: len(results) + 1, Title: text})
+		if limit > 0 && len(results) == limit {
+			break
+		}
+	}
+	return results
+}
+
+// SuggestionKey is the case- and whitespace-insensitive identity of a
+// suggestion, used to merge the same phrase across engines.
+func SuggestionKey(text string) string {
+	return strings.ToLower(NormalizeWhitespace(text))
+}
+
+// ParseOpenSearchSuggestions decodes the OpenSearch suggestions format,
+// ["query", ["first", "second", ...], ...], served by most engines.
+func ParseOpenSearchSuggestions(body []byte) ([]string, error) {
+	var payload []json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if len(payload) < 2 {
+		return nil, errors.New("opensearch suggestions: missing completions array")
+	}
+	var texts []string
+	if err := json.Unmarshal(payload[1], &texts); err != nil {
+		return nil, fmt.Errorf("opensearch suggestions: %w", err)
+	}
+	return texts, nil
+}
+
+// mergeSuggestions collapses the same phrase suggested by several engines.
+// The merged entry keeps the best rank and the engine that gave it, lists all
+// contributing engines, and phrases more engines agree on sort first among
+// equal ranks.
+func mergeSuggestions(results []SuggestionResult) []SuggestionResult {
+	byKey := make(map[string]int, len(results))
+	merged := make([]SuggestionResult, 0, len(results))
+	for _, result := range results {
+		key := SuggestionKey(result.Text)
+		idx, ok := byKey[key]
+		if !ok {
+			result.Engines = []string{result.Engine}
+			byKey[key] = len(merged)
+			merged = append(merged, result)
+			continue
+		}
+		existing := &merged[idx]
+		if !containsString(existing.Engines, result.Engine) {
+			existing.Engines = append(existing.Engines, result.Engine)
+		}
+		if result.Rank < existing.Rank {
+			existing.Rank = result.Rank
+			existing.Engine = result.Engine
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Rank != merged[j].Rank {
+			return merged[i].Rank < merged[j].Rank
+		}
+		return len(merged[i].Engines) > len(merged[j].Engines)
+	})
+	return merged
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOpenSearchSuggestions(t *testing.T) {
+	texts, err := ParseOpenSearchSuggestions([]byte(`["golang",["golang tutorial","golang generics"],[],{"google:suggesttype":["QUERY","QUERY"]}]`))
+	if err != nil {
+		t.Fatalf("ParseOpenSearchSuggestions() error = %v", err)
+	}
+	if want := []string{"golang tutorial", "golang generics"}; !reflect.DeepEqual(texts, want) {
+		t.Fatalf("unexpected suggestions %v", texts)
+	}
+	if _, err := ParseOpenSearchSuggestions([]byte(`["golang"]`)); err == nil {
+		t.Fatal("expected a payload without completions to fail")
+	}
+	if _, err := ParseOpenSearchSuggestions([]byte(`<html>`)); err == nil {
+		t.Fatal("expected non-JSON to fail")
+	}
+}
+
+func TestSuggestionResultsNormalizesAndLimits(t *testing.T) {
+	results := SuggestionResults([]string{" golang  tutorial", "", "Golang Tutorial", "golang generics", "golang jobs"}, 2)
+	if len(results) != 2 {
+		t.Fatalf("expected limit to cap suggestions, got %+v", results)
+	}
+	if results[0].Title != "golang tutorial" || results[0].Rank != 1 || results[1].Title != "golang generics" || results[1].Rank != 2 {
+		t.Fatalf("unexpected suggestions %+v", results)
+	}
+}
+
+func TestMergeSuggestions(t *testing.T) {
+	merged := mergeSuggestions([]SuggestionResult{
+		{Text: "golang tutorial", Rank: 1, Engine: "google"},
+		{Text: "golang generics", Rank: 2, Engine: "google"},
+		{Text: "golang jobs", Rank: 1, Engine: "bing"},
+		{Text: "Golang Generics", Rank: 1, Engine: "yandex"},
+	})
+	if len(merged) != 3 {
+		t.Fatalf("expected case-insensitive merge to 3 suggestions, got %+v", merged)
+	}
+	first := merged[0]
+	if first.Text != "golang generics" || first.Engine != "yandex" || !reflect.DeepEqual(first.Engines, []string{"google", "yandex"}) {
+		t.Fatalf("expected the phrase two engines agree on to lead, got %+v", first)
+	}
+	if merged[1].Text != "golang tutorial" || merged[2].Text != "golang jobs" {
+		t.Fatalf("unexpected order %+v", merged)
+	}
+}
@@ -0,0 +1,32 @@
+package core
+
+// VerbatimSupporter is implemented by engines (or their raw and pooled
+// wrappers) that can switch off spelling correction and query rewriting on a
+// vertical.
+type VerbatimSupporter interface {
+	SupportsVerbatim(Vertical) bool
+}
+
+// EngineSupportsVerbatim reports whether engine can run a verbatim=true query
+// on vertical as typed. An engine that does not implement VerbatimSupporter
+// has no such switch.
+func EngineSupportsVerbatim(engine SearchEngine, vertical Vertical) bool {
+	supporter, ok := engine.(VerbatimSupporter)
+	return ok && supporter.SupportsVerbatim(vertical)
+}
+
+// VerbatimUnsupported lists the engines that cannot honour verbatim on
+// vertical, for the query echo. It returns nil when verbatim is off or every
+// engine can.
+func VerbatimUnsupported(engines []SearchEngine, verbatim bool, vertical Vertical) []string {
+	if !verbatim {
+		return nil
+	}
+	var names []string
+	for _, engine := range engines {
+		if !EngineSupportsVerbatim(engine, vertical) {
+			names = append(names, engine.Name())
+		}
+	}
+	return names
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"errors"
+)
+
+// Vertical names a search tab. Its value doubles as the route suffix
+// (/{engine}/{vertical}) and the cache action, so "search" is the web tab.
+type Vertical string
+
+const (
+	VerticalWeb      Vertical = "search"
+	VerticalImage    Vertical = "image"
+	VerticalNews     Vertical = "news"
+	VerticalVideo    Vertical = "videos"
+	VerticalShopping Vertical = "shopping"
+	VerticalLocal    Vertical = "local"
+	VerticalScholar  Vertical = "scholar"
+	VerticalSuggest  Vertical = "suggest"
+)
+
+// Verticals lists every tab in route order.
+var Verticals = []Vertical{VerticalWeb, VerticalImage, VerticalNews, VerticalVideo, VerticalShopping, VerticalLocal, VerticalScholar, VerticalSuggest}
+
+// ErrUnsupportedVertical is returned when an engine is asked for a tab it does
+// not implement.
+var ErrUnsupportedVertical = errors.New("unsupported_vertical")
+
+// NewsSearcher is implemented by engines that can query a news tab. Results
+// carry SearchResult.News with the publisher and publication time.
+type NewsSearcher interface {
+	SearchNews(context.Context, Query) ([]SearchResult, error)
+}
+
+// VideoSearcher is implemented by engines that can query a video tab. Results
+// carry SearchResult.Video with duration, channel and platform.
+type VideoSearcher interface {
+	SearchVideos(context.Context, Query) ([]SearchResult, error)
+}
+
+// LocalSearcher is implemented by engines that can query a local or maps tab.
+// Results carry SearchResult.Local with the business listing.
+type LocalSearcher interface {
+	SearchLocal(context.Context, Query) ([]SearchResult, error)
+}
+
+// ShoppingSearcher is implemented by engines that can query a shopping tab.
+// Results carry SearchResult.Product with price, merchant and rating.
+type ShoppingSearcher interface {
+	SearchShopping(context.Context, Query) ([]SearchResult, error)
+}
+
+// ScholarSearcher is implemented by engines that can query a scholarly
+// literature index. Results carry SearchResult.Scholar with authors, venue
+// and citation counts.
+type ScholarSearcher interface {
+	SearchScholar(context.Context, Query) ([]SearchResult, error)
+}
+
+// Suggester is implemented by engines with a query autocomplete endpoint.
+// Each suggestion is a SearchResult whose Title holds the suggested query and
+// whose Rank is its position in the dropdown; URL is empty.
+type Suggester interface {
+	Suggest(context.Context, Query) ([]SearchResult, error)
+}
+
+// VerticalSupporter lets wrapper engines (raw and pooled browser adapters)
+// report which optional tabs the engine behind them implements, since the
+// wrapper itself has every method.
+type VerticalSupporter interface {
+	SupportsVertical(Vertical) bool
+}
+
+// EngineSupportsVertical reports whether engine serves vertical v. Web and
+// image are part of SearchEngine; other tabs need their optional interface.
+func EngineSupportsVertical(engine SearchEngine, v Vertical) bool {
+	switch v {
+	case VerticalWeb, VerticalImage:
+		return true
+	}
+	if supporter, ok := engine.(VerticalSupporter); ok {
+		return supporter.SupportsVertical(v)
+	}
+	switch v {
+	case VerticalNews:
+		_, ok := engine.(NewsSearcher)
+		return ok
+	case VerticalVideo:
+		_, ok := engine.(VideoSearcher)
+		return ok
+	case VerticalShopping:
+		_, ok := engine.(ShoppingSearcher)
+		return ok
+	case VerticalLocal:
+		_, ok := engine.(LocalSearcher)
+		return ok
+	case VerticalScholar:
+		_, ok := engine.(ScholarSearcher)
+		return ok
+	case VerticalSuggest:
+		_, ok := engine.(Suggester)
+		return ok
+	}
+	return false
+}
+
+// enginesSupportingVertical filters engines down to those serving v.
+func enginesSupportingVertical(engines []SearchEngine, v Vertical) []SearchEngine {
+	out := make([]SearchEngine, 0, len(engines))
+	for _, engine := range engines {
+		if EngineSupportsVertical(engine, v) {
+			out = append(out, engine)
+		}
+	}
+	return out
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// VideoMeta is the clip metadata a video tab shows next to each result.
+type VideoMeta struct {
+	// Duration is zero when the card showed no length badge.
+	Duration time.Duration
+	// Channel is the uploader or channel name.
+	Channel string
+	// Platform is the hosting site ("youtube", "vimeo"). Empty means derive it
+	// from the result URL.
+	Platform string
+	// PublishedAt is zero when the engine showed no parseable upload date.
+	PublishedAt time.Time
+	// Thumbnail is the preview image URL.
+	Thumbnail string
+}
+
+var (
+	// clockDurationPattern matches "4:05", "12:34" and "1:02:03" length badges.
+	clockDurationPattern = regexp.MustCompile(`^(?:(\d{1,2}):)?(\d{1,2}):(\d{2})$`)
+	// isoDurationPattern matches ISO 8601 durations ("PT1H2M3S") used in
+	// schema.org VideoObject markup.
+	isoDurationPattern = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+	youtubeIDPattern   = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+)
+
+// ParseVideoDuration parses a video length badge ("12:34", "1:02:03") or an
+// ISO 8601 duration ("PT12M34S"). It returns false for anything else,
+// including the "LIVE" badges shown on streams.
+func ParseVideoDuration(raw string) (time.Duration, bool) {
+	text := strings.TrimSpace(raw)
+	if m := clockDurationPattern.FindStringSubmatch(text); m != nil {
+		d := time.Duration(atoi(m[1]))*time.Hour +
+			time.Duration(atoi(m[2]))*time.Minute +
+			time.Duration(atoi(m[3]))*time.Second
+		return d, d > 0
+	}
+	if m := isoDurationPattern.FindStringSubmatch(strings.ToUpper(text)); m != nil && text != "PT" {
+		d := time.Duration(atoi(m[1]))*time.Hour +
+			time.Duration(atoi(m[2]))*time.Minute +
+			time.Duration(atoi(m[3]))*time.Second
+		return d, d > 0
+	}
+	return 0, false
+}
+
+// FormatVideoDuration renders d as a length badge: "4:05" or "1:02:03".
+func FormatVideoDuration(d time.Duration) string {
+	total := int(d / time.Second)
+	hours, minutes, seconds := total/3600, total%3600/60, total%60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, seconds)
+}
+
+// videoPlatforms maps a registrable domain to its platform name.
+var videoPlatforms = map[string]string{
+	"youtube.com":     "youtube",
+	"youtu.be":        "youtube",
+	"vimeo.com":       "vimeo",
+	"dailymotion.com": "dailymotion",
+	"dai.ly":          "dailymotion",
+	"rutube.ru":       "rutube",
+	"vk.com":          "vk",
+	"vkvideo.ru":      "vk",
+	"dzen.ru":         "dzen",
+	"tiktok.com":      "tiktok",
+	"twitch.tv":       "twitch",
+	"bilibili.com":    "bilibili",
+	"facebook.com":    "facebook",
+	"instagram.com":   "instagram",
+	"x.com":           "x",
+	"twitter.com":     "x",
+}
+
+// VideoPlatform names the hosting platform of a video URL, or "" when the
+// host is not a known video site.
+func VideoPlatform(rawURL string) string {
+	domain := extractDomain(rawURL)
+	if platform, ok := videoPlatforms[domain]; ok {
+		return platform
+	}
+	for host, platform := range videoPlatforms {
+		if strings.HasSuffix(domain, "."+host) {
+			return platform
+		}
+	}
+	return ""
+}
+
+// CanonicalVideoKey returns a key identifying the underlying clip, so one
+// video reached through different watch URLs (youtu.be short links, /shorts/,
+// embeds, extra tracking params) dedupes across engines. YouTube and Vimeo
+// URLs key on their video ID; anything else keys on the normalized URL.
+func CanonicalVideoKey(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return NormalizeURLForClustering(rawURL)
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch VideoPlatform(rawURL) {
+	case "youtube":
+		id := ""
+		switch {
+		case extractDomain(rawURL) == "youtu.be":
+			id = segments[0]
+		case u.Query().Get("v") != "":
+			id = u.Query().Get("v")
+		case len(segments) >= 2 && (segments[0] == "shorts" || segments[0] == "embed" || segments[0] == "live" || segments[0] == "v"):
+			id = segments[1]
+		}
+		if youtubeIDPattern.MatchString(id) {
+			return "youtube:" + id
+		}
+	case "vimeo":
+		for i := len(segments) - 1; i >= 0; i-- {
+			if isDigits(segments[i]) {
+				return "vimeo:" + segments[i]
+			}
+		}
+	}
+	return NormalizeURLForClustering(rawURL)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
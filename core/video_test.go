@@ -0,0 +1,74 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseVideoDuration(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want time.Duration
+		ok   bool
+	}{
+		{"4:05", 4*time.Minute + 5*time.Second, true},
+		{" 12:34 ", 12*time.Minute + 34*time.Second, true},
+		{"1:02:03", time.Hour + 2*time.Minute + 3*time.Second, true},
+		{"PT1H2M3S", time.Hour + 2*time.Minute + 3*time.Second, true},
+		{"PT45S", 45 * time.Second, true},
+		{"PT", 0, false},
+		{"LIVE", 0, false},
+		{"0:00", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseVideoDuration(tt.raw)
+		if ok != tt.ok || got != tt.want {
+			t.Fatalf("ParseVideoDuration(%q) = %s, %v; want %s, %v", tt.raw, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFormatVideoDuration(t *testing.T) {
+	if got := FormatVideoDuration(4*time.Minute + 5*time.Second); got != "4:05" {
+		t.Fatalf("unexpected short duration %q", got)
+	}
+	if got := FormatVideoDuration(time.Hour + 2*time.Minute + 3*time.Second); got != "1:02:03" {
+		t.Fatalf("unexpected long duration %q", got)
+	}
+}
+
+func TestCanonicalVideoKey(t *testing.T) {
+	const id = "dQw4w9WgXcQ"
+	for _, raw := range []string{
+		"https://www.youtube.com/watch?v=" + id,
+		"https://m.youtube.com/watch?v=" + id + "&t=42s",
+		"https://youtu.be/" + id,
+		"https://www.youtube.com/shorts/" + id,
+		"https://www.youtube.com/embed/" + id,
+	} {
+		if got := CanonicalVideoKey(raw); got != "youtube:"+id {
+			t.Fatalf("CanonicalVideoKey(%q) = %q", raw, got)
+		}
+	}
+	if got := CanonicalVideoKey("https://vimeo.com/channels/staffpicks/123456789"); got != "vimeo:123456789" {
+		t.Fatalf("unexpected vimeo key %q", got)
+	}
+	if got := CanonicalVideoKey("https://rutube.ru/video/abc/?utm_source=x"); got != NormalizeURLForClustering("https://rutube.ru/video/abc/") {
+		t.Fatalf("expected other hosts to key on the normalized URL, got %q", got)
+	}
+}
+
+func TestVideoPlatform(t *testing.T) {
+	tests := map[string]string{
+		"https://www.youtube.com/watch?v=x": "youtube",
+		"https://music.youtube.com/watch":   "youtube",
+		"https://vk.com/video-1_2":          "vk",
+		"https://example.com/clip.mp4":      "",
+	}
+	for raw, want := range tests {
+		if got := VideoPlatform(raw); got != want {
+			t.Fatalf("VideoPlatform(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
@@ -23,7 +23,7 @@ func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
 	if pageStatus != nil {
 		return nil, pageStatus
 	}
-	return parseDDGDocument(doc), nil
+	return core.AttachSerpMetaToFirstResult(parseDDGDocument(doc), parseDDGSerpMeta(doc)), nil
 }
 
 func classifyDDGDocument(doc *goquery.Document) error {
@@ -58,14 +58,18 @@ func parseDDGDocument(doc *goquery.Document) []core.SearchResult {
 		isAd := ddgSelectionHasAdMarker(item)
 
 		resultRank, absoluteRank := rank.Next(isAd)
-		results = append(results, core.SearchResult{
+		result := core.SearchResult{
 			Rank:         resultRank,
 			AbsoluteRank: absoluteRank,
 			URL:          href,
 			Title:        title,
 			Description:  desc,
 			Ad:           isAd,
-		})
+		}
+		if !isAd {
+			core.ApplyResultExtras(&result, item, Selectors.Extras, nil)
+		}
+		results = append(results, result)
 	})
 
 	return core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractDDGFeatures(doc))
@@ -129,3 +129,29 @@ func TestParseDDGHTMLAdsDoNotConsumeOrganicRank(t *testing.T) {
 		t.Fatalf("unexpected absolute ranks: %d, %d, %d", results[0].AbsoluteRank, results[1].AbsoluteRank, results[2].AbsoluteRank)
 	}
 }
+
+func TestParseDDGHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div id="did_you_mean">Including results for <a href="/?q=python+tutorial"><b>python</b> tutorial</a>. Search only for <a href="/?q=pyhton+tutorial">pyhton tutorial</a>?</div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.CorrectedQuery != "python tutorial" {
+		t.Errorf("CorrectedQuery = %q, want python tutorial", meta.CorrectedQuery)
+	}
+	if meta.EffectiveQuery != "python tutorial" {
+		t.Errorf("EffectiveQuery = %q, want the corrected query", meta.EffectiveQuery)
+	}
+}
@@ -125,6 +125,10 @@ func (ddg *DuckDuckGo) parseResults(results rod.Elements, pageNum int) []core.Se
 			Description:  desc,
 			Ad:           isAd,
 		}
+		if !isAd {
+			core.ApplyElementExtras(&result, r, Selectors.Extras, nil)
+		}
+		result.Layout = core.ElementLayout(r)
 		searchResults = append(searchResults, result)
 	}
 
@@ -158,6 +162,8 @@ func (ddg *DuckDuckGo) Search(ctx context.Context, query core.Query) (results []
 
 	allResults := []core.SearchResult{}
 	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
 	searchPage := 0
 
 	// fetchPage loads one SERP page and appends parsed results.
@@ -182,19 +188,29 @@ func (ddg *DuckDuckGo) Search(ctx context.Context, query core.Query) (results []
 			}
 			if ddg.isCaptcha(page) {
 				ddg.logger.Error("Captcha detected: %s", url)
+				core.ArchivePage(ctx, page)
 				return false, core.ErrCaptcha
 			}
 			ddg.logger.Error("Cannot parse search results: %s", err)
+			core.ArchivePage(ctx, page)
 			return false, core.ErrSearchTimeout
 		}
 		ddg.logger.Debug("Found results with selector: %s", selector)
 
+		core.ArchivePage(ctx, page)
+		if query.Layout && searchPage == 0 {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
 		r := ddg.parseResults(elements, searchPage)
 		if len(r) == 0 {
 			ddg.logger.Debug("No valid results found on page %d", searchPage)
 			return false, core.ErrSearchTimeout
 		}
 
+		if searchPage == 0 {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = core.SerpMetaFromPage(page, parseDDGSerpMeta)
+		}
 		if query.Features && searchPage == 0 {
 			pageFeatures = extractDDGFeaturesFromPage(page)
 		}
@@ -223,7 +239,8 @@ func (ddg *DuckDuckGo) Search(ctx context.Context, query core.Query) (results []
 	deduped = core.LimitOrganicResults(deduped, query.Limit)
 
 	ddg.logger.Info("Search completed: %d results", len(deduped))
-	return core.AttachFeaturesToFirstResult(deduped, pageFeatures), nil
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
 }
 
 // SearchImage executes a DuckDuckGo image search and returns normalized image
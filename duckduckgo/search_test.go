@@ -321,3 +321,32 @@ func TestShouldFetchDuckDuckGoPage(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildURLSafeSearch(t *testing.T) {
+	tests := []struct {
+		level core.SafeSearch
+		want  string
+	}{
+		{"", ""},
+		{core.SafeSearchOff, "-2"},
+		{core.SafeSearchModerate, "-1"},
+		{core.SafeSearchStrict, "1"},
+	}
+	for _, tt := range tests {
+		q := core.Query{Text: "golang", SafeSearch: tt.level}
+		web, err := BuildURL(q, 0)
+		if err != nil {
+			t.Fatalf("BuildURL() error = %v", err)
+		}
+		images, err := BuildImageURL(q)
+		if err != nil {
+			t.Fatalf("BuildImageURL() error = %v", err)
+		}
+		for _, got := range []string{web, images} {
+			parsed, _ := url.Parse(got)
+			if kp := parsed.Query().Get("kp"); kp != tt.want {
+				t.Fatalf("kp=%q for level %q, want %q", kp, tt.level, tt.want)
+			}
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package duckduckgo
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for DuckDuckGo SERP CSS selectors.
 //
 // Order matters: WaitForElements / parseResults try selectors in declared order
@@ -18,6 +20,11 @@ var Selectors = struct {
 	ImageImg         []string
 	ImageTitle       []string
 	ImageLink        []string
+	SpellCorrected   string
+	SearchBox        string
+
+	// Sitelinks, breadcrumbs and the snippet date of organic results.
+	Extras core.ResultExtrasSelectors
 }{
 	NoResults: []string{
 		"div[class*='no-results']",
@@ -102,4 +109,17 @@ var Selectors = struct {
 		"figcaption a",
 		"a",
 	},
+	// SpellCorrected is the first link of the "Including results for …"
+	// notice: DuckDuckGo already ran the corrected query.
+	SpellCorrected: "#did_you_mean a, [data-testid='spelling-message'] a",
+	SearchBox:      "input[name='q']",
+	// Extras: the URL line is the site name followed by "https://host ›
+	// Language › Classes", sitelinks are li#sl-N links, and dated snippets
+	// open with a span holding "Aug 20, 2025". Classes are generated, so
+	// only structure and ids are used.
+	Extras: core.ResultExtrasSelectors{
+		Sitelink:   "li[id^='sl-'] a",
+		Breadcrumb: "a[data-testid='result-extras-url-link'] p:last-of-type",
+		Date:       "div[data-result='snippet'] > div > span > span:first-child",
+	},
 }
@@ -3,6 +3,8 @@ package duckduckgo
 import (
 	"bytes"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/karust/openserp/core"
@@ -101,3 +103,68 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "fixture",
+			html:            string(fixture),
+			wantBreadcrumbs: []string{"http://test.test", "en-US", "docs", "Web", "API", "Fetch_API", "Using_Fetch"},
+			wantRich:        &core.RichSnippet{Date: "Aug 20, 2025", PublishedAt: "2025-08-20T00:00:00Z"},
+		},
+		{
+			name: "sitelinks without a date",
+			html: `
+<article data-testid="result">
+  <a data-testid="result-extras-url-link" href="https://go.dev/"><p>go.dev</p><p><span>https://go.dev</span><span>› doc</span></p></a>
+  <h2><a data-testid="result-title-a" href="https://go.dev/doc/">Documentation</a></h2>
+  <div data-result="snippet"><div><span><span>Learn Go with tutorials and references.</span></span></div></div>
+  <ul><li id="sl-0"><a href="https://go.dev/doc/tutorial/">Tutorials</a></li><li id="sl-1"><a href="https://go.dev/ref/spec">Spec</a></li></ul>
+</article>`,
+			wantSitelinks: []core.Sitelink{
+				{Title: "Tutorials", URL: "https://go.dev/doc/tutorial/"},
+				{Title: "Spec", URL: "https://go.dev/ref/spec"},
+			},
+			wantBreadcrumbs: []string{"https://go.dev", "doc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if !results[i].Ad {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected an organic result, got %+v", results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package duckduckgo
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseDDGSerpMeta reads the spelling correction and locale. DuckDuckGo
+// prints neither a result estimate nor a search time.
+func parseDDGSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+	}
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
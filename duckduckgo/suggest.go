@@ -0,0 +1,37 @@
+package duckduckgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches DuckDuckGo's query completions for query.Text. It needs no
+// browser, unlike DuckDuckGo search.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "duckduckgo", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("DuckDuckGo suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, parseSuggestions)
+}
+
+// parseSuggestions reads the /ac/ payload: [{"phrase": "..."}, ...].
+func parseSuggestions(body []byte) ([]string, error) {
+	var items []struct {
+		Phrase string `json:"phrase"`
+	}
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, err
+	}
+	texts := make([]string, 0, len(items))
+	for _, item := range items {
+		texts = append(texts, item.Phrase)
+	}
+	return texts, nil
+}
@@ -0,0 +1,37 @@
+package duckduckgo
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseSuggestions(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/suggest.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	texts, err := parseSuggestions(data)
+	if err != nil {
+		t.Fatalf("parseSuggestions() error = %v", err)
+	}
+	if want := []string{"golang tutorial", "golang generics", "golang download"}; !reflect.DeepEqual(texts, want) {
+		t.Fatalf("unexpected suggestions %v", texts)
+	}
+}
+
+func TestBuildSuggestURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildSuggestURL(core.Query{Text: "golang", LangCode: "de"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	if u != "https://duckduckgo.com/ac/?kl=de-de&q=golang" {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+}
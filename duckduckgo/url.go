@@ -79,6 +79,29 @@ func duckDuckGoKL(langCode, region string) string {
 	return ddgKLByLocale[locale.Language]
 }
 
+// Operators is DuckDuckGo's spelling of the structured query operators. Its
+// syntax has no OR.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	InURL:       "inurl:",
+}
+
+// ddgSafe maps SafeSearch levels to the kp= parameter.
+var ddgSafe = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "-2",
+	core.SafeSearchModerate: "-1",
+	core.SafeSearchStrict:   "1",
+}
+
+// SupportsSafeSearch reports whether DuckDuckGo URLs can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := ddgSafe[level]
+	return ok || level == ""
+}
+
 // BuildURL builds a DuckDuckGo web search URL for the provided query and page
 // index. It returns an error when query text or date parameters are invalid.
 func BuildURL(q core.Query, page int) (string, error) {
@@ -91,7 +114,7 @@ func BuildURL(q core.Query, page int) (string, error) {
 	params := url.Values{}
 
 	// Set request text
-	if q.Text != "" || q.Site != "" || q.Filetype != "" {
+	if !q.IsEmpty() {
 		text := q.Text
 		if q.Site != "" {
 			text += " site:" + q.Site
@@ -99,6 +122,8 @@ func BuildURL(q core.Query, page int) (string, error) {
 		if q.Filetype != "" {
 			text += " filetype:" + q.Filetype
 		}
+		operators, _ := Operators.Compile(q)
+		text += operators
 
 		params.Add("q", text)
 	}
@@ -135,6 +160,9 @@ func BuildURL(q core.Query, page int) (string, error) {
 	if kl := duckDuckGoKL(q.LangCode, q.Region); kl != "" {
 		params.Add("kl", kl)
 	}
+	if kp := ddgSafe[q.SafeSearch]; kp != "" {
+		params.Add("kp", kp)
+	}
 
 	// DuckDuckGo specific parameters
 	params.Add("t", "h")    // HTML format
@@ -208,6 +236,29 @@ func BuildImageURL(q core.Query) (string, error) {
 		params.Add("df", dateRange)
 	}
 
+	if kl := duckDuckGoKL(q.LangCode, q.Region); kl != "" {
+		params.Add("kl", kl)
+	}
+	if kp := ddgSafe[q.SafeSearch]; kp != "" {
+		params.Add("kp", kp)
+	}
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildSuggestURL builds a DuckDuckGo autocomplete URL for q.Text, localized
+// with the same kl value as web search.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	base.Path = "/ac/"
+	params := url.Values{}
+	params.Add("q", q.Text)
 	if kl := duckDuckGoKL(q.LangCode, q.Region); kl != "" {
 		params.Add("kl", kl)
 	}
@@ -6,27 +6,37 @@ import (
 	"github.com/karust/openserp/core"
 )
 
+// ecosiaFeatureSelectors are the SERP modules extractEcosiaFeatures reads; their
+// containers are also measured for layout=true.
+var ecosiaFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeAnswerBox,
+		Title:         "Answer",
+		Container:     []string{"[data-test-id='instant-answer']", "[data-test-id='answer-box']", ".instant-answer"},
+		TitleSelector: []string{"h2", "[data-test-id='instant-answer-title']"},
+		TextSelector:  []string{"[data-test-id='instant-answer-description']", "[data-test-id='answer-box-description']", ".instant-answer__description", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.8,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"[data-test-id='web-related-queries']", ".related-queries__bottom", "[data-test-id='related-searches']"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.8,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on an Ecosia SERP: the
+// result and ad cards and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Result, Selectors.Ad}, ecosiaFeatureSelectors)
+}
+
 func extractEcosiaFeatures(doc *goquery.Document) []core.SerpFeature {
-	return core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{
-		{
-			Type:          core.ResultTypeAnswerBox,
-			Title:         "Answer",
-			Container:     []string{"[data-test-id='instant-answer']", "[data-test-id='answer-box']", ".instant-answer"},
-			TitleSelector: []string{"h2", "[data-test-id='instant-answer-title']"},
-			TextSelector:  []string{"[data-test-id='instant-answer-description']", "[data-test-id='answer-box-description']", ".instant-answer__description", "p"},
-			LinkSelector:  []string{"a[href^='http']"},
-			Position:      1,
-			Confidence:    0.8,
-		},
-		{
-			Type:         core.ResultTypeRelatedSearches,
-			Title:        "Related searches",
-			Container:    []string{"[data-test-id='web-related-queries']", ".related-queries__bottom", "[data-test-id='related-searches']"},
-			ItemSelector: []string{"a"},
-			LinkSelector: []string{"a[href^='http']", "a"},
-			Confidence:   0.8,
-		},
-	})
+	return core.ExtractSerpFeaturesBySelectors(doc, ecosiaFeatureSelectors)
 }
 
 func extractEcosiaFeaturesFromPage(page *rod.Page) []core.SerpFeature {
@@ -24,7 +24,7 @@ func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
 	if pageStatus != nil {
 		return nil, pageStatus
 	}
-	return parseEcosiaDocument(doc), nil
+	return core.AttachSerpMetaToFirstResult(parseEcosiaDocument(doc), parseEcosiaSerpMeta(doc)), nil
 }
 
 func classifyEcosiaDocument(doc *goquery.Document) error {
@@ -77,7 +77,11 @@ func parseEcosiaSelectionRow(item *goquery.Selection, rank int, ad bool) (core.S
 		title = selectionText(item, "h2, h3")
 	}
 	desc := selectionText(item, Selectors.Desc)
-	return assembleEcosiaRow(href, title, desc, rank, ad)
+	res, ok := assembleEcosiaRow(href, title, desc, rank, ad)
+	if ok && !ad {
+		core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+	}
+	return res, ok
 }
 
 // assembleEcosiaRow validates an already-extracted web row and builds the
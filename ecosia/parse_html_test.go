@@ -81,3 +81,29 @@ func TestParseEcosiaHTMLAds(t *testing.T) {
 		}
 	}
 }
+
+func TestParseEcosiaHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div data-test-id="spelling-correction">Showing results for <a href="/search?q=python+tutorial">python tutorial</a></div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.CorrectedQuery != "python tutorial" {
+		t.Errorf("CorrectedQuery = %q, want python tutorial", meta.CorrectedQuery)
+	}
+	if meta.EffectiveQuery != "python tutorial" {
+		t.Errorf("EffectiveQuery = %q, want the corrected query", meta.EffectiveQuery)
+	}
+}
@@ -99,7 +99,14 @@ func (e *Ecosia) parseResult(elem *rod.Element, rank int, ad bool) (core.SearchR
 
 	title := core.FirstNonEmptyText(elem, Selectors.Title, "h2, h3")
 	desc := core.FirstNonEmptyText(elem, Selectors.Desc)
-	return assembleEcosiaRow(href.String(), title, desc, rank, ad)
+	res, ok := assembleEcosiaRow(href.String(), title, desc, rank, ad)
+	if ok && !ad {
+		core.ApplyElementExtras(&res, elem, Selectors.Extras, nil)
+	}
+	if ok {
+		res.Layout = core.ElementLayout(elem)
+	}
+	return res, ok
 }
 
 // Search executes an Ecosia web search and returns normalized search results.
@@ -115,6 +122,8 @@ func (e *Ecosia) Search(ctx context.Context, query core.Query) (results []core.S
 	// up within sponsored results so ad rank stays separate from SEO rank.
 	all := []core.SearchResult{}
 	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
 	pageNum, nextRank, err := startPage(query.Start)
 	if err != nil {
 		return nil, err
@@ -137,12 +146,14 @@ func (e *Ecosia) Search(ctx context.Context, query core.Query) (results []core.S
 
 		if err := page.WaitLoad(); err != nil {
 			e.logger.Error("Page load wait failed: %s", err)
+			core.ArchivePage(ctx, page)
 			return false, core.ErrSearchTimeout
 		}
 
 		if _, err := page.Timeout(e.GetSelectorTimeout()).Element(Selectors.Mainline); err != nil {
 			if e.isCaptcha(page) {
 				e.logger.Error("Captcha detected: %s", u)
+				core.ArchivePage(ctx, page)
 				return false, core.ErrCaptcha
 			}
 			e.logger.Warn("Mainline not found on page %d", pageNum)
@@ -158,6 +169,10 @@ func (e *Ecosia) Search(ctx context.Context, query core.Query) (results []core.S
 			return true, nil
 		}
 
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
 		for _, r := range organic {
 			if res, ok := e.parseResult(r, nextRank, false); ok {
 				all = append(all, res)
@@ -170,6 +185,10 @@ func (e *Ecosia) Search(ctx context.Context, query core.Query) (results []core.S
 				nextAdRank++
 			}
 		}
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = core.SerpMetaFromPage(page, parseEcosiaSerpMeta)
+		}
 		if query.Features && pageNum == firstPage {
 			pageFeatures = extractEcosiaFeaturesFromPage(page)
 		}
@@ -196,7 +215,8 @@ func (e *Ecosia) Search(ctx context.Context, query core.Query) (results []core.S
 		deduped = core.LimitOrganicResults(deduped, query.Limit)
 	}
 	e.logger.Info("Search completed: %d results", len(deduped))
-	return core.AttachFeaturesToFirstResult(deduped, pageFeatures), nil
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
 }
 
 // parseImageResult extracts a single image card into a SearchResult,
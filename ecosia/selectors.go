@@ -1,19 +1,26 @@
 package ecosia
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for Ecosia SERP CSS selectors.
 var Selectors = struct {
-	Captcha     string
-	NoResults   string
-	Mainline    string
-	Result      string
-	Ad          string
-	ResultLink  string
-	Title       string
-	Desc        string
-	ImageResult string
-	ImageLink   string
-	ImageSource string
-	ImageDims   string
+	Captcha        string
+	NoResults      string
+	Mainline       string
+	Result         string
+	Ad             string
+	ResultLink     string
+	Title          string
+	Desc           string
+	ImageResult    string
+	ImageLink      string
+	ImageSource    string
+	ImageDims      string
+	SpellCorrected string
+	SearchBox      string
+
+	// Breadcrumbs and the snippet date of organic results.
+	Extras core.ResultExtrasSelectors
 }{
 	// Captcha matches Ecosia's Cloudflare Turnstile interstitial. The hidden
 	// cf-turnstile-response input is present on every challenge page and never
@@ -30,4 +37,14 @@ var Selectors = struct {
 	ImageLink:   "[data-test-id='image-result-link']",
 	ImageSource: "[data-test-id='image-result-source']",
 	ImageDims:   "[data-test-id='image-result-dimensions']",
+	// SpellCorrected is the "Showing results for …" link: Ecosia already ran
+	// the corrected query.
+	SpellCorrected: "[data-test-id='spelling-correction'] a",
+	SearchBox:      "[data-test-id='search-form-input']",
+	// Extras: result-source holds the domain followed by a "› r › LocalLLaMA
+	// › comments" breadcrumbs span. Dated descriptions open with
+	// "02.08.2025 ...", which the snippet date fallback reads.
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "[data-test-id='result-source']",
+	},
 }
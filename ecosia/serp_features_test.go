@@ -91,3 +91,27 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("expected fixture results")
+	}
+	first := results[0]
+	if len(first.Breadcrumbs) < 3 || first.Breadcrumbs[2] != "LocalLLaMA" {
+		t.Fatalf("unexpected breadcrumbs: %q", first.Breadcrumbs)
+	}
+	if first.RichSnippet == nil || first.RichSnippet.Date != "02.08.2025" || first.RichSnippet.PublishedAt != "2025-08-02T00:00:00Z" {
+		t.Fatalf("expected the leading description date, got %+v", first.RichSnippet)
+	}
+}
@@ -0,0 +1,17 @@
+package ecosia
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseEcosiaSerpMeta reads the spelling correction and locale. Ecosia
+// prints neither a result estimate nor a search time.
+func parseEcosiaSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+	}
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/karust/openserp/core"
 )
@@ -15,41 +14,38 @@ const (
 	imagesURL = "https://www.ecosia.org/images"
 )
 
-// ecosiaFreshness maps a YYYYMMDD..YYYYMMDD DateInterval to Ecosia's freshness
-// bucket: <= 1d ->  day, <= 7d -> week, <= 31d -> month. Longer spans are
-// dropped (returns "", nil) since Ecosia exposes no finer control. Malformed
-// input is rejected so non-spec values do not silently lose the filter.
+// ecosiaFreshnessValues is Ecosia's freshness parameter per span. Ecosia has
+// no yearly bucket, so longer intervals drop the filter.
+var ecosiaFreshnessValues = map[core.DateSpan]string{
+	core.DateSpanDay:   "day",
+	core.DateSpanWeek:  "week",
+	core.DateSpanMonth: "month",
+}
+
+// ecosiaFreshness maps a DateInterval to Ecosia's freshness value.
 func ecosiaFreshness(dateInterval string) (string, error) {
-	s := strings.TrimSpace(dateInterval)
-	if s == "" {
-		return "", nil
-	}
-	parts := strings.Split(s, "..")
-	if len(parts) != 2 {
-		return "", errors.New("incorrect date interval provided, expected YYYYMMDD..YYYYMMDD")
-	}
-	start, err := time.Parse("20060102", parts[0])
+	span, err := core.DateIntervalSpan(dateInterval)
 	if err != nil {
-		return "", errors.New("invalid start date format, expected YYYYMMDD")
-	}
-	end, err := time.Parse("20060102", parts[1])
-	if err != nil {
-		return "", errors.New("invalid end date format, expected YYYYMMDD")
-	}
-	span := end.Sub(start)
-	if span < 0 {
-		return "", errors.New("date interval end is before start")
-	}
-	switch {
-	case span <= 24*time.Hour:
-		return "day", nil
-	case span <= 7*24*time.Hour:
-		return "week", nil
-	case span <= 31*24*time.Hour:
-		return "month", nil
-	default:
-		return "", nil
+		return "", err
 	}
+	return ecosiaFreshnessValues[span], nil
+}
+
+// Operators is Ecosia's spelling of the structured query operators. Ecosia
+// passes them to its Bing backend, which has no inurl:.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	Or:          " OR ",
+}
+
+// SupportsSafeSearch reports whether Ecosia can honour level. Like the
+// region, Ecosia keeps its safe search level in the ECFG cookie rather than
+// the URL, so only its moderate default is honoured.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	return level == "" || level == core.SafeSearchModerate
 }
 
 // BuildURL builds an Ecosia web search URL for the supplied query and
@@ -71,6 +67,8 @@ func BuildURL(q core.Query, page int) (string, error) {
 	if q.Filetype != "" {
 		text += " filetype:" + q.Filetype
 	}
+	operators, _ := Operators.Compile(q)
+	text += operators
 	if strings.TrimSpace(text) == "" {
 		return "", errors.New("empty query built")
 	}
@@ -131,6 +131,9 @@ func buildResult(req ExtractRequest, resp *FetchResponse, mode string, startedAt
 	if req.MaxBytes > 0 && len(body) > req.MaxBytes {
 		body = body[:req.MaxBytes]
 	}
+	if isPDF(body) {
+		return buildPDFResult(req, body, mode, startedAt)
+	}
 	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
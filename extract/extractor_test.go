@@ -1,7 +1,10 @@
 package extract
 
 import (
+	"bytes"
+	"compress/zlib"
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -308,3 +311,39 @@ func TestParseMetadataRichTags(t *testing.T) {
 func documentFromString(raw string) (*goquery.Document, error) {
 	return goquery.NewDocumentFromReader(strings.NewReader(raw))
 }
+
+// buildTestPDF assembles a minimal PDF whose page content stream is
+// Flate-compressed, the way most generators write it.
+func buildTestPDF(t *testing.T, content string) []byte {
+	t.Helper()
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.5\n")
+	pdf.WriteString("1 0 obj\n<< /Title (Attention Is All You Need) /Producer (pdfTeX) >>\nendobj\n")
+	fmt.Fprintf(&pdf, "2 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", compressed.Len())
+	pdf.Write(compressed.Bytes())
+	pdf.WriteString("\nendstream\nendobj\n%%EOF\n")
+	return pdf.Bytes()
+}
+
+func TestExtractPDFText(t *testing.T) {
+	content := `BT /F1 12 Tf 72 712 Td (Abstract) Tj 0 -14 Td
+[(The domi)-20(nant)-333(sequence)-333(transduction)-333(models)] TJ
+0 -14 Td (are based on \(complex\) recurrent networks.) Tj ET`
+	pdf := buildTestPDF(t, content)
+	result := runExtract(t, staticRaw(string(pdf)), nil, ExtractRequest{URL: "https://arxiv.org/pdf/1706.03762", Mode: ModeFast})
+	if result.Title != "Attention Is All You Need" {
+		t.Fatalf("title = %q", result.Title)
+	}
+	want := "Abstract\nThe dominant sequence transduction models\nare based on (complex) recurrent networks."
+	if result.Text != want || result.Markdown != want {
+		t.Fatalf("text = %q, want %q", result.Text, want)
+	}
+}
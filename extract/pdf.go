@@ -0,0 +1,318 @@
+package extract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+)
+
+// pdfMagic opens every PDF file. Scholar results often link the paper's PDF
+// directly, so the extractor reads PDF text as well as HTML.
+var pdfMagic = []byte("%PDF-")
+
+var (
+	pdfStreamPattern = regexp.MustCompile(`stream\r?\n`)
+	pdfTitlePattern  = regexp.MustCompile(`/Title\s*\(`)
+)
+
+// isPDF reports whether body is a PDF document rather than a web page.
+func isPDF(body []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(body, " \t\r\n"), pdfMagic)
+}
+
+// buildPDFResult is buildResult for PDF bodies. Markdown and Text carry the
+// same plain text, since a PDF has no markup to convert.
+func buildPDFResult(req ExtractRequest, body []byte, mode string, startedAt time.Time) (*ExtractResult, error) {
+	text := extractPDFText(body)
+	if text == "" {
+		return nil, errors.New("no extractable text in pdf")
+	}
+	title := pdfTitle(body)
+	if title == "" {
+		title, _, _ = strings.Cut(text, "\n")
+	}
+	return &ExtractResult{
+		URL:      req.URL,
+		Title:    strings.TrimSpace(title),
+		Markdown: text,
+		Text:     text,
+		Meta: ExtractMeta{
+			ModeUsed:  mode,
+			FetchedAt: time.Now().UTC().Format(time.RFC3339),
+			Bytes:     len(body),
+			TookMs:    time.Since(startedAt).Milliseconds(),
+		},
+	}, nil
+}
+
+// extractPDFText returns the text drawn by the content streams of a PDF. It
+// reads plain and Flate-compressed streams and decodes the Tj, TJ, ' and "
+// operators. Glyphs in fonts with custom encodings (CID fonts) do not map to
+// characters and are dropped. A body cut short by the byte budget yields the
+// text of the pages that arrived.
+func extractPDFText(body []byte) string {
+	var lines []string
+	for _, loc := range pdfStreamPattern.FindAllIndex(body, -1) {
+		if bytes.HasSuffix(body[:loc[0]], []byte("end")) {
+			continue
+		}
+		dictStart := bytes.LastIndex(body[:loc[0]], []byte("obj"))
+		if dictStart < 0 {
+			continue
+		}
+		dict := body[dictStart:loc[0]]
+		if bytes.Contains(dict, []byte("/Image")) || bytes.Contains(dict, []byte("/XRef")) ||
+			bytes.Contains(dict, []byte("/DCTDecode")) || bytes.Contains(dict, []byte("/FontFile")) {
+			continue
+		}
+		end := bytes.Index(body[loc[1]:], []byte("endstream"))
+		if end < 0 {
+			end = len(body) - loc[1]
+		}
+		data := body[loc[1] : loc[1]+end]
+		if bytes.Contains(dict, []byte("/FlateDecode")) {
+			reader, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				continue
+			}
+			// A truncated stream still yields the bytes decoded before the cut.
+			data, _ = io.ReadAll(reader)
+		} else if bytes.Contains(dict, []byte("/Filter")) {
+			continue
+		}
+		lines = append(lines, pdfContentText(data)...)
+	}
+	return normalizeMarkdown(strings.Join(lines, "\n"))
+}
+
+// pdfContentText interprets one content stream and returns its text lines.
+// Horizontal moves join text on one line; vertical moves start a new one.
+func pdfContentText(data []byte) []string {
+	var (
+		lines    []string
+		line     strings.Builder
+		strs     []string
+		operands []float64
+		inArray  bool
+	)
+	flush := func() {
+		if text := strings.Join(strings.Fields(line.String()), " "); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+	}
+	show := func(text string) {
+		line.WriteString(text)
+	}
+
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case c == '%':
+			for i < len(data) && data[i] != '\n' && data[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			text, next := pdfLiteralString(data, i)
+			i = next
+			if inArray {
+				strs = append(strs, text)
+			} else {
+				strs = []string{text}
+			}
+		case c == '<' && i+1 < len(data) && data[i+1] != '<':
+			end := bytes.IndexByte(data[i:], '>')
+			if end < 0 {
+				return append(lines, line.String())
+			}
+			text := pdfHexString(data[i+1 : i+end])
+			i += end + 1
+			if inArray {
+				strs = append(strs, text)
+			} else {
+				strs = []string{text}
+			}
+		case c == '[':
+			inArray = true
+			strs = nil
+			i++
+		case c == ']':
+			inArray = false
+			i++
+		case isPDFDelimiter(c) || unicode.IsSpace(rune(c)):
+			i++
+		case inArray && (c == '-' || c == '.' || (c >= '0' && c <= '9')):
+			// A large negative kerning inside TJ is an inter-word gap.
+			start := i
+			for i < len(data) && (data[i] == '-' || data[i] == '.' || (data[i] >= '0' && data[i] <= '9')) {
+				i++
+			}
+			if kern, err := strconv.ParseFloat(string(data[start:i]), 64); err == nil && kern < -200 {
+				strs = append(strs, " ")
+			}
+		default:
+			start := i
+			for i < len(data) && !isPDFDelimiter(data[i]) && !unicode.IsSpace(rune(data[i])) {
+				i++
+			}
+			token := string(data[start:i])
+			if number, err := strconv.ParseFloat(token, 64); err == nil {
+				operands = append(operands, number)
+				continue
+			}
+			switch token {
+			case "Tj", "TJ":
+				show(strings.Join(strs, ""))
+			case "'", "\"":
+				flush()
+				show(strings.Join(strs, ""))
+			case "Td", "TD":
+				if len(operands) >= 2 && operands[len(operands)-1] != 0 {
+					flush()
+				} else {
+					show(" ")
+				}
+			case "T*", "ET", "Tm":
+				flush()
+			case "ID":
+				// Skip inline image data up to its EI marker.
+				if end := bytes.Index(data[i:], []byte("EI")); end >= 0 {
+					i += end + 2
+				} else {
+					i = len(data)
+				}
+			}
+			strs = nil
+			operands = operands[:0]
+		}
+	}
+	flush()
+	return lines
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// pdfLiteralString decodes the (...) string starting at data[start] and
+// returns it with the index just past the closing parenthesis.
+func pdfLiteralString(data []byte, start int) (string, int) {
+	var out []byte
+	depth := 0
+	i := start
+	for ; i < len(data); i++ {
+		c := data[i]
+		switch c {
+		case '(':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return pdfDecodeText(out), i + 1
+			}
+		case '\\':
+			i++
+			if i >= len(data) {
+				break
+			}
+			switch esc := data[i]; esc {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// Line continuation.
+			default:
+				if esc >= '0' && esc <= '7' {
+					end := i
+					for end < len(data) && end < i+3 && data[end] >= '0' && data[end] <= '7' {
+						end++
+					}
+					value, _ := strconv.ParseUint(string(data[i:end]), 8, 8)
+					out = append(out, byte(value))
+					i = end - 1
+					continue
+				}
+				out = append(out, esc)
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return pdfDecodeText(out), i
+}
+
+// pdfHexString decodes a <...> string.
+func pdfHexString(hex []byte) string {
+	digits := make([]byte, 0, len(hex))
+	for _, c := range hex {
+		if !unicode.IsSpace(rune(c)) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		value, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			return ""
+		}
+		out = append(out, byte(value))
+	}
+	return pdfDecodeText(out)
+}
+
+// pdfDecodeText reads UTF-16BE strings (marked by a byte order mark) and
+// treats everything else as Latin-1. Strings that are mostly control bytes
+// are glyph IDs of a custom-encoded font and are dropped.
+func pdfDecodeText(raw []byte) string {
+	if len(raw) >= 2 && raw[0] == 0xfe && raw[1] == 0xff {
+		units := make([]uint16, 0, len(raw)/2)
+		for i := 2; i+1 < len(raw); i += 2 {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	var b strings.Builder
+	control := 0
+	for _, c := range raw {
+		switch {
+		case c == '\t' || c == '\n' || c == '\r':
+			b.WriteByte(' ')
+		case c < 0x20 || c == 0x7f:
+			control++
+		default:
+			b.WriteRune(rune(c))
+		}
+	}
+	if control*2 > len(raw) {
+		return ""
+	}
+	return b.String()
+}
+
+// pdfTitle returns the document Title from the PDF's Info dictionary when it
+// is stored as a literal string.
+func pdfTitle(body []byte) string {
+	loc := pdfTitlePattern.FindIndex(body)
+	if loc == nil {
+		return ""
+	}
+	title, _ := pdfLiteralString(body, loc[1]-1)
+	return strings.TrimSpace(title)
+}
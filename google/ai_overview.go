@@ -0,0 +1,67 @@
+package google
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/karust/openserp/core"
+)
+
+// AI Overview wait bounds. The overview streams in after the organic results
+// are drawn, so the search waits for it, but no longer than aiOverviewWait.
+const (
+	aiOverviewWait = 6 * time.Second
+	aiOverviewPoll = 200 * time.Millisecond
+	// aiOverviewExpandWait bounds how long the collapsed overview takes to
+	// open after its "Show more" toggle is clicked.
+	aiOverviewExpandWait = 1500 * time.Millisecond
+)
+
+// waitAIOverview polls until the AI Overview has finished streaming or is
+// known to be absent, then opens it so the page holds its full text. After
+// aiOverviewWait it gives up, and the status read from the page reports the
+// overview as still loading.
+func (gogl *Google) waitAIOverview(ctx context.Context, page *rod.Page) {
+	sel := Selectors.AIOverview
+	deadline := time.Now().Add(aiOverviewWait)
+	for {
+		if core.HasAnySelector(page, []string{sel.Container}) {
+			if !core.HasAnySelector(page, []string{sel.Loading}) {
+				break
+			}
+		} else if !core.HasAnySelector(page, []string{sel.Pending}) {
+			gogl.logger.Debug("No AI Overview on the page")
+			return
+		}
+		if !time.Now().Before(deadline) {
+			gogl.logger.Debug("AI Overview still loading after %s", aiOverviewWait)
+			return
+		}
+		if err := core.SleepContext(ctx, aiOverviewPoll); err != nil {
+			return
+		}
+	}
+	gogl.expandAIOverview(ctx, page)
+}
+
+// expandAIOverview clicks the overview's "Show more" toggle when it is
+// collapsed and waits for it to open.
+func (gogl *Google) expandAIOverview(ctx context.Context, page *rod.Page) {
+	showMore := Selectors.AIOverview.ShowMore
+	has, toggle, err := page.Has(showMore)
+	if err != nil || !has {
+		return
+	}
+	if err := toggle.Click(proto.InputMouseButtonLeft, 1); err != nil {
+		gogl.logger.Debug("AI Overview show more click failed: %s", err)
+		return
+	}
+	deadline := time.Now().Add(aiOverviewExpandWait)
+	for core.HasAnySelector(page, []string{showMore}) && time.Now().Before(deadline) {
+		if err := core.SleepContext(ctx, aiOverviewPoll); err != nil {
+			return
+		}
+	}
+}
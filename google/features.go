@@ -9,42 +9,96 @@ import (
 	"github.com/karust/openserp/core"
 )
 
+// googleFeatureSelectors are the generic SERP modules extractGoogleFeatures
+// reads; their containers are also measured for layout=true.
+var googleFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		// aimc carries the real AI Overview; mfc can be a placeholder.
+		Type:          core.ResultTypeAISummary,
+		Title:         "AI Overview",
+		Container:     []string{"div[data-mcpr]:has(div[data-subtree='aimc'])", "div[data-container-id='main-col'][data-sfc-root='c']", "div[data-subtree='aifb']", "div[data-mcpr]", "div[aria-label*='AI Overview']", "div[jsname][data-rl]", "div[data-rsoextract]"},
+		TitleSelector: []string{"[role='heading']", "h2", "h3"},
+		TextSelector:  []string{"div[data-subtree='aimc']", "div[data-streaming-container]", "div[data-sncf='1']", "[data-attrid*='description']", "div[data-subtree='aifb']"},
+		LinkSelector:  []string{"div[data-subtree='aimc'] a[href^='http']", "a[href^='http']"},
+		Position:      1,
+		Confidence:    0.75,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypePeopleAlsoAsk,
+		Title:        "People also ask",
+		Container:    []string{"div[data-initq]", "div[jsname='yEVEwb']"},
+		ItemSelector: []string{"div.related-question-pair[data-q]", "div[data-q]"},
+		LinkSelector: []string{"a[href^='http']"},
+		Position:     1,
+		Confidence:   0.8,
+		SingleMatch:  true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"div[jsname='yEVEwb'][role='navigation']", "div[data-abe='1']"},
+		ItemSelector: []string{"a[href*='/search?']"},
+		LinkSelector: []string{"a[href*='/search?']"},
+		Confidence:   0.6,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Google SERP: the
+// result blocks, answer rows and every feature container the extractors read.
+func layoutContainers(mobile bool) []string {
+	results := append(searchResultSelectors(mobile),
+		Selectors.AnswerBox+" "+Selectors.AnswerItem,
+		Selectors.AIOverview.Container,
+		Selectors.ProductCarouselItem,
+		Selectors.LocalResults,
+		Selectors.KnowledgePanel.Container,
+	)
+	return core.LayoutContainers(results, googleFeatureSelectors)
+}
+
 func extractGoogleFeatures(doc *goquery.Document) []core.SerpFeature {
-	features := core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{
-		{
-			// aimc carries the real AI Overview; mfc can be a placeholder.
-			Type:          core.ResultTypeAISummary,
-			Title:         "AI Overview",
-			Container:     []string{"div[data-mcpr]:has(div[data-subtree='aimc'])", "div[data-container-id='main-col'][data-sfc-root='c']", "div[data-subtree='aifb']", "div[data-mcpr]", "div[aria-label*='AI Overview']", "div[jsname][data-rl]", "div[data-rsoextract]"},
-			TitleSelector: []string{"[role='heading']", "h2", "h3"},
-			TextSelector:  []string{"div[data-subtree='aimc']", "div[data-streaming-container]", "div[data-sncf='1']", "[data-attrid*='description']", "div[data-subtree='aifb']"},
-			LinkSelector:  []string{"div[data-subtree='aimc'] a[href^='http']", "a[href^='http']"},
-			Position:      1,
-			Confidence:    0.75,
-			SingleMatch:   true,
-		},
-		{
-			Type:         core.ResultTypePeopleAlsoAsk,
-			Title:        "People also ask",
-			Container:    []string{"div[data-initq]", "div[jsname='yEVEwb']"},
-			ItemSelector: []string{"div.related-question-pair[data-q]", "div[data-q]"},
-			LinkSelector: []string{"a[href^='http']"},
-			Position:     1,
-			Confidence:   0.8,
-			SingleMatch:  true,
-		},
-		{
-			Type:         core.ResultTypeRelatedSearches,
-			Title:        "Related searches",
-			Container:    []string{"div[jsname='yEVEwb'][role='navigation']", "div[data-abe='1']"},
-			ItemSelector: []string{"a[href*='/search?']"},
-			LinkSelector: []string{"a[href*='/search?']"},
-			Confidence:   0.6,
-		},
-	})
+	features := core.ExtractSerpFeaturesBySelectors(doc, googleFeatureSelectors)
+	if overview, _ := extractGoogleAIOverview(doc); overview != nil {
+		features = replaceGoogleAISummary(features, *overview)
+	}
+	features = append(features, extractGoogleProductCarousel(doc)...)
+	features = append(features, extractGoogleLocalPack(doc)...)
+	features = append(features, core.ExtractKnowledgePanel(doc, Selectors.KnowledgePanel, googleResolveHref)...)
 	return filterGooglePlaceholders(features)
 }
 
+// extractGoogleAIOverview reads the AI Overview with its sections and
+// numbered citations. Layouts Selectors.AIOverview does not know fall back to
+// the generic ai_summary selector above, which keeps the whole module text.
+func extractGoogleAIOverview(doc *goquery.Document) (*core.SerpFeature, core.AISummaryStatus) {
+	overview, status := core.ExtractAISummary(doc, Selectors.AIOverview, googleResolveHref)
+	if overview == nil {
+		return nil, status
+	}
+	if isGooglePlaceholder(*overview) {
+		if status == core.AISummaryPresent {
+			status = core.AISummaryAbsent
+		}
+		return nil, status
+	}
+	overview.Title = "AI Overview"
+	overview.Position = &core.Position{Absolute: 1}
+	return overview, status
+}
+
+// replaceGoogleAISummary swaps the generic ai_summary feature for overview,
+// keeping its place in features.
+func replaceGoogleAISummary(features []core.SerpFeature, overview core.SerpFeature) []core.SerpFeature {
+	for i := range features {
+		if features[i].Type == core.ResultTypeAISummary {
+			features[i] = overview
+			return features
+		}
+	}
+	return append([]core.SerpFeature{overview}, features...)
+}
+
 // googlePlaceholderText catches empty AI Overview shells.
 var googlePlaceholderText = []string{
 	"ai overview is not available",
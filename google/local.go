@@ -0,0 +1,205 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseLocalHTML parses a Google local results tab (tbm=lcl) HTML document.
+func ParseLocalHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyGoogleDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseGoogleLocalDocument(doc, 0), nil
+}
+
+// parseGoogleLocalDocument extracts business cards from the local tab. A
+// business without a website links to its map page instead; cards with
+// neither are dropped.
+func parseGoogleLocalDocument(doc *goquery.Document, start int) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.LocalResults).Each(func(_ int, card *goquery.Selection) {
+		name, local, ok := core.ParseLocalCard(card, Selectors.LocalCard, googleResolveHref)
+		if !ok {
+			return
+		}
+		link := local.Website
+		if link == "" {
+			link = local.MapURL
+		}
+		if link == "" {
+			return
+		}
+		resultRank, absoluteRank := rank.Next(false)
+		local.Position = absoluteRank
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          link,
+			Title:        name,
+			Local:        local,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// extractGoogleLocalPack captures the map pack shown on regular result pages
+// for place queries.
+func extractGoogleLocalPack(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractLocalPack(doc, Selectors.LocalPack, Selectors.LocalResults, Selectors.LocalCard, googleResolveHref)
+}
+
+// SearchLocal runs raw HTTP requests against the Google local results tab,
+// following its start= pages until query.Limit businesses are collected.
+func SearchLocal(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	var all []core.SearchResult
+	pageQuery := query
+	for pages := 0; core.ShouldFetchResultPage(len(all), query.Limit, pages); pages++ {
+		results, err := searchLocalPage(ctx, pageQuery)
+		if err != nil {
+			// A later page without cards ends the walk; only the first
+			// page's parse failure fails the search.
+			if pages > 0 && errors.Is(err, core.ErrParser) {
+				break
+			}
+			return nil, err
+		}
+		if len(results) == 0 {
+			break
+		}
+		all = append(all, results...)
+		pageQuery.Start += len(results)
+	}
+	if len(all) == 0 {
+		return []core.SearchResult{}, nil
+	}
+	return core.LimitOrganicResults(core.DeduplicateResults(all), query.Limit), nil
+}
+
+// searchLocalPage fetches and parses the local tab page at query.Start. An
+// empty page returns no results and no error.
+func searchLocalPage(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	localURL, err := BuildLocalURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", localURL).Debug(fmt.Sprintf("Google Local URL built: %s", localURL))
+
+	res, err := core.RawSearchRequest(ctx, localURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyGoogleDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseGoogleLocalDocument(doc, query.Start)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: google local returned no parseable results", core.ErrParser)
+	}
+	return results, nil
+}
+
+// SearchLocal executes a Google local results tab search in the browser,
+// following its start= pages until query.Limit businesses are collected.
+func (gogl *Google) SearchLocal(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, gogl.Name(), true)
+	scoped := *gogl
+	scoped.logger = gogl.logger.WithRequest(ctx)
+	gogl = &scoped
+
+	gogl.logger.Debug("Starting local search, query: %+v", query)
+
+	var all []core.SearchResult
+	pageQuery := query
+	for pages := 0; core.ShouldFetchResultPage(len(all), query.Limit, pages); pages++ {
+		results, err := gogl.searchLocalPage(ctx, pageQuery)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			break
+		}
+		all = append(all, results...)
+		pageQuery.Start += len(results)
+	}
+
+	results := core.LimitOrganicResults(core.DeduplicateResults(all), query.Limit)
+	if results == nil {
+		results = []core.SearchResult{}
+	}
+	gogl.logger.Info("Local search completed: %d results", len(results))
+	return results, nil
+}
+
+// searchLocalPage opens the local tab page at query.Start and parses its
+// business cards. An empty page returns no results and no error.
+func (gogl *Google) searchLocalPage(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	u, err := BuildLocalURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gogl.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer gogl.close(ctx, page)
+
+	waitFor := []string{Selectors.LocalResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, gogl.GetSelectorTimeout()); err != nil {
+		if pageErr := gogl.classifyPage(page, query.ProxyURL); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			gogl.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyGoogleDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+	return parseGoogleLocalDocument(doc, query.Start), nil
+}
@@ -0,0 +1,82 @@
+package google
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestGoogleParseLocalDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "local_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseGoogleLocalDocument(doc, 20)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 local results, got %d", len(results))
+	}
+
+	first := results[0]
+	if first.Title != "Blue Bottle Coffee" || first.URL != "https://bluebottlecoffee.com/cafes/ferry-building" || first.AbsoluteRank != 21 {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	want := core.LocalMeta{
+		Position: 21, Rating: 4.5, ReviewCount: 820, Category: "Coffee shop",
+		Address: "1 Ferry Building #7", Phone: "(415) 555-0100", Hours: "Open ⋅ Closes 7 PM",
+		Website: "https://bluebottlecoffee.com/cafes/ferry-building",
+		MapURL:  "https://www.google.com/search?q=coffee&tbm=lcl&rldimm=1111",
+	}
+	if *first.Local != want {
+		t.Fatalf("unexpected local metadata: %+v", first.Local)
+	}
+
+	second := results[1]
+	if !strings.HasSuffix(second.URL, "rldimm=2222") || second.Local.ReviewCount != 1400 || second.Local.Category != "Cafe" || second.Local.Hours != "Closed ⋅ Opens 7 AM" {
+		t.Fatalf("expected map link fallback and parsed details, got %+v %+v", second, second.Local)
+	}
+}
+
+func TestGoogleLocalPackFeature(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "local_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	features := extractGoogleLocalPack(doc)
+	if len(features) != 1 || len(features[0].Items) != 2 {
+		t.Fatalf("expected one local pack with 2 businesses, got %+v", features)
+	}
+	item := features[0].Items[1]
+	if features[0].Type != core.ResultTypeLocal || item.Title != "Sightglass Coffee" || item.Local.Position != 2 || item.Text != "270 7th St" {
+		t.Fatalf("unexpected pack item: %+v %+v", item, item.Local)
+	}
+}
+
+func TestGoogleParseLocalHTMLCaptcha(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseLocalHTML(testutil.ResponseFromFixture(t, "search_captcha.html").Body); !errors.Is(err, core.ErrCaptcha) {
+		t.Fatalf("expected captcha error, got %v", err)
+	}
+}
+
+func TestGoogleBuildLocalURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildLocalURL(core.Query{Text: "coffee", Region: "US", Start: 20})
+	if err != nil {
+		t.Fatalf("BuildLocalURL() error = %v", err)
+	}
+	for _, want := range []string{"tbm=lcl", "q=coffee", "start=20", "gl=us"} {
+		if !strings.Contains(u, want) {
+			t.Fatalf("expected %q in %s", want, u)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseNewsHTML parses a Google News tab (tbm=nws) HTML document.
+func ParseNewsHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyGoogleDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseGoogleNewsDocument(doc, 0, time.Now()), nil
+}
+
+// parseGoogleNewsDocument extracts story cards. Relative ages ("3 hours ago")
+// are resolved against now; start offsets ranks for paginated requests.
+func parseGoogleNewsDocument(doc *goquery.Document, start int, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.NewsResults).Each(func(_ int, card *goquery.Selection) {
+		link := card.Find(Selectors.NewsLink).First()
+		href := unwrapGoogleRedirect(strings.TrimSpace(link.AttrOr("href", "")))
+		title := core.NormalizeWhitespace(card.Find(Selectors.NewsTitle).First().Text())
+		if href == "" || href == "#" || title == "" {
+			return
+		}
+
+		meta := &core.NewsMeta{
+			Source: core.NormalizeWhitespace(card.Find(Selectors.NewsSource).First().Text()),
+		}
+		if published, ok := core.ParsePublishedTime(card.Find(Selectors.NewsTime).First().Text(), now); ok {
+			meta.PublishedAt = published
+		}
+		// Thumbnails are inlined as data: URIs until lazy-loading swaps them,
+		// and those are useless to API callers.
+		if src := card.Find(Selectors.NewsThumbnail).First().AttrOr("src", ""); strings.HasPrefix(src, "http") {
+			meta.Thumbnail = src
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.NewsSnippet).First().Text()),
+			News:         meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// unwrapGoogleRedirect returns the target of a "/url?q=..." hop, which the
+// no-JS news layout uses instead of direct links.
+func unwrapGoogleRedirect(href string) string {
+	if !strings.HasPrefix(href, "/url?") {
+		return href
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("q")
+}
+
+// SearchNews runs a raw HTTP request against the Google News tab.
+func SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	newsURL, err := BuildNewsURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", newsURL).Debug(fmt.Sprintf("Google News URL built: %s", newsURL))
+
+	res, err := core.RawSearchRequest(ctx, newsURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyGoogleDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseGoogleNewsDocument(doc, query.Start, time.Now())
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: google news returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchNews executes a Google News tab search in the browser.
+func (gogl *Google) SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, gogl.Name(), true)
+	scoped := *gogl
+	scoped.logger = gogl.logger.WithRequest(ctx)
+	gogl = &scoped
+
+	gogl.logger.Debug("Starting news search, query: %+v", query)
+	u, err := BuildNewsURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gogl.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer gogl.close(ctx, page)
+
+	waitFor := []string{Selectors.NewsResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, gogl.GetSelectorTimeout()); err != nil {
+		if pageErr := gogl.classifyPage(page, query.ProxyURL); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			gogl.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyGoogleDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseGoogleNewsDocument(doc, query.Start, time.Now())
+	gogl.logger.Info("News search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
@@ -0,0 +1,69 @@
+package google
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestGoogleParseNewsDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "news_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseGoogleNewsDocument(doc, 0, now)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 news results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	if first.Title != "Go 1.22 brings range-over-int loops" || first.News == nil {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if first.News.Source != "Reuters" || !first.News.PublishedAt.Equal(now.Add(-3*time.Hour)) {
+		t.Fatalf("unexpected news metadata: %+v", first.News)
+	}
+	if !strings.HasPrefix(first.News.Thumbnail, "https://") {
+		t.Fatalf("expected thumbnail URL, got %q", first.News.Thumbnail)
+	}
+	if results[1].News.Thumbnail != "" {
+		t.Fatalf("expected data: thumbnail to be dropped, got %q", results[1].News.Thumbnail)
+	}
+	if results[2].URL != "https://go.dev/blog/survey2024" {
+		t.Fatalf("expected /url?q= redirect to be unwrapped, got %q", results[2].URL)
+	}
+}
+
+func TestGoogleParseNewsHTMLCaptcha(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseNewsHTML(testutil.ResponseFromFixture(t, "search_captcha.html").Body); !errors.Is(err, core.ErrCaptcha) {
+		t.Fatalf("expected captcha error, got %v", err)
+	}
+}
+
+func TestGoogleBuildNewsURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildNewsURL(core.Query{Text: "golang", LangCode: "en", DateInterval: "20240301..20240305", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildNewsURL() error = %v", err)
+	}
+	for _, want := range []string{"tbm=nws", "q=golang", "start=10", "cd_min%3A20240301"} {
+		if !strings.Contains(u, want) {
+			t.Fatalf("expected %q in %s", want, u)
+		}
+	}
+	if _, err := BuildNewsURL(core.Query{}); err == nil {
+		t.Fatal("expected empty query to fail")
+	}
+}
@@ -0,0 +1,245 @@
+package google
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/karust/openserp/core"
+)
+
+// PAA tree bounds. Every question costs one click, and the whole walk shares
+// one deadline so a deep paa_depth cannot eat the search timeout.
+const (
+	paaMaxClicks    = 40
+	paaExpandBudget = 12 * time.Second
+	// paaAnswerWait bounds how long one clicked question takes to open.
+	paaAnswerWait = 2 * time.Second
+	// paaRevealWait bounds how long Google takes to append follow-up
+	// questions after one is opened.
+	paaRevealWait = 800 * time.Millisecond
+)
+
+// paaNode is one question in the expanded people-also-ask tree.
+type paaNode struct {
+	Question string
+	Answer   string
+	Source   string
+	Parent   string
+	Depth    int
+}
+
+// expandPeopleAlsoAsk opens the PAA box breadth-first. The questions already on
+// the page are depth 1; questions Google appends after one is opened are its
+// children, one level deeper. Questions up to maxDepth are opened so their
+// answer and source link can be read. The walk stops early at paaMaxClicks or
+// paaExpandBudget and returns what it has.
+func (gogl *Google) expandPeopleAlsoAsk(ctx context.Context, page *rod.Page, maxDepth int) []paaNode {
+	deadline := time.Now().Add(paaExpandBudget)
+	seen := map[string]bool{}
+	var nodes []paaNode
+	var queue []int
+	for _, question := range gogl.paaQuestions(page, seen) {
+		nodes = append(nodes, paaNode{Question: question, Depth: 1})
+		queue = append(queue, len(nodes)-1)
+	}
+
+	clicks := 0
+	for len(queue) > 0 {
+		if clicks >= paaMaxClicks || !time.Now().Before(deadline) || ctx.Err() != nil {
+			gogl.logger.Debug("PAA expansion stopped after %d clicks with %d questions queued", clicks, len(queue))
+			break
+		}
+		idx := queue[0]
+		queue = queue[1:]
+
+		el := gogl.paaQuestionElement(page, nodes[idx].Question)
+		if el == nil {
+			continue
+		}
+		if _, _, ok := splitPAAText(elementText(el)); !ok {
+			clicks++
+			if err := el.Click(proto.InputMouseButtonLeft, 1); err != nil {
+				gogl.logger.Debug("PAA question click failed: %s", err)
+				continue
+			}
+			if err := gogl.waitAnswersExpanded(ctx, rod.Elements{el}, paaAnswerWait); err != nil {
+				break
+			}
+		}
+		gogl.capturePAAAnswer(el, &nodes[idx])
+
+		// Opening a question appends its follow-ups; claim them even past
+		// maxDepth so a later click does not adopt them.
+		revealed := gogl.waitPAARevealed(ctx, page, seen, paaRevealWait)
+		if nodes[idx].Depth >= maxDepth {
+			continue
+		}
+		for _, question := range revealed {
+			nodes = append(nodes, paaNode{Question: question, Parent: nodes[idx].Question, Depth: nodes[idx].Depth + 1})
+			queue = append(queue, len(nodes)-1)
+		}
+	}
+	gogl.logger.Debug("PAA expansion found %d questions with %d clicks", len(nodes), clicks)
+	return nodes
+}
+
+// paaQuestions returns the data-q of PAA questions not yet in seen, marking
+// them seen.
+func (gogl *Google) paaQuestions(page *rod.Page, seen map[string]bool) []string {
+	elements, err := page.Elements(Selectors.AnswerBox)
+	if err != nil {
+		gogl.logger.Debug("PAA questions lookup failed: %s", err)
+		return nil
+	}
+	var questions []string
+	for _, el := range elements {
+		question := paaQuestionText(el)
+		if question == "" || seen[question] {
+			continue
+		}
+		seen[question] = true
+		questions = append(questions, question)
+	}
+	return questions
+}
+
+// waitPAARevealed polls for questions added after a click until some appear or
+// maxWait elapses.
+func (gogl *Google) waitPAARevealed(ctx context.Context, page *rod.Page, seen map[string]bool, maxWait time.Duration) []string {
+	deadline := time.Now().Add(maxWait)
+	for {
+		if questions := gogl.paaQuestions(page, seen); len(questions) > 0 {
+			return questions
+		}
+		if !time.Now().Before(deadline) {
+			return nil
+		}
+		if err := core.SleepContext(ctx, 100*time.Millisecond); err != nil {
+			return nil
+		}
+	}
+}
+
+func (gogl *Google) paaQuestionElement(page *rod.Page, question string) *rod.Element {
+	elements, err := page.Elements(Selectors.AnswerBox)
+	if err != nil {
+		return nil
+	}
+	for _, el := range elements {
+		if paaQuestionText(el) == question {
+			return el
+		}
+	}
+	return nil
+}
+
+// capturePAAAnswer reads the opened answer body and its source link. It uses
+// Elements rather than Element so a sourceless answer does not block on the
+// page timeout.
+func (gogl *Google) capturePAAAnswer(el *rod.Element, node *paaNode) {
+	if _, answer, ok := splitPAAText(elementText(el)); ok {
+		node.Answer = answer
+	}
+	links, err := el.Elements(Selectors.AnswerItem)
+	if err != nil || len(links) == 0 {
+		gogl.logger.Debug("Missing PAA source link for %q", node.Question)
+		return
+	}
+	href, err := links.First().Property("href")
+	if err != nil {
+		return
+	}
+	node.Source = href.String()
+}
+
+func paaQuestionText(el *rod.Element) string {
+	attr, err := el.Attribute("data-q")
+	if err != nil || attr == nil {
+		return ""
+	}
+	return core.NormalizeWhitespace(*attr)
+}
+
+func elementText(el *rod.Element) string {
+	text, err := el.Text()
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+// splitPAAText splits an opened PAA entry, laid out as [question, answer...,
+// source, meta], into the question and answer body. ok is false while the
+// entry is collapsed to the question alone.
+func splitPAAText(raw string) (question string, answer string, ok bool) {
+	lines := strings.Split(raw, "\n")
+	if len(lines) < 2 {
+		return "", "", false
+	}
+	// Drop the trailing source and meta lines, but never slice past the
+	// question — a short answer (len 2) would otherwise give lines[1:0].
+	answerEnd := max(len(lines)-2, 1)
+	return lines[0], strings.Join(lines[1:answerEnd], "\n"), true
+}
+
+// paaTreeFeature turns the expanded tree into one people-also-ask feature.
+// Items carry each question's parent and depth and are listed depth-first, so
+// follow-ups sit right under the question that revealed them; Links hold the
+// answer sources.
+func paaTreeFeature(nodes []paaNode) core.SerpFeature {
+	feature := core.SerpFeature{
+		Type:       core.ResultTypePeopleAlsoAsk,
+		Title:      "People also ask",
+		Confidence: 0.8,
+	}
+	children := map[string][]paaNode{}
+	for _, node := range nodes {
+		children[node.Parent] = append(children[node.Parent], node)
+	}
+	var ordered []paaNode
+	var walk func(parent string)
+	walk = func(parent string) {
+		for _, node := range children[parent] {
+			ordered = append(ordered, node)
+			walk(node.Question)
+		}
+	}
+	walk("")
+
+	for _, node := range ordered {
+		feature.Items = append(feature.Items, core.FeatureItem{
+			Title:  node.Question,
+			Text:   node.Answer,
+			Link:   node.Source,
+			Parent: node.Parent,
+			Depth:  node.Depth,
+		})
+		if node.Source != "" {
+			feature.Links = append(feature.Links, core.FeatureLink{Title: node.Question, URL: node.Source})
+		}
+	}
+	return feature
+}
+
+// withPAATree replaces the flat PAA feature parsed from the page with the
+// expanded tree, which keeps the flat module's layout box.
+func withPAATree(features []core.SerpFeature, nodes []paaNode) []core.SerpFeature {
+	if len(nodes) == 0 {
+		return features
+	}
+	kept := make([]core.SerpFeature, 0, len(features)+1)
+	var layout *core.LayoutBox
+	for _, feature := range features {
+		if feature.Type != core.ResultTypePeopleAlsoAsk {
+			kept = append(kept, feature)
+		} else if layout == nil {
+			layout = feature.Layout
+		}
+	}
+	tree := paaTreeFeature(nodes)
+	tree.Layout = layout
+	return append(kept, tree)
+}
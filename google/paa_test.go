@@ -0,0 +1,53 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestSplitPAAText(t *testing.T) {
+	t.Parallel()
+
+	question, answer, ok := splitPAAText("What is Go?\nGo is a programming language.\nIt is compiled.\ngo.dev\nhttps://go.dev")
+	if !ok || question != "What is Go?" || answer != "Go is a programming language.\nIt is compiled." {
+		t.Fatalf("unexpected split: %q %q %v", question, answer, ok)
+	}
+	if _, _, ok := splitPAAText("What is Go?"); ok {
+		t.Fatal("a collapsed question must not count as opened")
+	}
+	// A two-line entry keeps the question and yields an empty body.
+	if question, answer, ok := splitPAAText("What is Go?\ngo.dev"); !ok || question != "What is Go?" || answer != "" {
+		t.Fatalf("unexpected short split: %q %q %v", question, answer, ok)
+	}
+}
+
+func TestWithPAATreeReplacesFlatFeature(t *testing.T) {
+	t.Parallel()
+
+	nodes := []paaNode{
+		{Question: "What is Go?", Answer: "A language.", Source: "https://go.dev/", Depth: 1},
+		{Question: "Is Go hard?", Depth: 1},
+		{Question: "Who made Go?", Answer: "Google.", Source: "https://en.wikipedia.org/wiki/Go", Parent: "What is Go?", Depth: 2},
+	}
+	features := withPAATree([]core.SerpFeature{
+		{Type: core.ResultTypePeopleAlsoAsk, Items: []core.FeatureItem{{Title: "What is Go?"}}},
+		{Type: core.ResultTypeRelatedSearches, Items: []core.FeatureItem{{Text: "golang tutorial"}}},
+	}, nodes)
+
+	if len(features) != 2 || features[0].Type != core.ResultTypeRelatedSearches {
+		t.Fatalf("expected the flat PAA feature to be replaced, got %+v", features)
+	}
+	tree := features[1]
+	if len(tree.Items) != 3 || len(tree.Links) != 2 {
+		t.Fatalf("unexpected tree feature: %+v", tree)
+	}
+	child := tree.Items[1]
+	if child.Parent != "What is Go?" || child.Depth != 2 || child.Text != "Google." || child.Link != "https://en.wikipedia.org/wiki/Go" {
+		t.Fatalf("expected the follow-up listed under its parent, got %+v", child)
+	}
+
+	if got := withPAATree(features[:1], nil); len(got) != 1 {
+		t.Fatalf("expected features untouched without a tree, got %+v", got)
+	}
+}
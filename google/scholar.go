@@ -0,0 +1,201 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-rod/rod"
+	"github.com/karust/openserp/core"
+)
+
+// ParseScholarHTML parses a Google Scholar results HTML document.
+func ParseScholarHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyGoogleScholarDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseGoogleScholarDocument(doc, 0), nil
+}
+
+// classifyGoogleScholarDocument detects Scholar's own captcha form on top of
+// the reCAPTCHA checks shared with web search, and its "did not match any
+// articles" page.
+func classifyGoogleScholarDocument(doc *goquery.Document) error {
+	if doc.Find(Selectors.ScholarCaptcha).Length() > 0 || isGoogleCaptchaDocument(doc) {
+		return core.ErrCaptcha
+	}
+	if doc.Find(Selectors.ScholarResults).Length() == 0 &&
+		strings.Contains(strings.ToLower(doc.Find(Selectors.ScholarNoResults).Text()), "did not match any articles") {
+		return core.ErrEmptyResult
+	}
+	return nil
+}
+
+// parseGoogleScholarDocument extracts paper cards. Citation-only entries
+// ([CITATION]) have no title link and are skipped.
+func parseGoogleScholarDocument(doc *goquery.Document, start int) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.ScholarResults).Each(func(_ int, card *goquery.Selection) {
+		titleTag := card.Find(Selectors.ScholarTitle).First()
+		href := googleScholarHref(strings.TrimSpace(titleTag.AttrOr("href", "")))
+		title := core.NormalizeWhitespace(titleTag.Text())
+		if !strings.HasPrefix(href, "http") || title == "" {
+			return
+		}
+
+		meta := &core.ScholarMeta{}
+		meta.Authors, meta.Venue, meta.Year = core.ParseScholarByline(card.Find(Selectors.ScholarByline).First().Text())
+		if pdf := googleScholarHref(strings.TrimSpace(card.Find(Selectors.ScholarPDF).First().AttrOr("href", ""))); strings.HasPrefix(pdf, "http") {
+			meta.PDFURL = pdf
+		}
+		card.Find(Selectors.ScholarLinks).Each(func(_ int, link *goquery.Selection) {
+			linkHref := strings.TrimSpace(link.AttrOr("href", ""))
+			switch {
+			case strings.Contains(linkHref, "cites="):
+				meta.CitedBy = core.ParseScholarCount(link.Text())
+				meta.CitedByURL = googleScholarHref(linkHref)
+			case strings.Contains(linkHref, "cluster="):
+				meta.Versions = core.ParseScholarCount(link.Text())
+				meta.VersionsURL = googleScholarHref(linkHref)
+			}
+		})
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.ScholarSnippet).First().Text()),
+			Scholar:      meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// googleScholarHref makes Scholar's relative links (/scholar?cites=...)
+// absolute.
+func googleScholarHref(href string) string {
+	if strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "//") {
+		return "https://scholar.google.com" + href
+	}
+	return href
+}
+
+// SearchScholar runs a raw HTTP request against Google Scholar.
+func SearchScholar(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	scholarURL, err := BuildScholarURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", scholarURL).Debug(fmt.Sprintf("Google Scholar URL built: %s", scholarURL))
+
+	res, err := core.RawSearchRequest(ctx, scholarURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyGoogleScholarDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseGoogleScholarDocument(doc, query.Start)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: google scholar returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchScholar executes a Google Scholar search in the browser.
+func (gogl *Google) SearchScholar(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, gogl.Name(), true)
+	scoped := *gogl
+	scoped.logger = gogl.logger.WithRequest(ctx)
+	gogl = &scoped
+
+	gogl.logger.Debug("Starting scholar search, query: %+v", query)
+	u, err := BuildScholarURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gogl.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer gogl.close(ctx, page)
+
+	waitFor := []string{Selectors.ScholarResults, Selectors.ScholarNoResults, Selectors.ScholarCaptcha, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, gogl.GetSelectorTimeout()); err != nil {
+		if pageErr := gogl.classifyScholarPage(page, query.ProxyURL); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			gogl.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyGoogleScholarDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseGoogleScholarDocument(doc, query.Start)
+	gogl.logger.Info("Scholar search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// classifyScholarPage is classifyPage for Scholar pages. Scholar's own
+// captcha form cannot be solved, so only reCAPTCHA goes to the solver.
+func (gogl *Google) classifyScholarPage(page *rod.Page, queryProxyURL string) error {
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil
+	}
+	pageErr := classifyGoogleScholarDocument(doc)
+	if !errors.Is(pageErr, core.ErrCaptcha) || doc.Find(Selectors.ScholarCaptcha).Length() > 0 {
+		return pageErr
+	}
+	if gogl.solveCaptchaOnPage(page, queryProxyURL) {
+		return nil
+	}
+	return pageErr
+}
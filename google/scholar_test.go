@@ -0,0 +1,87 @@
+package google
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestGoogleParseScholarDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "scholar_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseGoogleScholarDocument(doc, 10)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 scholar results with the citation-only entry skipped, got %d", len(results))
+	}
+
+	first := results[0]
+	if first.Title != "Attention is all you need" || first.Rank != 11 || first.Description == "" {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	want := core.ScholarMeta{
+		Authors:     []string{"A Vaswani", "N Shazeer", "N Parmar"},
+		Venue:       "Advances in neural information processing systems",
+		Year:        2017,
+		CitedBy:     152341,
+		CitedByURL:  "https://scholar.google.com/scholar?cites=2960712678066186980&as_sdt=2005&sciodt=0,5&hl=en",
+		PDFURL:      "https://arxiv.org/pdf/1706.03762",
+		Versions:    71,
+		VersionsURL: "https://scholar.google.com/scholar?cluster=2960712678066186980&hl=en&as_sdt=0,5",
+	}
+	if !reflect.DeepEqual(*first.Scholar, want) {
+		t.Fatalf("unexpected scholar metadata: %+v", first.Scholar)
+	}
+
+	second := results[1]
+	if second.Rank != 12 || second.URL != "https://aclanthology.org/N19-1423/" || second.Scholar.Venue != "" || second.Scholar.Year != 2019 || second.Scholar.PDFURL != "" || second.Scholar.Versions != 12 {
+		t.Fatalf("unexpected second result: %+v %+v", second, second.Scholar)
+	}
+}
+
+func TestGoogleParseScholarHTMLNoResults(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseScholarHTML(testutil.ResponseFromFixture(t, "scholar_no_results.html").Body)
+	if err != nil || len(results) != 0 {
+		t.Fatalf("expected empty results, got %d results, err %v", len(results), err)
+	}
+}
+
+func TestGoogleParseScholarHTMLCaptcha(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseScholarHTML(testutil.ResponseFromFixture(t, "search_captcha.html").Body); !errors.Is(err, core.ErrCaptcha) {
+		t.Fatalf("expected captcha error, got %v", err)
+	}
+}
+
+func TestGoogleBuildScholarURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildScholarURL(core.Query{Text: "transformers", LangCode: "en", DateInterval: "20170101..20201231", Start: 20, Limit: 50})
+	if err != nil {
+		t.Fatalf("BuildScholarURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Host != "scholar.google.com" || params.Get("q") != "transformers" || params.Get("as_ylo") != "2017" ||
+		params.Get("as_yhi") != "2020" || params.Get("start") != "20" || params.Get("num") != "20" || params.Get("hl") != "en" {
+		t.Fatalf("unexpected scholar URL: %s", u)
+	}
+
+	if _, err := BuildScholarURL(core.Query{Text: "transformers", DateInterval: "2017"}); err == nil {
+		t.Fatal("expected malformed date interval to be rejected")
+	}
+}
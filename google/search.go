@@ -4,8 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -18,8 +16,7 @@ import (
 type Google struct {
 	core.Browser
 	core.SearchEngineOptions
-	rgxpGetDigits *regexp.Regexp
-	logger        *core.EngineLogger
+	logger *core.EngineLogger
 }
 
 // New creates a Google engine instance with browser/runtime options applied.
@@ -28,7 +25,6 @@ func New(browser core.Browser, opts core.SearchEngineOptions) *Google {
 	opts.Init()
 	gogl.SearchEngineOptions = opts
 	gogl.logger = core.NewEngineLogger("Google")
-	gogl.rgxpGetDigits = regexp.MustCompile(`\d`)
 	return &gogl
 }
 
@@ -37,45 +33,6 @@ func (gogl *Google) Name() string {
 	return "google"
 }
 
-func (gogl *Google) getTotalResults(page *rod.Page) (int, error) {
-	if gogl.rgxpGetDigits == nil {
-		return 0, core.ErrParser
-	}
-
-	// Stats div is absent on many locales; probe first so .Search doesn't block
-	// the full selector timeout.
-	if has, _, err := page.Has(Selectors.ResultStats); err != nil || !has {
-		return 0, nil
-	}
-
-	resultsStats, err := page.Timeout(gogl.GetSelectorTimeout()).Search(Selectors.ResultStats)
-	if err != nil {
-		return 0, errors.New("Result stats not found: " + err.Error())
-	}
-
-	statsText, err := resultsStats.First.Text()
-	if err != nil {
-		return 0, errors.New("Cannot extract result stats text: " + err.Error())
-	}
-
-	if len(statsText) == 0 {
-		return 0, nil
-	}
-
-	// Remove search time seconds info from the end
-	if len(statsText) > 15 {
-		statsText = statsText[:len(statsText)-15]
-	}
-
-	foundDigits := gogl.rgxpGetDigits.FindAllString(statsText, -1)
-	totalNum := strings.Join(foundDigits, "")
-	total, err := strconv.Atoi(totalNum)
-	if err != nil {
-		return 0, err
-	}
-	return total, nil
-}
-
 func (gogl *Google) solveCaptcha(page *rod.Page, sitekey, datas, proxyURL string) bool {
 	gogl.logger.Debug("Solve captcha: sitekey=%s", sitekey)
 
@@ -243,6 +200,7 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 
 	if err := gogl.classifyPage(page, query.ProxyURL); err != nil && !errors.Is(err, core.ErrEmptyResult) {
 		gogl.logger.Error("Page classified as %v: %s", err, url)
+		core.ArchivePage(ctx, page)
 		return nil, err
 	}
 
@@ -254,27 +212,54 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 	// Wait for the canonical organic wrapper first, then Google's broader
 	// data-hveid/data-ved layout. Headless and headful Chrome can receive
 	// different SERP markup for the same query.
-	searchResultElems, matchedSelector, err := core.WaitForElements(ctx, page, searchResultSelectors(), gogl.GetSelectorTimeout())
+	searchResultElems, matchedSelector, err := core.WaitForElements(ctx, page, searchResultSelectors(query.Device == core.DeviceMobile), gogl.GetSelectorTimeout())
 	if err != nil {
 		if pageErr := gogl.classifyPage(page, query.ProxyURL); pageErr != nil {
 			if errors.Is(pageErr, core.ErrEmptyResult) {
 				return nil, nil
 			}
 			gogl.logger.Error("Page classified as %v: %s", pageErr, url)
+			core.ArchivePage(ctx, page)
 			return nil, pageErr
 		}
 		if core.IsContextDone(err) {
 			return nil, err
 		}
+		core.ArchivePage(ctx, page)
 		return nil, core.ErrSearchTimeout
 	}
 	gogl.logger.Debug("Search result selector matched: %s (%d elements)", matchedSelector, len(searchResultElems))
 
-	totalResults, err := gogl.getTotalResults(page)
-	if err != nil {
-		gogl.logger.Debug("Failed to get total results: %v", err)
+	// Read the meta after the AI Overview has settled, so its status is
+	// the final one.
+	if query.Features {
+		gogl.waitAIOverview(ctx, page)
+	}
+	serpMeta := core.SerpMetaFromPage(page, parseGoogleSerpMeta)
+	if serpMeta != nil {
+		gogl.logger.Info("Found %d total results", serpMeta.TotalResults)
+	}
+
+	// Walk the PAA tree before the answer-box pass below reads it, so that
+	// pass sees every opened question instead of toggling them shut again.
+	var paaTree []paaNode
+	if query.Features && query.PAADepth > 0 && !query.AIOnly {
+		paaTree = gogl.expandPeopleAlsoAsk(ctx, page, query.PAADepth)
+	}
+	// Archive what the parser reads, then measure; the walk grows the page
+	// and stamping marks the DOM.
+	core.ArchivePage(ctx, page)
+	if query.Layout {
+		serpMeta = core.WithSerpLayout(serpMeta, core.StampLayout(page, layoutContainers(query.Device == core.DeviceMobile)...))
+	}
+	core.CaptureScreenshot(ctx, page)
+
+	// features=ai_only answers from the settled overview alone, skipping the
+	// organic results and the feature hydration wait. The envelope keeps
+	// only the AI summary; the carrier row holds the meta even without one.
+	if query.AIOnly {
+		return []core.SearchResult{{Features: core.FeaturesFromPage(page, extractGoogleFeatures), SerpMeta: serpMeta}}, nil
 	}
-	gogl.logger.Info("Found %d total results", totalResults)
 
 	rank := core.NewRankStateAt(query.Start, query.Start+1)
 	// When matched by the canonical organic selector (div.tF2Cxc) every element
@@ -282,7 +267,7 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 	// (it sits on the outer .g/data-hveid container). Only require data-ved when
 	// we fell back to the broad attribute selector, which also matches non-result
 	// blocks (knowledge panels, nav) that must be filtered out.
-	matchedOrganic := matchedSelector == Selectors.Results
+	matchedOrganic := matchedSelector == Selectors.Results || matchedSelector == Selectors.ResultsMobile
 	for _, resEl := range searchResultElems {
 		srchRes := core.SearchResult{}
 
@@ -333,6 +318,7 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 				srchRes.Description = strings.TrimSpace(text)
 			}
 			srchRes.Rank, srchRes.AbsoluteRank = rank.Next(true)
+			srchRes.Layout = core.ElementLayout(resEl)
 			searchResults = append(searchResults, srchRes)
 
 		} else if isAnswerBox {
@@ -351,20 +337,22 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 
 			gogl.logger.Info("Found %d answers", len(answers))
 
-			// Unvail answer contents
-			for _, answ := range answers {
-				if err := answ.Click(proto.InputMouseButtonLeft, 1); err != nil {
-					gogl.logger.Debug("Answer expand click failed: %s", err)
-					continue
+			// Unvail answer contents, unless the PAA walk already opened them
+			if len(paaTree) == 0 {
+				for _, answ := range answers {
+					if err := answ.Click(proto.InputMouseButtonLeft, 1); err != nil {
+						gogl.logger.Debug("Answer expand click failed: %s", err)
+						continue
+					}
+					if err := answ.Focus(); err != nil {
+						gogl.logger.Debug("Answer focus failed: %s", err)
+					}
+
 				}
-				if err := answ.Focus(); err != nil {
-					gogl.logger.Debug("Answer focus failed: %s", err)
+				// Poll for expansion (usually 200-400ms) instead of a flat 2s sleep.
+				if err := gogl.waitAnswersExpanded(ctx, answers, 2*time.Second); err != nil {
+					return nil, err
 				}
-
-			}
-			// Poll for expansion (usually 200-400ms) instead of a flat 2s sleep.
-			if err := gogl.waitAnswersExpanded(ctx, answers, 2*time.Second); err != nil {
-				return nil, err
 			}
 
 			for i, answ := range answers {
@@ -373,9 +361,9 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 					gogl.logger.Debug("Missing answer text")
 					continue
 				}
-				answerText := strings.Split(answerRawText, "\n")
-				if len(answerText) < 2 {
-					gogl.logger.Debug("Short answer text: %s", answerText)
+				question, answer, ok := splitPAAText(answerRawText)
+				if !ok {
+					gogl.logger.Debug("Short answer text: %s", answerRawText)
 					continue
 				}
 
@@ -395,21 +383,21 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 					continue
 				}
 				srchRes.URL = href.String()
-				srchRes.Title = answerText[0]
-				// answerText is [title, body..., source, meta]; drop the trailing
-				// two metadata lines, but never slice past the title — a short
-				// answer (len 2) would otherwise produce answerText[1:0] and panic.
-				descEnd := max(len(answerText)-2, 1)
-				srchRes.Description = strings.Join(answerText[1:descEnd], "\n")
+				srchRes.Title = question
+				srchRes.Description = answer
 				srchRes.Rank = -1 * (i + 1)
 				srchRes.Type = core.ResultTypePeopleAlsoAsk
+				srchRes.Layout = core.ElementLayout(answ)
 				searchResults = append(searchResults, srchRes)
 			}
 			continue
 		} else if isResultCandidate {
 			// Parse regular search results
-			// Get title from h3
+			// Get title from h3, or the mobile SERP's heading div
 			titleTag, err := resEl.Element(Selectors.Title)
+			if err != nil && query.Device == core.DeviceMobile {
+				titleTag, err = resEl.Element(Selectors.TitleMobile)
+			}
 			if err != nil {
 				continue
 			}
@@ -451,8 +439,10 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 				}
 			}
 			srchRes.Description = desc
+			core.ApplyElementExtras(&srchRes, resEl, Selectors.Extras, googleResolveHref)
 
 			srchRes.Rank, srchRes.AbsoluteRank = rank.Next(false)
+			srchRes.Layout = core.ElementLayout(resEl)
 			searchResults = append(searchResults, srchRes)
 			continue
 
@@ -478,9 +468,10 @@ func (gogl *Google) Search(ctx context.Context, query core.Query) (results []cor
 		return nil, core.ErrSearchTimeout
 	}
 	if query.Features {
-		deduped = core.AttachFeaturesToFirstResult(deduped, extractGoogleFeaturesFromPage(ctx, page))
+		features := withPAATree(extractGoogleFeaturesFromPage(ctx, page), paaTree)
+		deduped = core.AttachFeaturesToFirstResult(deduped, features)
 	}
-	return deduped, nil
+	return core.AttachSerpMetaToFirstResult(deduped, serpMeta), nil
 }
 
 // SearchImage executes a Google image search and returns normalized image
@@ -100,6 +100,9 @@ func parseGoogleDocument(doc *goquery.Document) []core.SearchResult {
 				Description:  desc,
 				Ad:           isAd,
 			}
+			if !isAd {
+				core.ApplyResultExtras(&result, item, Selectors.Extras, googleResolveHref)
+			}
 
 			results = append(results, result)
 		}
@@ -108,7 +111,8 @@ func parseGoogleDocument(doc *goquery.Document) []core.SearchResult {
 	logrus.WithField("document_size", len(doc.Text())).Trace(
 		fmt.Sprintf("Google search document size: %d", len(doc.Text())),
 	)
-	return core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractGoogleFeatures(doc))
+	results = core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractGoogleFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseGoogleSerpMeta(doc))
 }
 
 func classifyGoogleRawHTML(body []byte) error {
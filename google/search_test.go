@@ -2,6 +2,7 @@ package google
 
 import (
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/karust/openserp/core"
@@ -402,3 +403,112 @@ func TestSolveCaptchaWithoutConfiguredSolverReturnsFalse(t *testing.T) {
 		t.Fatal("expected solveCaptcha to fail without solver/page context")
 	}
 }
+
+func TestBuildURLSafeSearch(t *testing.T) {
+	tests := []struct {
+		level core.SafeSearch
+		want  string
+	}{
+		{"", ""},
+		{core.SafeSearchOff, "off"},
+		{core.SafeSearchModerate, ""},
+		{core.SafeSearchStrict, "active"},
+	}
+	for _, tt := range tests {
+		q := core.Query{Text: "golang", Filter: true, SafeSearch: tt.level}
+		for _, build := range []func(core.Query) (string, error){BuildURL, BuildImageURL, BuildNewsURL} {
+			got, err := build(q)
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			parsed, _ := url.Parse(got)
+			if safe := parsed.Query().Get("safe"); safe != tt.want {
+				t.Fatalf("safe=%q for level %q, want %q (%s)", safe, tt.level, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestBuildURLVerbatim(t *testing.T) {
+	got, err := BuildURL(core.Query{Text: "pyhton", Filter: true, Verbatim: true, DateInterval: "20240101..20240201"})
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	params, _ := url.Parse(got)
+	if nfpr := params.Query().Get("nfpr"); nfpr != "1" {
+		t.Fatalf("nfpr=%q, want 1 (%s)", nfpr, got)
+	}
+	if tbs := params.Query().Get("tbs"); tbs != "cdr:1,cd_min:20240101,cd_max:20240201,li:1" {
+		t.Fatalf("tbs=%q should carry both the date range and li:1", tbs)
+	}
+
+	plain, _ := BuildURL(core.Query{Text: "pyhton", Filter: true})
+	if parsed, _ := url.Parse(plain); parsed.Query().Has("nfpr") || parsed.Query().Has("tbs") {
+		t.Fatalf("non-verbatim URL carries verbatim params: %s", plain)
+	}
+}
+
+func TestBuildTabURLsVerbatim(t *testing.T) {
+	q := core.Query{Text: "pyhton", Verbatim: true}
+	builders := map[string]func(core.Query) (string, error){
+		"images":   BuildImageURL,
+		"news":     BuildNewsURL,
+		"videos":   BuildVideoURL,
+		"shopping": BuildShoppingURL,
+		"local":    BuildLocalURL,
+	}
+	for name, build := range builders {
+		got, err := build(q)
+		if err != nil {
+			t.Fatalf("%s: build error = %v", name, err)
+		}
+		parsed, _ := url.Parse(got)
+		if parsed.Query().Get("nfpr") != "1" || parsed.Query().Get("tbs") != "li:1" {
+			t.Fatalf("%s URL should carry nfpr=1 and tbs=li:1: %s", name, got)
+		}
+	}
+	if SupportsVerbatim(core.VerticalScholar) {
+		t.Fatal("Scholar has no verbatim switch")
+	}
+}
+
+func TestBuildURLOperators(t *testing.T) {
+	got, err := BuildURL(core.Query{
+		Text:         "golang",
+		Exact:        "go vet",
+		ExcludeSites: []string{"pinterest.com"},
+		InURL:        "blog",
+		OrTerms:      []string{"tutorial", "guide"},
+		Filter:       true,
+	})
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	parsed, _ := url.Parse(got)
+	if q := parsed.Query().Get("q"); q != `golang "go vet" -site:pinterest.com inurl:blog (tutorial OR guide)` {
+		t.Fatalf("unexpected q value: %q", q)
+	}
+}
+
+func TestSearchResultSelectorsMobileFirst(t *testing.T) {
+	if got := searchResultSelectors(true); got[0] != Selectors.ResultsMobile {
+		t.Fatalf("expected mobile layout first, got %v", got)
+	}
+	for _, sel := range searchResultSelectors(false) {
+		if sel == Selectors.ResultsMobile {
+			t.Fatalf("desktop search should not wait for the mobile layout: %v", searchResultSelectors(false))
+		}
+	}
+}
+
+func TestLayoutContainersCoverResultsAndFeatures(t *testing.T) {
+	containers := strings.Join(layoutContainers(false), "|")
+	for _, want := range []string{Selectors.Results, Selectors.ProductCarouselItem, Selectors.KnowledgePanel.Container, "div[data-initq]"} {
+		if !strings.Contains(containers, want) {
+			t.Fatalf("layout containers miss %q: %s", want, containers)
+		}
+	}
+	if strings.Contains("|"+containers+"|", "|a|") {
+		t.Fatalf("layout containers must not stamp every link: %s", containers)
+	}
+}
@@ -1,5 +1,7 @@
 package google
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for Google SERP CSS selectors.
 // Both the browser parser (search.go, rod) and HTML parser (search_raw.go,
 // goquery) reference these. When Google changes their DOM, edit here only.
@@ -10,23 +12,78 @@ var Selectors = struct {
 	SoftBlock      string
 	NoResults      string
 	ResultStats    string
+	ResultTime     string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
 	CookieBtn      string
 	Results        string
 	ResultsBroad   string
+	ResultsMobile  string
 	Ad             string
 	Link           string
 	Title          string
+	TitleMobile    string
 	DescPrimary    string
 	DescFallback   string
 	DescAny        string
 	AnswerBox      string
 	AnswerItem     string
 
+	// AI Overview above the results.
+	AIOverview core.AISummarySelectors
+
+	// Sitelinks, breadcrumbs and rich snippet lines of organic results.
+	Extras core.ResultExtrasSelectors
+
 	// Image search.
 	ImageResults      string
 	ImageLink         string
 	ImageLinkFallback string
 	ImageTitle        []string
+
+	// News search (tbm=nws).
+	NewsResults   string
+	NewsLink      string
+	NewsTitle     string
+	NewsSnippet   string
+	NewsSource    string
+	NewsTime      string
+	NewsThumbnail string
+
+	// Video search (tbm=vid).
+	VideoResults     string
+	VideoLink        string
+	VideoTitle       string
+	VideoSnippet     string
+	VideoDuration    string
+	VideoAttribution string
+	VideoThumbnail   string
+
+	// Shopping search (tbm=shop) and inline product carousels.
+	ShoppingResults     string
+	ShoppingCard        core.ProductCardSelectors
+	ProductCarousel     string
+	ProductCarouselItem string
+	ProductCarouselCard core.ProductCardSelectors
+
+	// Local tab (tbm=lcl) and the local pack on regular result pages.
+	LocalPack    string
+	LocalResults string
+	LocalCard    core.LocalCardSelectors
+
+	// Knowledge panel beside (desktop) or above (mobile) the results.
+	KnowledgePanel core.KnowledgePanelSelectors
+
+	// Google Scholar (scholar.google.com).
+	ScholarCaptcha   string
+	ScholarNoResults string
+	ScholarResults   string
+	ScholarTitle     string
+	ScholarByline    string
+	ScholarSnippet   string
+	ScholarLinks     string
+	ScholarPDF       string
 }{
 	Captcha:     "[data-sitekey]",
 	CaptchaPage: "form#captcha-form, [data-sitekey], .g-recaptcha, script[src*='recaptcha']",
@@ -40,7 +97,15 @@ var Selectors = struct {
 	SoftBlock:   "noscript",
 	NoResults:   "#botstuff, #topstuff, .mnr-c",
 	ResultStats: "div#result-stats",
-	CookieBtn:   "div[role='dialog'][aria-modal] button",
+	// ResultTime is the "(0.25 seconds)" suffix inside ResultStats.
+	ResultTime: "nobr",
+	// SpellCorrected is the "Showing results for" link, whose text is the
+	// query Google actually ran; SpellSuggested is the "Did you mean" link,
+	// which shares its class but not its id.
+	SpellCorrected: "a#fprsl",
+	SpellSuggested: "a.gL9Hy:not(#fprsl)",
+	SearchBox:      "textarea[name='q'], input[name='q']",
+	CookieBtn:      "div[role='dialog'][aria-modal] button",
 	// Results targets the canonical organic result block. div.tF2Cxc is the
 	// stable per-result wrapper; the :not(:has(div.tF2Cxc)) guard keeps only the
 	// innermost block so nested knowledge-panel cards (which reuse tF2Cxc) don't
@@ -48,14 +113,58 @@ var Selectors = struct {
 	// attribute selector, kept as a fallback for SERP layouts without tF2Cxc.
 	Results:      "div.tF2Cxc:not(:has(div.tF2Cxc))",
 	ResultsBroad: "div[data-hveid][data-ved]",
-	Ad:           "div[data-text-ad], [data-text-ad]",
-	Link:         "a",
-	Title:        "h3",
-	DescPrimary:  "div[data-sncf='1'] div",
-	DescFallback: "div.VwiC3b",
-	DescAny:      "div",
-	AnswerBox:    "div[data-hveid][data-ulkwtsb] div[data-q]",
-	AnswerItem:   "a",
+	// ResultsMobile and TitleMobile match the mobile SERP, which drops
+	// tF2Cxc and renders each title as an ARIA heading inside the result link
+	// instead of an h3.
+	ResultsMobile: "div.MjjYud:has(a div[role='heading'][aria-level='3'])",
+	Ad:            "div[data-text-ad], [data-text-ad]",
+	Link:          "a",
+	Title:         "h3",
+	TitleMobile:   "div[role='heading'][aria-level='3']",
+	DescPrimary:   "div[data-sncf='1'] div",
+	DescFallback:  "div.VwiC3b",
+	DescAny:       "div",
+	AnswerBox:     "div[data-hveid][data-ulkwtsb] div[data-q]",
+	AnswerItem:    "a",
+
+	// AIOverview streams into the aimc subtree of a data-mcpr shell. The
+	// answer prose is the main-col column, whose children are its paragraphs,
+	// code blocks, lists and "Basic GET Request" headings. Inline citation
+	// chips read "MDN Web Docs +2"; the source cards (MFrAxb) repeat in an
+	// inline group and in the full "10 Websites" list. Streamed nodes get
+	// data-complete="true" once written.
+	AIOverview: core.AISummarySelectors{
+		Container:   "div[data-mcpr]:has(div[data-subtree='aimc'])",
+		Body:        "div[data-subtree='aimc'] div[data-container-id='main-col']",
+		Block:       "div[data-container-id='main-col'] > div > div",
+		Heading:     "[role='heading'][aria-level='3']",
+		Chip:        "span.WBgIic, span.DHPVt",
+		Source:      "div.MFrAxb[data-src-id]",
+		SourceLink:  "a.NDNGvf",
+		SourceTitle: "div.Nn35F",
+		SourceName:  "div.jEYmO",
+		Loading:     "div[data-subtree='aimc']:not([data-complete='true'])",
+		Pending:     "div[data-mcpr] div[data-streaming-container]",
+		ShowMore:    "div[role='button'][aria-controls='m-x-content'][aria-expanded='false']",
+	},
+
+	// Extras are looked up in the MjjYud wrapper, which also holds the
+	// sitelink table below a result. Inline sitelinks are a row of bare
+	// links; expanded ones are cards with their own h3 and snippet. The
+	// snippet date is "20.08.2025 —" and the rich line reads
+	// "Rating: 4.5 · 1,234 reviews · $19.99".
+	Extras: core.ResultExtrasSelectors{
+		Scope:           "div.MjjYud, div.g",
+		Sitelink:        "div.usJj9c, div.HiHjCd a",
+		SitelinkLink:    "h3 a, a",
+		SitelinkSnippet: "div.zz3gNc",
+		Breadcrumb:      "cite",
+		RichLine:        "div.fG8Fp",
+		Date:            "span.YrbPuc, span.LEwnzc",
+		FAQ:             "div.wQiwMc",
+		FAQQuestion:     "div.dnXCYb",
+		FAQAnswer:       "div.bCOlv",
+	},
 
 	// ImageResults selects each image cell in the image SERP grid.
 	ImageResults: "div[data-hveid][data-ved][jsaction]",
@@ -66,8 +175,113 @@ var Selectors = struct {
 	ImageLinkFallback: "a[href*='imgres']",
 	// ImageTitle selectors are tried in order to recover a human-readable title.
 	ImageTitle: []string{"h3", "a"},
+
+	// NewsResults selects one story card on the news tab. Each card is a
+	// single anchor wrapping the source badge, heading, snippet and age.
+	NewsResults:   "div.SoaBEf",
+	NewsLink:      "a.WlydOe",
+	NewsTitle:     "div.n0jPhd, div[role='heading']",
+	NewsSnippet:   "div.GI74Re",
+	NewsSource:    "div.MgUUmf span",
+	NewsTime:      "div.OSrXXb span, div.rbYSKb span",
+	NewsThumbnail: "img",
+
+	// VideoResults selects one clip card on the videos tab. The attribution
+	// line reads "YouTube · Channel · 3 weeks ago"; the platform label is
+	// dropped for videos hosted on the linked site itself.
+	VideoResults:     "div.MjjYud:has(h3)",
+	VideoLink:        "a:has(h3)",
+	VideoTitle:       "h3",
+	VideoSnippet:     "div.ITZIwc, div.VwiC3b",
+	VideoDuration:    "div.J1mWY, span.k1U36b",
+	VideoAttribution: "div.gqF9jc span",
+	VideoThumbnail:   "img",
+
+	// ShoppingResults selects one offer card in the shopping tab grid or list
+	// layout. Offer links point either at the merchant through a /url
+	// redirect or at Google's own /shopping/product comparison page.
+	ShoppingResults: "div.sh-dgr__content, div.sh-dlr__list-result",
+	ShoppingCard: core.ProductCardSelectors{
+		Link:      "a.shntl[href]",
+		Title:     "h3.tAxDx, h3",
+		Price:     "span.a8Pemb",
+		Merchant:  "div.aULzUe, div.IuHnof",
+		Rating:    "span.Rsc7Yb",
+		Reviews:   "span.QIrs8, span.NzUzee",
+		Thumbnail: "div.ArOc1c img, img",
+	},
+	// ProductCarousel is the sponsored product strip above organic results;
+	// each pla-unit card is one offer.
+	ProductCarousel:     "div.cu-container, div.commercial-unit-desktop-top",
+	ProductCarouselItem: "div.pla-unit",
+	ProductCarouselCard: core.ProductCardSelectors{
+		Link:      "a.pla-unit-single-clickable-target, a.clickable-card",
+		Title:     "div.pymv4e, span.pymv4e, div.orXoSd",
+		Price:     "div.e10twf, span.e10twf",
+		Merchant:  "div.LbUacb span, div.zPEcBd",
+		Rating:    "span.z3HNkc",
+		Reviews:   "span.fl.nbvhM, span.pbAs0b",
+		Thumbnail: "img",
+	},
+
+	// LocalResults selects one business card; the local tab and the pack on
+	// regular result pages share the markup. Each rllt__details line holds
+	// "4.6(1.2K) · $$ · Coffee shop", the address, the hours or the phone,
+	// in an order that varies by business type.
+	LocalPack:    "div.rlfl__tls",
+	LocalResults: "div.VkpGBb",
+	LocalCard: core.LocalCardSelectors{
+		Name:    "div.dbg0pd, div[role='heading']",
+		Rating:  "span.yi40Hd",
+		Reviews: "span.RDApEe",
+		Details: "div.rllt__details > div:not(.dbg0pd)",
+		Website: "a.yYlJEf.Q7PwXb, a[aria-label='Website']",
+		MapLink: "a.vwVdIc, a[href*='/maps/place/']",
+	},
+
+	// KnowledgePanel fields are keyed by data-attrid. Fact rows use kc:/ or
+	// ss:/ ids and hold a "Born: " label span followed by the value; the
+	// social profiles row is also kc:/, but has no label span.
+	KnowledgePanel: core.KnowledgePanelSelectors{
+		Container:   "div.kp-wholepage, div.kp-wholepage-osrp, div.knowledge-panel",
+		Title:       "[data-attrid='title']",
+		EntityType:  "[data-attrid='subtitle']",
+		Description: "div[data-attrid='description'] > span:last-child, div.kno-rdesc > span:last-child",
+		Source:      "div[data-attrid='description'] a[href], div.kno-rdesc a[href]",
+		Attribute:   "div[data-attrid^='kc:/']:has(span.w8qArf), div[data-attrid^='ss:/']:has(span.w8qArf)",
+		Label:       "span.w8qArf",
+		Value:       "span.LrzXr, span.kno-fv",
+		Image:       "div[data-attrid='image'] img, g-img img",
+		Website:     "div[data-attrid='visit_official_site'] a[href]",
+		Profile:     "div[data-attrid='kc:/common/topic:social media presence'] a[href]",
+	},
+
+	// ScholarByline is "Authors - Venue, Year - host"; ScholarLinks is the
+	// footer row whose "Cited by N" and "All N versions" links are told apart
+	// by their cites= and cluster= parameters. ScholarPDF is the [PDF] side
+	// link, present only when Scholar found a full-text copy.
+	ScholarCaptcha:   "#gs_captcha_ccl, form#gs_captcha_f",
+	ScholarNoResults: "#gs_res_ccl_mid .gs_med, #gs_res_ccl .gs_med",
+	ScholarResults:   "div.gs_r.gs_or.gs_scl",
+	ScholarTitle:     "h3.gs_rt a",
+	ScholarByline:    "div.gs_a",
+	ScholarSnippet:   "div.gs_rs",
+	ScholarLinks:     "div.gs_fl a",
+	ScholarPDF:       "div.gs_or_ggsm a",
 }
 
-func searchResultSelectors() []string {
+// searchResultSelectors lists the organic result selectors in the order they
+// are tried; the mobile layout is tried first for device=mobile.
+func searchResultSelectors(mobile bool) []string {
+	if mobile {
+		return []string{Selectors.ResultsMobile, Selectors.Results, Selectors.ResultsBroad}
+	}
 	return []string{Selectors.Results, Selectors.ResultsBroad}
 }
+
+// SupportsDevice reports whether Google results can be parsed from device's
+// SERP on vertical. The mobile selectors (ResultsMobile, TitleMobile) cover
+// web search only.
+func SupportsDevice(device core.Device, vertical core.Vertical) bool {
+	return device != core.DeviceMobile || vertical == core.VerticalWeb
+}
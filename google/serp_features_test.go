@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
 )
 
 // TestParseHTMLFixtureExtractsRealFeatures guards the live AI Overview fixture.
@@ -49,6 +50,84 @@ func TestParseHTMLFixtureExtractsRealFeatures(t *testing.T) {
 	}
 }
 
+func TestParseHTMLFixtureAIOverviewSectionsAndCitations(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if results[0].SerpMeta == nil || results[0].SerpMeta.AISummary != core.AISummaryPresent {
+		t.Fatalf("expected the AI Overview to be reported present, got %+v", results[0].SerpMeta)
+	}
+	var overview *core.SerpFeature
+	for i := range results[0].Features {
+		if results[0].Features[i].Type == core.ResultTypeAISummary {
+			overview = &results[0].Features[i]
+		}
+	}
+	if overview == nil {
+		t.Fatal("expected an ai_summary feature")
+	}
+	if strings.Contains(overview.Text, "MDN Web Docs+2") || strings.Contains(overview.Text, "10 Websites") {
+		t.Fatalf("ai_summary text must leave out citation chips and the source list: %q", overview.Text)
+	}
+
+	var headings []string
+	for _, item := range overview.Items {
+		if item.Title != "" {
+			headings = append(headings, item.Title)
+		}
+	}
+	if strings.Join(headings, "|") != "Basic GET Request|POST Request with Options|Key Components" {
+		t.Fatalf("unexpected sections: %q", headings)
+	}
+	if !strings.HasPrefix(overview.Items[0].Text, "To fetch data in JavaScript") {
+		t.Fatalf("expected the lead paragraph as the untitled first section, got %+v", overview.Items[0])
+	}
+
+	// The three cards shown inline repeat in the full source list.
+	if len(overview.Links) != 12 {
+		t.Fatalf("expected 12 cited sources, got %d: %+v", len(overview.Links), overview.Links)
+	}
+	first, last := overview.Links[0], overview.Links[11]
+	if first.Title != "Using the Fetch API - MDN Web Docs" || first.Source != "MDN Web Docs" || first.Position != 1 {
+		t.Fatalf("unexpected first citation: %+v", first)
+	}
+	if last.Source != "Medium" || last.Position != 12 {
+		t.Fatalf("unexpected last citation: %+v", last)
+	}
+}
+
+func TestExtractGoogleAIOverviewStatus(t *testing.T) {
+	t.Parallel()
+
+	loading := `<div data-mcpr=""><div data-streaming-container=""><div data-subtree="aimc"><div data-container-id="main-col"><div><div>Fetch starts a</div></div></div></div></div></div>`
+	overview, status := extractGoogleAIOverview(mustDoc(t, loading))
+	if status != core.AISummaryLoading || overview == nil || overview.Text != "Fetch starts a" {
+		t.Fatalf("streaming overview: status = %q, feature = %+v", status, overview)
+	}
+
+	shell := `<div data-mcpr=""><div data-streaming-container=""></div></div>`
+	if overview, status := extractGoogleAIOverview(mustDoc(t, shell)); status != core.AISummaryLoading || overview != nil {
+		t.Fatalf("empty shell: status = %q, feature = %+v", status, overview)
+	}
+
+	placeholder := `<div data-mcpr=""><div data-subtree="aimc" data-complete="true"><div data-container-id="main-col"><div><div>An AI Overview is not available for this search</div></div></div></div></div>`
+	if overview, status := extractGoogleAIOverview(mustDoc(t, placeholder)); status != core.AISummaryAbsent || overview != nil {
+		t.Fatalf("placeholder: status = %q, feature = %+v", status, overview)
+	}
+
+	if _, status := extractGoogleAIOverview(mustDoc(t, `<div class="g">Organic</div>`)); status != core.AISummaryAbsent {
+		t.Fatalf("no overview: status = %q", status)
+	}
+}
+
 func TestParseHTMLExtractsSerpFeatures(t *testing.T) {
 	t.Parallel()
 
@@ -113,3 +192,121 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsKnowledgePanel(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(testutil.ResponseFromFixture(t, "knowledge_panel.html").Body)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var panel *core.SerpFeature
+	for _, result := range results {
+		for i := range result.Features {
+			if result.Features[i].Type == core.ResultTypeKnowledgePanel {
+				panel = &result.Features[i]
+			}
+		}
+	}
+	if panel == nil || panel.Knowledge == nil {
+		t.Fatalf("expected knowledge panel feature in %#v", results)
+	}
+	kp := panel.Knowledge
+	if panel.Title != "OpenAI" || kp.EntityType != "Artificial intelligence company" {
+		t.Fatalf("unexpected panel header: %q %q", panel.Title, kp.EntityType)
+	}
+	if !strings.HasPrefix(panel.Text, "OpenAI is an American") || !strings.HasSuffix(panel.Text, "San Francisco, California.") {
+		t.Fatalf("expected description without its source label, got %q", panel.Text)
+	}
+	if kp.DescriptionSource != "Wikipedia" || kp.DescriptionURL != "https://en.wikipedia.org/wiki/OpenAI" {
+		t.Fatalf("unexpected description source: %+v", kp)
+	}
+	if len(panel.Links) != 1 || panel.Links[0].URL != kp.DescriptionURL {
+		t.Fatalf("expected the description source as the feature link, got %+v", panel.Links)
+	}
+	want := []core.KnowledgeAttribute{
+		{Label: "CEO", Value: "Sam Altman (Nov 22, 2023–)"},
+		{Label: "Founded", Value: "December 11, 2015, San Francisco, California, United States"},
+		{Label: "Headquarters", Value: "San Francisco, California, United States"},
+		{Label: "Number of employees", Value: "3,000 (2025)"},
+	}
+	if len(kp.Attributes) != len(want) {
+		t.Fatalf("expected %d attributes, got %+v", len(want), kp.Attributes)
+	}
+	for i := range want {
+		if kp.Attributes[i] != want[i] {
+			t.Fatalf("attribute %d = %+v, want %+v", i, kp.Attributes[i], want[i])
+		}
+	}
+	if len(kp.Images) != 2 || kp.Images[0] != "https://encrypted-tbn0.gstatic.com/images?q=tbn:openai-logo" {
+		t.Fatalf("expected lazy image source and no inline data URIs, got %v", kp.Images)
+	}
+	if kp.Website != "https://openai.com/" {
+		t.Fatalf("unexpected website: %q", kp.Website)
+	}
+	wantProfiles := []core.KnowledgeProfile{
+		{Network: "Twitter", URL: "https://twitter.com/OpenAI"},
+		{Network: "LinkedIn", URL: "https://www.linkedin.com/company/openai"},
+		{Network: "YouTube", URL: "https://www.youtube.com/openai"},
+	}
+	if len(kp.Profiles) != len(wantProfiles) {
+		t.Fatalf("unexpected profiles: %+v", kp.Profiles)
+	}
+	for i := range wantProfiles {
+		if kp.Profiles[i] != wantProfiles[i] {
+			t.Fatalf("profile %d = %+v, want %+v", i, kp.Profiles[i], wantProfiles[i])
+		}
+	}
+}
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) == 0 || results[0].RichSnippet == nil || results[0].RichSnippet.Date != "20.08.2025" {
+		t.Fatalf("expected the snippet date of the first fixture result, got %+v", results)
+	}
+	if results[0].Breadcrumbs != nil {
+		t.Fatalf("an elided cite is not a path, got %q", results[0].Breadcrumbs)
+	}
+
+	html := `
+<div class="MjjYud">
+  <div class="tF2Cxc">
+    <a href="https://go.dev/"><h3>The Go Programming Language</h3><cite>https://go.dev › learn</cite></a>
+    <div data-sncf="1"><div>Build simple, secure, scalable systems with Go.</div></div>
+    <div class="fG8Fp">Rating: 4.8 · 2,150 reviews · Free</div>
+  </div>
+  <div class="HiHjCd">
+    <a href="/url?q=https://go.dev/doc/">Documentation</a> ·
+    <a href="https://go.dev/dl/">Download</a> ·
+    <a href="https://go.dev/">Home</a>
+  </div>
+</div>`
+
+	results, err = ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected one organic result, got %+v", results)
+	}
+	res := results[0]
+	if len(res.Sitelinks) != 2 || res.Sitelinks[0].URL != "https://go.dev/doc/" || res.Sitelinks[1].Title != "Download" {
+		t.Fatalf("unexpected sitelinks: %+v", res.Sitelinks)
+	}
+	if len(res.Breadcrumbs) != 2 || res.Breadcrumbs[1] != "learn" {
+		t.Fatalf("unexpected breadcrumbs: %q", res.Breadcrumbs)
+	}
+	if res.RichSnippet == nil || res.RichSnippet.Rating != 4.8 || res.RichSnippet.ReviewCount != 2150 {
+		t.Fatalf("unexpected rich snippet: %+v", res.RichSnippet)
+	}
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseGoogleSerpMeta reads the result estimate, timing, spelling correction,
+// AI Overview status and locale around the organic results.
+func parseGoogleSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{}
+
+	stats := doc.Find(Selectors.ResultStats).First()
+	timing := stats.Find(Selectors.ResultTime)
+	meta.SearchTimeSeconds = core.ParseSearchTime(timing.Text())
+	// The timing suffix is a child of the stats div; drop it so its digits
+	// don't run into the count.
+	countText := strings.TrimSuffix(stats.Text(), timing.Text())
+	meta.TotalResults = core.ParseResultCount(countText)
+
+	meta.CorrectedQuery = strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text())
+	meta.SuggestedQuery = strings.TrimSpace(doc.Find(Selectors.SpellSuggested).First().Text())
+	_, meta.AISummary = extractGoogleAIOverview(doc)
+
+	searchBox := doc.Find(Selectors.SearchBox).First()
+	searchBoxQuery := searchBox.AttrOr("value", "")
+	if searchBoxQuery == "" {
+		searchBoxQuery = searchBox.Text()
+	}
+	return core.FinishSerpMeta(doc, meta, searchBoxQuery)
+}
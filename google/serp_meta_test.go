@@ -0,0 +1,81 @@
+package google
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestParseHTMLAttachesSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.TotalResults != 114000000 {
+		t.Errorf("TotalResults = %d, want 114000000", meta.TotalResults)
+	}
+	if meta.SearchTimeSeconds != 0.25 {
+		t.Errorf("SearchTimeSeconds = %v, want 0.25", meta.SearchTimeSeconds)
+	}
+	if meta.EffectiveQuery != "how to fetch in JS" {
+		t.Errorf("EffectiveQuery = %q", meta.EffectiveQuery)
+	}
+	if meta.Locale != "de-RU" {
+		t.Errorf("Locale = %q, want de-RU", meta.Locale)
+	}
+	for _, r := range results[1:] {
+		if r.SerpMeta != nil {
+			t.Fatalf("serp meta attached beyond first result: %+v", r)
+		}
+	}
+}
+
+func TestParseGoogleSerpMetaSpelling(t *testing.T) {
+	t.Parallel()
+
+	corrected := `<html lang="en"><body>
+<textarea name="q">pyhton tutorial</textarea>
+<p>Showing results for <a id="fprsl" class="gL9Hy" href="/search?q=python+tutorial"><b><i>python</i></b> tutorial</a></p>
+</body></html>`
+	meta := parseGoogleSerpMeta(mustDoc(t, corrected))
+	if meta.CorrectedQuery != "python tutorial" || meta.EffectiveQuery != "python tutorial" {
+		t.Fatalf("corrected meta = %+v", meta)
+	}
+	if meta.SuggestedQuery != "" {
+		t.Fatalf("correction misread as suggestion: %+v", meta)
+	}
+
+	suggested := `<html lang="en"><body>
+<textarea name="q">pyhton tutorial</textarea>
+<p class="gqLncc">Did you mean: <a class="gL9Hy" href="/search?q=python+tutorial"><b><i>python</i></b> tutorial</a></p>
+</body></html>`
+	meta = parseGoogleSerpMeta(mustDoc(t, suggested))
+	if meta.SuggestedQuery != "python tutorial" || meta.CorrectedQuery != "" {
+		t.Fatalf("suggested meta = %+v", meta)
+	}
+	if meta.EffectiveQuery != "pyhton tutorial" {
+		t.Fatalf("EffectiveQuery = %q, want the query as typed", meta.EffectiveQuery)
+	}
+}
+
+func mustDoc(t *testing.T, html string) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("parse html: %v", err)
+	}
+	return doc
+}
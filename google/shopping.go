@@ -0,0 +1,165 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseShoppingHTML parses a Google Shopping tab (tbm=shop) HTML document.
+func ParseShoppingHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyGoogleDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseGoogleShoppingDocument(doc, 0), nil
+}
+
+// parseGoogleShoppingDocument extracts offer cards from the shopping tab.
+func parseGoogleShoppingDocument(doc *goquery.Document, start int) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.ShoppingResults).Each(func(_ int, card *goquery.Selection) {
+		title, link, product, ok := core.ParseProductCard(card, Selectors.ShoppingCard, googleResolveHref)
+		if !ok {
+			return
+		}
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          link,
+			Title:        title,
+			Product:      product,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// googleResolveHref resolves offer and listing links. Outbound links go
+// through /url with the target in "url" (or "q"); product comparison and map
+// pages are relative.
+func googleResolveHref(href string) string {
+	switch {
+	case strings.HasPrefix(href, "/url?"):
+		u, err := url.Parse(href)
+		if err != nil {
+			return ""
+		}
+		if target := u.Query().Get("url"); target != "" {
+			return target
+		}
+		return u.Query().Get("q")
+	case strings.HasPrefix(href, "/"):
+		return "https://www.google.com" + href
+	}
+	return href
+}
+
+// extractGoogleProductCarousel captures the sponsored product strip shown on
+// regular result pages for commercial queries.
+func extractGoogleProductCarousel(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractProductCarousel(doc, Selectors.ProductCarousel, Selectors.ProductCarouselItem, Selectors.ProductCarouselCard, googleResolveHref)
+}
+
+// SearchShopping runs a raw HTTP request against the Google Shopping tab.
+func SearchShopping(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	shoppingURL, err := BuildShoppingURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", shoppingURL).Debug(fmt.Sprintf("Google Shopping URL built: %s", shoppingURL))
+
+	res, err := core.RawSearchRequest(ctx, shoppingURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyGoogleDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseGoogleShoppingDocument(doc, query.Start)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: google shopping returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchShopping executes a Google Shopping tab search in the browser.
+func (gogl *Google) SearchShopping(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, gogl.Name(), true)
+	scoped := *gogl
+	scoped.logger = gogl.logger.WithRequest(ctx)
+	gogl = &scoped
+
+	gogl.logger.Debug("Starting shopping search, query: %+v", query)
+	u, err := BuildShoppingURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gogl.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer gogl.close(ctx, page)
+
+	waitFor := []string{Selectors.ShoppingResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, gogl.GetSelectorTimeout()); err != nil {
+		if pageErr := gogl.classifyPage(page, query.ProxyURL); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			gogl.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyGoogleDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseGoogleShoppingDocument(doc, query.Start)
+	gogl.logger.Info("Shopping search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
@@ -0,0 +1,84 @@
+package google
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestGoogleParseShoppingDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "shopping_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseGoogleShoppingDocument(doc, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 shopping results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	if first.URL != "https://www.bestbuy.com/site/google-pixel-8-128gb/6559316.p" || first.Product == nil {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	want := core.ProductMeta{Price: 499, Currency: "USD", Merchant: "Best Buy", Rating: 4.6, ReviewCount: 2431, Thumbnail: "https://encrypted-tbn0.gstatic.com/shopping?q=tbn:pixel8"}
+	if *first.Product != want {
+		t.Fatalf("unexpected product metadata: %+v", first.Product)
+	}
+
+	second := results[1]
+	if !strings.HasPrefix(second.URL, "https://www.google.com/shopping/product/1234567890") {
+		t.Fatalf("expected comparison page link to be made absolute, got %q", second.URL)
+	}
+	if second.Product.Price != 799.99 || second.Product.Merchant != "Amazon.com" || second.Product.Thumbnail != "" {
+		t.Fatalf("unexpected second product metadata: %+v", second.Product)
+	}
+}
+
+func TestGoogleProductCarouselFeature(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "shopping_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	features := extractGoogleProductCarousel(doc)
+	if len(features) != 1 || len(features[0].Items) != 1 {
+		t.Fatalf("expected one product carousel, got %+v", features)
+	}
+	item := features[0].Items[0]
+	if features[0].Type != core.ResultTypeShopping || item.Link != "https://store.google.com/product/pixel_8" {
+		t.Fatalf("unexpected carousel item: %+v", item)
+	}
+	if item.Product == nil || item.Product.Price != 699 || item.Product.Merchant != "Google Store" {
+		t.Fatalf("unexpected carousel product: %+v", item.Product)
+	}
+}
+
+func TestGoogleParseShoppingHTMLCaptcha(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseShoppingHTML(testutil.ResponseFromFixture(t, "search_captcha.html").Body); !errors.Is(err, core.ErrCaptcha) {
+		t.Fatalf("expected captcha error, got %v", err)
+	}
+}
+
+func TestGoogleBuildShoppingURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildShoppingURL(core.Query{Text: "pixel 8", LangCode: "en", Start: 20})
+	if err != nil {
+		t.Fatalf("BuildShoppingURL() error = %v", err)
+	}
+	for _, want := range []string{"tbm=shop", "q=pixel+8", "start=20"} {
+		if !strings.Contains(u, want) {
+			t.Fatalf("expected %q in %s", want, u)
+		}
+	}
+}
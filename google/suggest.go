@@ -0,0 +1,21 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches Google's query completions for query.Text, localized by
+// hl/gl.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("Google suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, core.ParseOpenSearchSuggestions)
+}
@@ -0,0 +1,31 @@
+package google
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestGoogleBuildSuggestURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildSuggestURL(core.Query{Text: "golang", LangCode: "de"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Host != "suggestqueries.google.com" || params.Get("q") != "golang" || params.Get("client") != "firefox" {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+	if params.Get("hl") != "de" || params.Get("gl") != "de" {
+		t.Fatalf("expected locale params, got %s", u)
+	}
+	if _, err := BuildSuggestURL(core.Query{Site: "go.dev"}); err == nil {
+		t.Fatal("expected empty text to fail")
+	}
+}
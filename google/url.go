@@ -226,6 +226,48 @@ var googleDefaultCountryByLanguage = map[string]string{
 	"ko": "kr",
 }
 
+// Operators is Google's spelling of the structured query operators.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	InURL:       "inurl:",
+	Or:          " OR ",
+}
+
+// googleSafe maps SafeSearch levels to the safe= parameter. Moderate is
+// Google's default (explicit results blurred), so it sends nothing.
+var googleSafe = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "off",
+	core.SafeSearchModerate: "",
+	core.SafeSearchStrict:   "active",
+}
+
+// SupportsSafeSearch reports whether Google URLs can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := googleSafe[level]
+	return ok || level == ""
+}
+
+// SupportsVerbatim reports whether Google can run a verbatim query on
+// vertical. Every tbm tab takes the switches; Scholar has none.
+func SupportsVerbatim(vertical core.Vertical) bool {
+	return vertical != core.VerticalScholar
+}
+
+// addGoogleVerbatim adds Google's verbatim switches when q asks for them:
+// nfpr=1 is "Search instead for" (no auto-correction) and tbs=li:1 is the
+// Verbatim tool (no synonyms or term rewriting). It returns tbs with li:1
+// appended.
+func addGoogleVerbatim(params url.Values, tbs []string, q core.Query) []string {
+	if !q.Verbatim {
+		return tbs
+	}
+	params.Add("nfpr", "1")
+	return append(tbs, "li:1")
+}
+
 // BuildURL builds a Google web search URL from Query fields.
 // It returns an error when the resulting query text is empty or invalid.
 func BuildURL(q core.Query) (string, error) {
@@ -240,7 +282,7 @@ func BuildURL(q core.Query) (string, error) {
 	params := url.Values{}
 
 	// Set request text
-	if q.Text != "" || q.Site != "" || q.Filetype != "" {
+	if !q.IsEmpty() {
 		text := q.Text
 		if q.Site != "" {
 			text += " site:" + q.Site
@@ -248,6 +290,8 @@ func BuildURL(q core.Query) (string, error) {
 		if q.Filetype != "" {
 			text += " filetype:" + q.Filetype
 		}
+		operators, _ := Operators.Compile(q)
+		text += operators
 
 		logrus.WithField("query_hash", core.QueryHash(text)).Trace(fmt.Sprintf("Query text: %s", text))
 		params.Add("q", text)
@@ -258,15 +302,20 @@ func BuildURL(q core.Query) (string, error) {
 		return "", errors.New("empty query built")
 	}
 
-	// Set search date range
+	// Set search date range; tbs also carries the verbatim switch, so its
+	// parts are collected and joined.
+	var tbs []string
 	if q.DateInterval != "" {
 		intervals := strings.Split(q.DateInterval, "..")
 		if len(intervals) != 2 {
 			return "", errors.New("incorrect date interval provided")
 		}
 
-		dataParam := fmt.Sprintf("cdr:1,cd_min:%s,cd_max:%s", intervals[0], intervals[1])
-		params.Add("tbs", dataParam)
+		tbs = append(tbs, fmt.Sprintf("cdr:1,cd_min:%s,cd_max:%s", intervals[0], intervals[1]))
+	}
+	tbs = addGoogleVerbatim(params, tbs, q)
+	if len(tbs) > 0 {
+		params.Add("tbs", strings.Join(tbs, ","))
 	}
 
 	// Limit number of results
@@ -298,6 +347,9 @@ func BuildURL(q core.Query) (string, error) {
 		params.Add("lr", "lang_"+locale.language)
 	}
 
+	if safe := googleSafe[q.SafeSearch]; safe != "" {
+		params.Add("safe", safe)
+	}
 	params.Add("pws", "0") // Do not personalize search results
 	params.Add("sourceid", "chrome")
 	params.Add("ie", "UTF-8")
@@ -339,15 +391,19 @@ func BuildImageURL(q core.Query) (string, error) {
 		return "", errors.New("empty query built")
 	}
 
-	// Set search date range
+	// Set search date range; tbs also carries the verbatim switch.
+	var tbs []string
 	if q.DateInterval != "" {
 		intervals := strings.Split(q.DateInterval, "..")
 		if len(intervals) != 2 {
 			return "", errors.New("incorrect date interval provided")
 		}
 
-		dataParam := fmt.Sprintf("cdr:1,cd_min:%s,cd_max:%s", intervals[0], intervals[1])
-		params.Add("tbs", dataParam)
+		tbs = append(tbs, fmt.Sprintf("cdr:1,cd_min:%s,cd_max:%s", intervals[0], intervals[1]))
+	}
+	tbs = addGoogleVerbatim(params, tbs, q)
+	if len(tbs) > 0 {
+		params.Add("tbs", strings.Join(tbs, ","))
 	}
 
 	// Limit number of results
@@ -366,6 +422,150 @@ func BuildImageURL(q core.Query) (string, error) {
 		params.Add("lr", "lang_"+locale.language)
 	}
 
+	if safe := googleSafe[q.SafeSearch]; safe != "" {
+		params.Add("safe", safe)
+	}
+	params.Add("pws", "0") // Do not personalize search results
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildNewsURL builds a Google News tab (tbm=nws) search URL from Query fields.
+// It returns an error when the resulting query text is empty or invalid.
+func BuildNewsURL(q core.Query) (string, error) {
+	return buildTabURL(q, "nws")
+}
+
+// BuildVideoURL builds a Google Videos tab (tbm=vid) search URL from Query
+// fields. It returns an error when the resulting query text is empty or invalid.
+func BuildVideoURL(q core.Query) (string, error) {
+	return buildTabURL(q, "vid")
+}
+
+// BuildShoppingURL builds a Google Shopping tab (tbm=shop) search URL from
+// Query fields. It returns an error when the resulting query text is empty or
+// invalid.
+func BuildShoppingURL(q core.Query) (string, error) {
+	return buildTabURL(q, "shop")
+}
+
+// BuildLocalURL builds a Google local results tab (tbm=lcl) search URL from
+// Query fields. Region sets gl, which anchors the results when the query
+// names no place.
+func BuildLocalURL(q core.Query) (string, error) {
+	return buildTabURL(q, "lcl")
+}
+
+// BuildScholarURL builds a Google Scholar search URL from Query fields.
+// DateInterval is reduced to the publication year range Scholar filters by
+// (as_ylo/as_yhi). It returns an error when the query text is empty or the
+// interval is malformed.
+func BuildScholarURL(q core.Query) (string, error) {
+	base, _ := url.Parse("https://scholar.google.com/scholar")
+
+	params := url.Values{}
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("q", text)
+	}
+	if len(params.Get("q")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	if q.DateInterval != "" {
+		from, to, err := core.ScholarYearRange(q.DateInterval)
+		if err != nil {
+			return "", err
+		}
+		params.Add("as_ylo", strconv.Itoa(from))
+		params.Add("as_yhi", strconv.Itoa(to))
+	}
+
+	// Scholar pages hold at most 20 results.
+	if q.Limit > 10 {
+		params.Add("num", strconv.Itoa(min(q.Limit, 20)))
+	}
+	if q.Start < 0 {
+		return "", errors.New("incorrect start param provided")
+	}
+	if q.Start > 0 {
+		params.Add("start", strconv.Itoa(q.Start))
+	}
+
+	if locale := googleLocale(q.LangCode, q.Region); locale.language != "" {
+		params.Add("hl", locale.language)
+	}
+	params.Add("as_sdt", "0,5") // Articles, including patents
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// buildTabURL builds a search URL for a vertical tab selected by tbm. The news
+// and video tabs accept the same paging, date and locale parameters.
+func buildTabURL(q core.Query, tbm string) (string, error) {
+	locale := googleLocale(q.LangCode, q.Region)
+	googleBase := googleDomain(locale)
+	base, err := url.Parse(fmt.Sprintf("https://www.google.%s", googleBase))
+	if err != nil {
+		return "", err
+	}
+
+	base.Path += "search"
+	params := url.Values{}
+	params.Add("tbm", tbm)
+
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("q", text)
+	}
+
+	if len(params.Get("q")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	// Set search date range; tbs also carries the verbatim switch.
+	var tbs []string
+	if q.DateInterval != "" {
+		intervals := strings.Split(q.DateInterval, "..")
+		if len(intervals) != 2 {
+			return "", errors.New("incorrect date interval provided")
+		}
+
+		tbs = append(tbs, fmt.Sprintf("cdr:1,cd_min:%s,cd_max:%s", intervals[0], intervals[1]))
+	}
+	tbs = addGoogleVerbatim(params, tbs, q)
+	if len(tbs) > 0 {
+		params.Add("tbs", strings.Join(tbs, ","))
+	}
+
+	if q.Limit > 10 {
+		params.Add("num", strconv.Itoa(q.Limit))
+	}
+	if q.Start < 0 {
+		return "", errors.New("incorrect start param provided")
+	}
+	if q.Start > 0 {
+		params.Add("start", strconv.Itoa(q.Start))
+	}
+
+	if locale.country != "" {
+		params.Add("gl", locale.country)
+	}
+	if locale.language != "" {
+		params.Add("hl", locale.language)
+	}
+
+	if safe := googleSafe[q.SafeSearch]; safe != "" {
+		params.Add("safe", safe)
+	}
 	params.Add("pws", "0") // Do not personalize search results
 
 	base.RawQuery = params.Encode()
@@ -480,3 +680,26 @@ func parseSourceImageURL(href string) (SourceImage, error) {
 
 	return source, nil
 }
+
+// BuildSuggestURL builds a Google autocomplete URL for q.Text. client=firefox
+// selects the plain OpenSearch JSON format; ie/oe keep non-Latin suggestions
+// in UTF-8.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params := url.Values{}
+	params.Add("client", "firefox")
+	params.Add("q", q.Text)
+	params.Add("ie", "utf-8")
+	params.Add("oe", "utf-8")
+
+	locale := googleLocale(q.LangCode, q.Region)
+	if locale.language != "" {
+		params.Add("hl", locale.language)
+	}
+	if locale.country != "" {
+		params.Add("gl", locale.country)
+	}
+	return "https://suggestqueries.google.com/complete/search?" + params.Encode(), nil
+}
@@ -0,0 +1,176 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseVideosHTML parses a Google Videos tab (tbm=vid) HTML document.
+func ParseVideosHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyGoogleDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseGoogleVideoDocument(doc, 0, time.Now()), nil
+}
+
+// parseGoogleVideoDocument extracts clip cards from the videos tab.
+func parseGoogleVideoDocument(doc *goquery.Document, start int, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankStateAt(start, start+1)
+
+	doc.Find(Selectors.VideoResults).Each(func(_ int, card *goquery.Selection) {
+		href := unwrapGoogleRedirect(strings.TrimSpace(card.Find(Selectors.VideoLink).First().AttrOr("href", "")))
+		title := core.NormalizeWhitespace(card.Find(Selectors.VideoTitle).First().Text())
+		if !strings.HasPrefix(href, "http") || title == "" {
+			return
+		}
+
+		meta := googleVideoAttribution(card.Find(Selectors.VideoAttribution), now)
+		if d, ok := core.ParseVideoDuration(card.Find(Selectors.VideoDuration).First().Text()); ok {
+			meta.Duration = d
+		}
+		if src := card.Find(Selectors.VideoThumbnail).First().AttrOr("src", ""); strings.HasPrefix(src, "http") {
+			meta.Thumbnail = src
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.VideoSnippet).First().Text()),
+			Video:        meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// googleVideoAttribution splits the "Platform · Channel · date" line. Parts
+// that parse as a date become PublishedAt; of the rest, the last one is the
+// channel, since the platform label always comes first.
+func googleVideoAttribution(spans *goquery.Selection, now time.Time) *core.VideoMeta {
+	meta := &core.VideoMeta{}
+	var labels []string
+	for _, part := range strings.Split(spans.Text(), "·") {
+		part = core.NormalizeWhitespace(part)
+		if part == "" {
+			continue
+		}
+		if published, ok := core.ParsePublishedTime(part, now); ok && meta.PublishedAt.IsZero() {
+			meta.PublishedAt = published
+			continue
+		}
+		labels = append(labels, part)
+	}
+	if len(labels) > 0 {
+		meta.Channel = labels[len(labels)-1]
+	}
+	if len(labels) > 1 {
+		meta.Platform = strings.ToLower(labels[0])
+	}
+	return meta
+}
+
+// SearchVideos runs a raw HTTP request against the Google Videos tab.
+func SearchVideos(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "google", false)
+
+	videoURL, err := BuildVideoURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", videoURL).Debug(fmt.Sprintf("Google Videos URL built: %s", videoURL))
+
+	res, err := core.RawSearchRequest(ctx, videoURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyGoogleDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseGoogleVideoDocument(doc, query.Start, time.Now())
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: google videos returned no parseable results", core.ErrParser)
+	}
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
+
+// SearchVideos executes a Google Videos tab search in the browser.
+func (gogl *Google) SearchVideos(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, gogl.Name(), true)
+	scoped := *gogl
+	scoped.logger = gogl.logger.WithRequest(ctx)
+	gogl = &scoped
+
+	gogl.logger.Debug("Starting video search, query: %+v", query)
+	u, err := BuildVideoURL(query)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gogl.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer gogl.close(ctx, page)
+
+	waitFor := []string{Selectors.VideoResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, gogl.GetSelectorTimeout()); err != nil {
+		if pageErr := gogl.classifyPage(page, query.ProxyURL); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			gogl.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyGoogleDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseGoogleVideoDocument(doc, query.Start, time.Now())
+	gogl.logger.Info("Video search completed: %d results", len(results))
+	return core.LimitOrganicResults(results, query.Limit), nil
+}
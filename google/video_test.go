@@ -0,0 +1,70 @@
+package google
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestGoogleParseVideoDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "video_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseGoogleVideoDocument(doc, 0, now)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 video results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	if first.URL != "https://www.youtube.com/watch?v=f6kdp27TYZs" || first.Video == nil {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if first.Video.Duration != 51*time.Minute+27*time.Second || first.Video.Channel != "Google for Developers" {
+		t.Fatalf("unexpected video metadata: %+v", first.Video)
+	}
+	if first.Video.Platform != "youtube" || first.Video.PublishedAt.Year() != 2012 {
+		t.Fatalf("expected platform and upload date from attribution, got %+v", first.Video)
+	}
+	if !strings.HasPrefix(first.Video.Thumbnail, "https://i.ytimg.com/") {
+		t.Fatalf("expected thumbnail URL, got %q", first.Video.Thumbnail)
+	}
+	if results[1].Video.Thumbnail != "" || !results[1].Video.PublishedAt.Equal(now.AddDate(0, 0, -21)) {
+		t.Fatalf("unexpected second video metadata: %+v", results[1].Video)
+	}
+	last := results[2]
+	if last.URL != "https://go.dev/talks/2013/advconc.slide" || last.Video.Channel != "go.dev" || last.Video.Platform != "" {
+		t.Fatalf("unexpected third result: %+v %+v", last, last.Video)
+	}
+}
+
+func TestGoogleParseVideosHTMLCaptcha(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ParseVideosHTML(testutil.ResponseFromFixture(t, "search_captcha.html").Body); !errors.Is(err, core.ErrCaptcha) {
+		t.Fatalf("expected captcha error, got %v", err)
+	}
+}
+
+func TestGoogleBuildVideoURL(t *testing.T) {
+	t.Parallel()
+
+	u, err := BuildVideoURL(core.Query{Text: "golang", LangCode: "en", Start: 10})
+	if err != nil {
+		t.Fatalf("BuildVideoURL() error = %v", err)
+	}
+	for _, want := range []string{"tbm=vid", "q=golang", "start=10"} {
+		if !strings.Contains(u, want) {
+			t.Fatalf("expected %q in %s", want, u)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package mojeek
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// mojeekFeatureSelectors are the SERP modules extractMojeekFeatures reads; their
+// containers are also measured for layout=true.
+var mojeekFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"div.infobox", "section.infobox"},
+		TitleSelector: []string{"h2", "h3"},
+		TextSelector:  []string{"p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.7,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"div.related-searches", "ul.related-searches"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.7,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Mojeek SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, mojeekFeatureSelectors)
+}
+
+func extractMojeekFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, mojeekFeatureSelectors)
+}
@@ -0,0 +1,72 @@
+package mojeek
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a Mojeek SERP HTML document and returns search results.
+// No network I/O.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyMojeekDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseMojeekDocument(doc, 1)
+	results = core.AttachFeaturesToFirstResult(results, extractMojeekFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseMojeekSerpMeta(doc)), nil
+}
+
+func classifyMojeekDocument(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// parseMojeekDocument extracts organic rows ranked from startRank. Mojeek
+// carries no ads, so Rank and AbsoluteRank are the same sequence.
+func parseMojeekDocument(doc *goquery.Document, startRank int) []core.SearchResult {
+	var results []core.SearchResult
+	rank := startRank
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		href, _ := item.Find(Selectors.Link).First().Attr("href")
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleMojeekRow(href, title, desc, rank); ok {
+			core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+			rank++
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleMojeekRow validates an already-extracted row and builds the result.
+func assembleMojeekRow(href, title, desc string, rank int) (core.SearchResult, bool) {
+	href = strings.TrimSpace(href)
+	if title == "" || !core.IsHTTPURL(href) {
+		return core.SearchResult{}, false
+	}
+	return core.SearchResult{
+		Rank:         rank,
+		AbsoluteRank: rank,
+		URL:          href,
+		Title:        title,
+		Description:  desc,
+	}, true
+}
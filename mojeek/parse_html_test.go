@@ -0,0 +1,104 @@
+package mojeek
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseMojeekHTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+	testutil.AssertFirstResultFilled(t, results)
+	if results[0].URL != "https://en.wikipedia.org/wiki/List_of_search_engines" {
+		t.Fatalf("unexpected first URL: %s", results[0].URL)
+	}
+}
+
+func TestMojeekClassifyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    error
+	}{
+		{"search_no_results.html", core.ErrEmptyResult},
+		{"search_captcha.html", core.ErrCaptcha},
+		{"search_results.html", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseHTML(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			switch {
+			case tt.want == core.ErrEmptyResult:
+				if err != nil || len(results) != 0 {
+					t.Fatalf("expected zero results for %s, got %d (err=%v)", tt.fixture, len(results), err)
+				}
+			case tt.want != nil:
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("expected %v for %s, got %v", tt.want, tt.fixture, err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("expected nil for %s, got %v", tt.fixture, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseMojeekHTMLEmpty(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(bytes.NewReader([]byte("")))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected zero results for empty HTML, got %d", len(results))
+	}
+}
+
+func TestParseMojeekHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div class="spell">Did you mean <a href="/search?q=python">python</a>?</div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.SuggestedQuery != "python" {
+		t.Errorf("SuggestedQuery = %q, want python", meta.SuggestedQuery)
+	}
+}
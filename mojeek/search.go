@@ -0,0 +1,160 @@
+// Package mojeek implements a Mojeek SERP scraper (web search).
+//
+// Mojeek (https://www.mojeek.com/) is a UK search engine with its own crawler
+// and index, independent of Google and Bing, which makes it a useful fallback
+// when the larger engines are blocking.
+package mojeek
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+// mojeekPageSize is the results-per-page count on the web SERP.
+const mojeekPageSize = 10
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index, rounding off-grid offsets down to a page boundary.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / mojeekPageSize, nil
+}
+
+// Mojeek implements core.SearchEngine for Mojeek SERP pages.
+type Mojeek struct {
+	core.Browser
+	core.SearchEngineOptions
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a Mojeek engine instance with browser/runtime options applied.
+func New(browser core.Browser, opts core.SearchEngineOptions) *Mojeek {
+	m := Mojeek{Browser: browser}
+	opts.Init()
+	m.SearchEngineOptions = opts
+	m.logger = core.NewEngineLogger("Mojeek")
+	m.pageSleep = time.Second
+	return &m
+}
+
+// Name returns the stable engine identifier.
+func (m *Mojeek) Name() string { return "mojeek" }
+
+// Search executes a Mojeek web search and returns normalized search results.
+// It may return core.ErrCaptcha or core.ErrSearchTimeout.
+func (m *Mojeek) Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, m.Name(), false)
+	scoped := *m
+	scoped.logger = m.logger.WithRequest(ctx)
+	m = &scoped
+
+	m.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	firstPage := pageNum
+	all := []core.SearchResult{}
+	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
+
+	// fetchPage loads one SERP page and appends parsed results.
+	// Returns (done, error): done=true ends the outer loop without error.
+	fetchPage := func() (bool, error) {
+		u, err := BuildURL(query, pageNum)
+		if err != nil {
+			return false, err
+		}
+
+		page, err := m.Navigate(ctx, u)
+		if err != nil {
+			return false, err
+		}
+		defer core.DeferClosePage(ctx, page, &m.Browser)()
+
+		waitFor := []string{Selectors.Results, Selectors.NoResults, Selectors.Captcha}
+		if _, _, err := core.WaitForElements(ctx, page, waitFor, m.GetSelectorTimeout()); err != nil {
+			if pageErr := core.ClassifyFromPage(page, classifyMojeekDocument); pageErr != nil {
+				if errors.Is(pageErr, core.ErrEmptyResult) {
+					return true, nil
+				}
+				m.logger.Error("Page classified as %v: %s", pageErr, u)
+				core.ArchivePage(ctx, page)
+				return false, pageErr
+			}
+			if core.IsContextDone(err) {
+				return false, err
+			}
+			core.ArchivePage(ctx, page)
+			return false, core.ErrSearchTimeout
+		}
+
+		// Archive the page before StampLayout marks it, then measure before
+		// snapshotting so result rows read their boxes.
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
+		doc, err := core.DocumentFromPage(page)
+		if err != nil {
+			return false, core.ErrParser
+		}
+		if pageErr := classifyMojeekDocument(doc); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				m.logger.Debug("No results on page %d", pageNum)
+				return true, nil
+			}
+			m.logger.Error("Page classified as %v: %s", pageErr, u)
+			return false, pageErr
+		}
+
+		rows := parseMojeekDocument(doc, pageNum*mojeekPageSize+1)
+		if len(rows) == 0 {
+			m.logger.Debug("No parseable results on page %d", pageNum)
+			return true, nil
+		}
+		all = append(all, rows...)
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = parseMojeekSerpMeta(doc)
+		}
+		if query.Features && pageNum == firstPage {
+			pageFeatures = extractMojeekFeatures(doc)
+		}
+		return false, nil
+	}
+
+	for core.ShouldFetchResultPage(len(all), query.Limit, pageNum-firstPage) {
+		done, err := fetchPage()
+		if err != nil {
+			return nil, err
+		}
+		pageNum++
+		if done || !core.ShouldFetchResultPage(len(all), query.Limit, pageNum-firstPage) {
+			break
+		}
+		if err := core.SleepContext(ctx, m.pageSleep); err != nil {
+			return nil, err
+		}
+	}
+
+	deduped := core.DeduplicateResults(all)
+	if query.Limit > 0 {
+		deduped = core.LimitOrganicResults(deduped, query.Limit)
+	}
+	m.logger.Info("Search completed: %d results", len(deduped))
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
+}
+
+// SearchImage is not implemented: Mojeek has no stable image vertical.
+func (m *Mojeek) SearchImage(_ context.Context, _ core.Query) ([]core.SearchResult, error) {
+	return nil, errors.New("image search is not supported for mojeek")
+}
@@ -0,0 +1,17 @@
+//go:build integration
+// +build integration
+
+package mojeek
+
+import (
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil/ithelper"
+)
+
+func TestSearchMojeek(t *testing.T) {
+	ithelper.RunEngineTests(t, func(b *core.Browser) core.SearchEngine {
+		return New(*b, ithelper.EngineOptions())
+	})
+}
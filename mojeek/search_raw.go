@@ -0,0 +1,64 @@
+package mojeek
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+func Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, "mojeek", false)
+
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL, err := BuildURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", searchURL).Debug(fmt.Sprintf("Mojeek URL built: %s", searchURL))
+
+	res, err := core.RawSearchRequest(ctx, searchURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+	core.WithRequest(ctx).WithField("status_code", res.StatusCode).Debug(
+		fmt.Sprintf("Mojeek Raw response: code=%d", res.StatusCode),
+	)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	htmlStatus := classifyMojeekDocument(doc)
+	if htmlStatus != nil && !errors.Is(htmlStatus, core.ErrEmptyResult) {
+		return nil, htmlStatus
+	}
+
+	parsedResults := parseMojeekDocument(doc, pageNum*mojeekPageSize+1)
+	if len(parsedResults) == 0 {
+		if errors.Is(htmlStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, fmt.Errorf("%w: mojeek raw search returned no parseable results", core.ErrParser)
+	}
+	parsedResults = core.AttachFeaturesToFirstResult(parsedResults, extractMojeekFeatures(doc))
+	parsedResults = core.AttachSerpMetaToFirstResult(parsedResults, parseMojeekSerpMeta(doc))
+
+	core.WithRequest(ctx).WithField("results_count", len(parsedResults)).Debug(
+		fmt.Sprintf("Mojeek Raw results : %v", parsedResults),
+	)
+
+	return core.StripResultFeatures(parsedResults, query.Features), nil
+}
@@ -0,0 +1,114 @@
+package mojeek
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values, string)
+	}{
+		{
+			name:  "basic search omits s on page 0",
+			query: core.Query{Text: "open source search"},
+			check: func(t *testing.T, params url.Values, host string) {
+				t.Helper()
+				if host != "www.mojeek.com" {
+					t.Fatalf("unexpected host: %s", host)
+				}
+				if got := params.Get("q"); got != "open source search" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				for _, key := range []string{"s", "lb", "arc", "since"} {
+					if got := params.Get(key); got != "" {
+						t.Fatalf("expected no %s param, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, pagination and locale",
+			query: core.Query{Text: "tea", Site: "bbc.co.uk", LangCode: "en", Region: "GB"},
+			page:  2,
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("q"); got != "tea site:bbc.co.uk" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				if got := params.Get("s"); got != "21" {
+					t.Fatalf("unexpected s: %q", got)
+				}
+				if got := params.Get("lb"); got != "en" {
+					t.Fatalf("unexpected lb: %q", got)
+				}
+				if got := params.Get("arc"); got != "uk" {
+					t.Fatalf("expected GB to map to arc=uk, got %q", got)
+				}
+			},
+		},
+		{
+			name:  "region falls back to lang country subtag",
+			query: core.Query{Text: "bier", LangCode: "de-AT"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("lb"); got != "de" {
+					t.Fatalf("unexpected lb: %q", got)
+				}
+				if got := params.Get("arc"); got != "at" {
+					t.Fatalf("unexpected arc: %q", got)
+				}
+			},
+		},
+		{
+			name:  "date interval sends lower bound",
+			query: core.Query{Text: "golang", DateInterval: "20240101..20240301"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("since"); got != "20240101" {
+					t.Fatalf("unexpected since: %q", got)
+				}
+			},
+		},
+		{
+			name:    "end before start errors",
+			query:   core.Query{Text: "golang", DateInterval: "20240301..20240101"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed date errors",
+			query:   core.Query{Text: "golang", DateInterval: "week"},
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed.Query(), parsed.Host)
+			}
+		})
+	}
+}
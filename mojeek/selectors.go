@@ -0,0 +1,43 @@
+package mojeek
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Mojeek SERP CSS selectors.
+// Mojeek serves plain server-rendered HTML, so the browser and raw paths parse
+// the same markup.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	Title          string
+	Link           string
+	Desc           string
+	SpellSuggested string
+	SearchBox      string
+
+	// Breadcrumbs of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	Captcha: "form[action*='captcha'], iframe[src*='captcha']",
+	// CaptchaMarkers cover the automated-queries block page Mojeek serves with
+	// HTTP 200 to some networks (most blocks arrive as a bare 403).
+	CaptchaMarkers: []string{
+		"your network appears to be sending automated queries",
+		"unusual traffic",
+	},
+	NoResults:    ".no-results, #no-results",
+	EmptyMarkers: []string{"no pages found matching"},
+	Results:      "ul.results-standard > li",
+	Title:        "h2 a, a.title",
+	Link:         "a.ob, h2 a[href]",
+	Desc:         "p.s",
+	// SpellSuggested is the "Did you mean …" link.
+	SpellSuggested: ".spell a",
+	SearchBox:      "input[name='q']",
+	// Extras: the a.ob line reads "en.wikipedia.org › wiki › Page".
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "a.ob span.url",
+	},
+}
@@ -0,0 +1,66 @@
+package mojeek
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "display path",
+			html:            string(fixture),
+			url:             "https://en.wikipedia.org/wiki/List_of_search_engines",
+			wantBreadcrumbs: []string{"en.wikipedia.org", "wiki", "List_of_search_engines"},
+		},
+		{
+			name: "a bare host is not a path",
+			html: string(fixture),
+			url:  "https://www.opensearch.org/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
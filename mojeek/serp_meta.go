@@ -0,0 +1,17 @@
+package mojeek
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseMojeekSerpMeta reads the spelling suggestion and locale. Mojeek
+// never rewrites the query, so there is no correction to report.
+func parseMojeekSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		SuggestedQuery: strings.TrimSpace(doc.Find(Selectors.SpellSuggested).First().Text()),
+	}
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
@@ -0,0 +1,130 @@
+package mojeek
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+const baseURL = "https://www.mojeek.com/search"
+
+// mojeekRegionAliases maps ISO country codes to the region codes Mojeek's arc=
+// bias uses where they differ.
+var mojeekRegionAliases = map[string]string{
+	"gb": "uk",
+}
+
+// mojeekSince returns the since= date (YYYYMMDD) for a YYYYMMDD..YYYYMMDD
+// DateInterval. Mojeek only exposes a lower bound, so the end date is
+// validated but not sent.
+func mojeekSince(dateInterval string) (string, error) {
+	s := strings.TrimSpace(dateInterval)
+	if s == "" {
+		return "", nil
+	}
+	parts := strings.Split(s, "..")
+	if len(parts) != 2 {
+		return "", errors.New("incorrect date interval provided, expected YYYYMMDD..YYYYMMDD")
+	}
+	start, err := time.Parse("20060102", parts[0])
+	if err != nil {
+		return "", errors.New("invalid start date format, expected YYYYMMDD")
+	}
+	end, err := time.Parse("20060102", parts[1])
+	if err != nil {
+		return "", errors.New("invalid end date format, expected YYYYMMDD")
+	}
+	if end.Before(start) {
+		return "", errors.New("date interval end is before start")
+	}
+	return parts[0], nil
+}
+
+// mojeekLocale returns the lb= (language bias) and arc= (region bias) values
+// for q.LangCode/q.Region. Region falls back to the LangCode's country subtag.
+func mojeekLocale(langCode, region string) (language, country string) {
+	parsed := core.ParseLocale(langCode)
+	country = strings.ToLower(core.CountryFromRegion(region))
+	if country == "" {
+		country = strings.ToLower(parsed.Country)
+	}
+	if alias, ok := mojeekRegionAliases[country]; ok {
+		country = alias
+	}
+	return parsed.Language, country
+}
+
+// Operators is Mojeek's spelling of the structured query operators. Mojeek
+// has no title, URL or OR operators.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+}
+
+// SupportsSafeSearch reports whether Mojeek URLs can carry level. Mojeek's
+// safe= filter is a plain on/off switch with no moderate setting.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	return level != core.SafeSearchModerate
+}
+
+// BuildURL builds a Mojeek web search URL for the supplied query and 0-based
+// page index. Pagination uses s=, the 1-based offset of the first result.
+func BuildURL(q core.Query, page int) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	operators, _ := Operators.Compile(q)
+	text += operators
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+
+	params := url.Values{}
+	params.Set("q", text)
+
+	language, country := mojeekLocale(q.LangCode, q.Region)
+	if language != "" {
+		params.Set("lb", language)
+	}
+	if country != "" {
+		params.Set("arc", country)
+	}
+	switch q.SafeSearch {
+	case core.SafeSearchOff:
+		params.Set("safe", "0")
+	case core.SafeSearchStrict:
+		params.Set("safe", "1")
+	}
+
+	since, err := mojeekSince(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if since != "" {
+		params.Set("since", since)
+	}
+
+	if page > 0 {
+		params.Set("s", strconv.Itoa(page*mojeekPageSize+1))
+	}
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
@@ -0,0 +1,40 @@
+package qwant
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// qwantFeatureSelectors are the SERP modules extractQwantFeatures reads; their
+// containers are also measured for layout=true.
+var qwantFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"aside.knowledge", ".ia-wikipedia"},
+		TitleSelector: []string{"h2", "h3"},
+		TextSelector:  []string{"p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.7,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{".related-searches"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.7,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Qwant SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, qwantFeatureSelectors)
+}
+
+func extractQwantFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, qwantFeatureSelectors)
+}
@@ -0,0 +1,137 @@
+package qwant
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a Qwant Lite SERP HTML document and returns search
+// results. No network I/O.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyQwantDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseQwantDocument(doc, core.NewRankState(0))
+	results = core.AttachFeaturesToFirstResult(results, extractQwantFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseQwantSerpMeta(doc)), nil
+}
+
+func classifyQwantDocument(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// parseQwantDocument walks result rows in DOM order. rank carries the
+// organic/ad/absolute counters so the browser path can continue them across
+// pages.
+func parseQwantDocument(doc *goquery.Document, rank *core.RankState) []core.SearchResult {
+	var results []core.SearchResult
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		href, _ := item.Find(Selectors.Link).First().Attr("href")
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleQwantRow(href, title, desc, qwantSelectionIsAd(item), rank); ok {
+			if !res.Ad {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			}
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleQwantRow validates an already-extracted row and reserves its rank.
+// Rows are rejected before rank.Next so skipped cards leave no gaps.
+func assembleQwantRow(href, title, desc string, ad bool, rank *core.RankState) (core.SearchResult, bool) {
+	href = strings.TrimSpace(href)
+	if title == "" || !core.IsHTTPURL(href) {
+		return core.SearchResult{}, false
+	}
+	resultRank, absoluteRank := rank.Next(ad)
+	return core.SearchResult{
+		Rank:         resultRank,
+		AbsoluteRank: absoluteRank,
+		URL:          href,
+		Title:        title,
+		Description:  desc,
+		Ad:           ad,
+	}, true
+}
+
+func qwantSelectionIsAd(item *goquery.Selection) bool {
+	if item.Is(Selectors.Ad) || item.Find(Selectors.Ad).Length() > 0 {
+		return true
+	}
+	isAd := false
+	item.Find("span, em").EachWithBreak(func(_ int, marker *goquery.Selection) bool {
+		text := strings.TrimSpace(marker.Text())
+		for _, label := range Selectors.AdLabels {
+			if strings.EqualFold(text, label) {
+				isAd = true
+				return false
+			}
+		}
+		return true
+	})
+	return isAd
+}
+
+// parseQwantImageDocument extracts image cards from a snapshot of the image
+// grid, ranking from startRank.
+func parseQwantImageDocument(doc *goquery.Document, startRank int) []core.SearchResult {
+	var results []core.SearchResult
+	rank := startRank
+	doc.Find(Selectors.ImageResult).Each(func(_ int, item *goquery.Selection) {
+		link := item.Find(Selectors.ImageLink).First()
+		href, _ := link.Attr("href")
+		title, _ := link.Find("img").First().Attr("alt")
+		source := strings.TrimSpace(item.Find(Selectors.ImageSource).First().Text())
+		dims := strings.TrimSpace(item.Find(Selectors.ImageDims).First().Text())
+		if res, ok := assembleQwantImageRow(href, strings.TrimSpace(title), source, dims, rank); ok {
+			results = append(results, res)
+			rank++
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleQwantImageRow validates an already-extracted image card and builds
+// the result, formatting the source/dimensions description.
+func assembleQwantImageRow(href, title, source, dims string, rank int) (core.SearchResult, bool) {
+	href = strings.TrimSpace(href)
+	if !core.IsHTTPURL(href) {
+		return core.SearchResult{}, false
+	}
+	desc := source
+	if dims != "" {
+		if source != "" {
+			desc = fmt.Sprintf("%s (%s)", source, dims)
+		} else {
+			desc = dims
+		}
+	}
+	return core.SearchResult{
+		Rank:        rank,
+		URL:         href,
+		Title:       title,
+		Description: desc,
+	}, true
+}
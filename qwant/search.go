@@ -0,0 +1,213 @@
+// Package qwant implements a Qwant SERP scraper (web and image search).
+//
+// Qwant (https://www.qwant.com/) is a French engine that blends its own index
+// with Bing's, targeted per market through a language_COUNTRY locale. Web
+// results are read from Qwant Lite, which renders them server-side; images
+// come from the main app's grid.
+package qwant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+// qwantPageSize is the organic-results-per-page count on the web SERP.
+const qwantPageSize = 10
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index, rounding off-grid offsets down to a page boundary.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / qwantPageSize, nil
+}
+
+// Qwant implements core.SearchEngine for Qwant SERP pages.
+type Qwant struct {
+	core.Browser
+	core.SearchEngineOptions
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a Qwant engine instance with browser/runtime options applied.
+func New(browser core.Browser, opts core.SearchEngineOptions) *Qwant {
+	q := Qwant{Browser: browser}
+	opts.Init()
+	q.SearchEngineOptions = opts
+	q.logger = core.NewEngineLogger("Qwant")
+	q.pageSleep = time.Second
+	return &q
+}
+
+// Name returns the stable engine identifier.
+func (q *Qwant) Name() string { return "qwant" }
+
+// Search executes a Qwant web search and returns normalized search
+// results. It may return core.ErrCaptcha or core.ErrSearchTimeout.
+func (q *Qwant) Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, q.Name(), false)
+	scoped := *q
+	scoped.logger = q.logger.WithRequest(ctx)
+	q = &scoped
+
+	q.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	firstPage := pageNum
+	// One RankState spans all pages so organic ranks keep counting across
+	// pages while ads keep their own sequence.
+	rank := core.NewRankState(pageNum)
+	all := []core.SearchResult{}
+	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
+
+	// fetchPage loads one SERP page and appends parsed results.
+	// Returns (done, error): done=true ends the outer loop without error.
+	fetchPage := func() (bool, error) {
+		u, err := BuildURL(query, pageNum)
+		if err != nil {
+			return false, err
+		}
+
+		page, err := q.Navigate(ctx, u)
+		if err != nil {
+			return false, err
+		}
+		defer core.DeferClosePage(ctx, page, &q.Browser)()
+
+		waitFor := []string{Selectors.Results, Selectors.NoResults, Selectors.Captcha}
+		if _, _, err := core.WaitForElements(ctx, page, waitFor, q.GetSelectorTimeout()); err != nil {
+			if pageErr := core.ClassifyFromPage(page, classifyQwantDocument); pageErr != nil {
+				if errors.Is(pageErr, core.ErrEmptyResult) {
+					return true, nil
+				}
+				q.logger.Error("Page classified as %v: %s", pageErr, u)
+				core.ArchivePage(ctx, page)
+				return false, pageErr
+			}
+			if core.IsContextDone(err) {
+				return false, err
+			}
+			core.ArchivePage(ctx, page)
+			return false, core.ErrSearchTimeout
+		}
+
+		// Archive the page before StampLayout marks it, then measure before
+		// snapshotting so result rows read their boxes.
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
+		doc, err := core.DocumentFromPage(page)
+		if err != nil {
+			return false, core.ErrParser
+		}
+		if pageErr := classifyQwantDocument(doc); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				q.logger.Debug("No results on page %d", pageNum)
+				return true, nil
+			}
+			q.logger.Error("Page classified as %v: %s", pageErr, u)
+			return false, pageErr
+		}
+
+		rows := parseQwantDocument(doc, rank)
+		if len(rows) == 0 {
+			q.logger.Debug("No parseable results on page %d", pageNum)
+			return true, nil
+		}
+		all = append(all, rows...)
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = parseQwantSerpMeta(doc)
+		}
+		if query.Features && pageNum == firstPage {
+			pageFeatures = extractQwantFeatures(doc)
+		}
+		return false, nil
+	}
+
+	for core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+		done, err := fetchPage()
+		if err != nil {
+			return nil, err
+		}
+		pageNum++
+		if done || !core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+			break
+		}
+		if err := core.SleepContext(ctx, q.pageSleep); err != nil {
+			return nil, err
+		}
+	}
+
+	deduped := core.DeduplicateResults(all)
+	if query.Limit > 0 {
+		deduped = core.LimitOrganicResults(deduped, query.Limit)
+	}
+	q.logger.Info("Search completed: %d results", len(deduped))
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
+}
+
+// SearchImage executes a Qwant image search and returns normalized image
+// results. It may return core.ErrCaptcha or core.ErrSearchTimeout.
+// query.Start is ignored: the grid grows on scroll rather than by page, so
+// depth is bounded by the first load and trimmed to query.Limit.
+func (q *Qwant) SearchImage(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, q.Name(), false)
+	scoped := *q
+	scoped.logger = q.logger.WithRequest(ctx)
+	q = &scoped
+
+	q.logger.Debug("Starting image search, query: %+v", query)
+	u, err := BuildImageURL(query)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := q.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &q.Browser)()
+
+	waitFor := []string{Selectors.ImageResult, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, q.GetSelectorTimeout()); err != nil {
+		if pageErr := core.ClassifyFromPage(page, classifyQwantDocument); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			q.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyQwantDocument(doc); pageErr != nil && !errors.Is(pageErr, core.ErrEmptyResult) {
+		q.logger.Error("Page classified as %v: %s", pageErr, u)
+		return nil, pageErr
+	}
+
+	out := parseQwantImageDocument(doc, 1)
+	if query.Limit > 0 && len(out) > query.Limit {
+		out = out[:query.Limit]
+	}
+	q.logger.Info("Image search completed: %d results", len(out))
+	return out, nil
+}
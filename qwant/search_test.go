@@ -0,0 +1,164 @@
+package qwant
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values, string)
+	}{
+		{
+			name:  "basic search targets lite without locale",
+			query: core.Query{Text: "open source search"},
+			check: func(t *testing.T, params url.Values, host string) {
+				t.Helper()
+				if host != "lite.qwant.com" {
+					t.Fatalf("unexpected host: %s", host)
+				}
+				if got := params.Get("q"); got != "open source search" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				if got := params.Get("t"); got != "web" {
+					t.Fatalf("unexpected t: %q", got)
+				}
+				for _, key := range []string{"p", "locale", "freshness"} {
+					if got := params.Get(key); got != "" {
+						t.Fatalf("expected no %s param, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, filetype and pagination",
+			query: core.Query{Text: "rapport", Site: "gouv.fr", Filetype: "pdf"},
+			page:  2,
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("q"); got != "rapport site:gouv.fr filetype:pdf" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				if got := params.Get("p"); got != "3" {
+					t.Fatalf("unexpected p: %q", got)
+				}
+			},
+		},
+		{
+			name:  "bare language maps to its default market",
+			query: core.Query{Text: "pain", LangCode: "fr"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("locale"); got != "fr_FR" {
+					t.Fatalf("unexpected locale: %q", got)
+				}
+			},
+		},
+		{
+			name:  "region picks market and keeps supported language",
+			query: core.Query{Text: "brood", LangCode: "nl", Region: "BE"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("locale"); got != "nl_BE" {
+					t.Fatalf("unexpected locale: %q", got)
+				}
+			},
+		},
+		{
+			name:  "unsupported language falls back to market default",
+			query: core.Query{Text: "bread", LangCode: "en", Region: "CH"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("locale"); got != "de_CH" {
+					t.Fatalf("unexpected locale: %q", got)
+				}
+			},
+		},
+		{
+			name:  "lang country subtag selects market",
+			query: core.Query{Text: "pain", LangCode: "fr-CA"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("locale"); got != "fr_CA" {
+					t.Fatalf("unexpected locale: %q", got)
+				}
+			},
+		},
+		{
+			name:  "date interval buckets into freshness",
+			query: core.Query{Text: "actualités", DateInterval: "20240101..20240105"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("freshness"); got != "week" {
+					t.Fatalf("unexpected freshness: %q", got)
+				}
+			},
+		},
+		{
+			name:    "malformed date errors",
+			query:   core.Query{Text: "actualités", DateInterval: "hier"},
+			wantErr: true,
+		},
+		{
+			name:    "negative page errors",
+			query:   core.Query{Text: "pain"},
+			page:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed.Query(), parsed.Host)
+			}
+		})
+	}
+}
+
+func TestBuildImageURL(t *testing.T) {
+	got, err := BuildImageURL(core.Query{Text: "tour eiffel", LangCode: "fr", Filetype: "pdf"})
+	if err != nil {
+		t.Fatalf("BuildImageURL() error = %v", err)
+	}
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("BuildImageURL() returned invalid URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Host != "www.qwant.com" || params.Get("t") != "images" {
+		t.Fatalf("unexpected image URL: %s", got)
+	}
+	if params.Get("q") != "tour eiffel" {
+		t.Fatalf("expected filetype to be left out of image query, got %q", params.Get("q"))
+	}
+	if params.Get("locale") != "fr_FR" {
+		t.Fatalf("unexpected locale: %q", params.Get("locale"))
+	}
+
+	if _, err := BuildImageURL(core.Query{}); err == nil {
+		t.Fatal("expected error for empty query")
+	}
+}
@@ -0,0 +1,58 @@
+package qwant
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Qwant SERP CSS selectors.
+// Web entries target Qwant Lite; Image entries target the www.qwant.com
+// image grid.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	Ad             string
+	AdLabels       []string
+	Title          string
+	Link           string
+	Desc           string
+	ImageResult    string
+	ImageLink      string
+	ImageSource    string
+	ImageDims      string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Breadcrumbs of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	// Captcha matches the DataDome challenge Qwant fronts both hosts with.
+	Captcha: "iframe[src*='captcha-delivery.com'], script[src*='captcha-delivery.com']",
+	CaptchaMarkers: []string{
+		"please enable js and disable any ad blocker",
+		"vous n'êtes pas un robot",
+	},
+	NoResults:    ".no-results, .empty-results",
+	EmptyMarkers: []string{"aucun résultat", "no results found"},
+	Results:      "section.results article, div.result",
+	Ad:           ".is-ad, .is-sponsored",
+	AdLabels:     []string{"Annonce", "Ad", "Anzeige", "Sponsorisé"},
+	Title:        "h2, h3",
+	Link:         "h2 a[href], h3 a[href], a.url[href]",
+	Desc:         "p.desc, p",
+	ImageResult:  "[data-testid='imageResult']",
+	ImageLink:    "a[href]",
+	ImageSource:  "[data-testid='imageResultDomain']",
+	ImageDims:    "[data-testid='imageResultSize']",
+	// SpellCorrected is "Résultats pour …" (Qwant already ran the fixed
+	// query); SpellSuggested is "Essayez avec cette orthographe".
+	SpellCorrected: "[data-testid='spellCorrected'] a",
+	SpellSuggested: "[data-testid='spellSuggested'] a",
+	SearchBox:      "input[name='q']",
+	// Extras: a.url shows the bare domain on Lite and "host › path" on the
+	// full site; only the latter yields breadcrumbs.
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "a.url",
+	},
+}
@@ -0,0 +1,72 @@
+package qwant
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name: "lite shows a bare domain",
+			html: string(fixture),
+			url:  "https://fr.wikipedia.org/wiki/Baguette",
+		},
+		{
+			name:            "full site display path",
+			html:            strings.Replace(string(fixture), ">fr.wikipedia.org</a>", ">fr.wikipedia.org › wiki › Baguette</a>", 1),
+			url:             "https://fr.wikipedia.org/wiki/Baguette",
+			wantBreadcrumbs: []string{"fr.wikipedia.org", "wiki", "Baguette"},
+		},
+		{
+			name:     "snippet date",
+			html:     string(fixture),
+			url:      "https://www.unesco.org/fr/articles/la-baguette-de-pain",
+			wantRich: &core.RichSnippet{Date: "Dec 1, 2022", PublishedAt: "2022-12-01T00:00:00Z"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
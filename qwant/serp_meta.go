@@ -0,0 +1,17 @@
+package qwant
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseQwantSerpMeta reads the spelling notices and locale.
+func parseQwantSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+		SuggestedQuery: strings.TrimSpace(doc.Find(Selectors.SpellSuggested).First().Text()),
+	}
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
@@ -0,0 +1,46 @@
+package qwant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches Qwant's query completions for query.Text.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "qwant", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("Qwant suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, parseSuggestions)
+}
+
+// parseSuggestions reads the v3 suggest payload:
+// {"status": "success", "data": {"items": [{"value": "..."}]}}.
+func parseSuggestions(body []byte) ([]string, error) {
+	var payload struct {
+		Status string `json:"status"`
+		Data   struct {
+			Items []struct {
+				Value string `json:"value"`
+			} `json:"items"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Status != "success" {
+		return nil, errors.New("qwant suggest returned status " + payload.Status)
+	}
+	texts := make([]string, 0, len(payload.Data.Items))
+	for _, item := range payload.Data.Items {
+		texts = append(texts, item.Value)
+	}
+	return texts, nil
+}
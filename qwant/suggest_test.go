@@ -0,0 +1,41 @@
+package qwant
+
+import (
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseSuggestions(t *testing.T) {
+	data, err := os.ReadFile("testdata/suggest.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	texts, err := parseSuggestions(data)
+	if err != nil {
+		t.Fatalf("parseSuggestions() error = %v", err)
+	}
+	if want := []string{"golang tutorial", "golang vs rust"}; !reflect.DeepEqual(texts, want) {
+		t.Fatalf("unexpected suggestions %v", texts)
+	}
+	if _, err := parseSuggestions([]byte(`{"status":"error","data":{"error_code":24}}`)); err == nil {
+		t.Fatal("expected an error status to fail")
+	}
+}
+
+func TestBuildSuggestURL(t *testing.T) {
+	u, err := BuildSuggestURL(core.Query{Text: "golang", LangCode: "fr"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	if parsed.Host != "api.qwant.com" || parsed.Query().Get("q") != "golang" || parsed.Query().Get("locale") != "fr_FR" {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+}
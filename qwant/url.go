@@ -0,0 +1,191 @@
+package qwant
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+const (
+	// liteURL serves server-rendered web results. The main www.qwant.com app
+	// renders them client-side from a bot-protected API.
+	liteURL    = "https://lite.qwant.com/"
+	imagesURL  = "https://www.qwant.com/"
+	suggestURL = "https://api.qwant.com/v3/suggest"
+)
+
+// qwantLanguageDefaults maps a bare language subtag to the locale Qwant uses
+// for it when the caller names no country.
+var qwantLanguageDefaults = map[string]string{
+	"bg": "bg_BG", "ca": "ca_ES", "cs": "cs_CZ", "da": "da_DK", "de": "de_DE",
+	"el": "el_GR", "en": "en_US", "es": "es_ES", "et": "et_EE", "fi": "fi_FI",
+	"fr": "fr_FR", "he": "he_IL", "hu": "hu_HU", "it": "it_IT", "ko": "ko_KR",
+	"nb": "nb_NO", "nl": "nl_NL", "no": "nb_NO", "pl": "pl_PL", "pt": "pt_PT",
+	"ro": "ro_RO", "sv": "sv_SE", "th": "th_TH", "zh": "zh_CN",
+}
+
+// qwantLocale returns the locale= value for q. Region (or a country subtag on
+// LangCode) selects the market via core.QwantLocale; a bare LangCode falls
+// back to its default market. Returns "" to let Qwant use its own default.
+func qwantLocale(q core.Query) string {
+	parsed := core.ParseLocale(q.LangCode)
+	region := q.Region
+	if core.CountryFromRegion(region) == "" && parsed.Country != "" {
+		region = q.LangCode
+	}
+	if locale := core.QwantLocale(parsed.Language, region); locale != "" {
+		return locale
+	}
+	return qwantLanguageDefaults[parsed.Language]
+}
+
+// qwantFreshness maps a YYYYMMDD..YYYYMMDD DateInterval to Qwant's freshness
+// bucket: <= 1d -> day, <= 7d -> week, <= 31d -> month. Longer spans are
+// dropped (returns "", nil) since Qwant exposes no finer control. Malformed
+// input is rejected so non-spec values do not silently lose the filter.
+func qwantFreshness(dateInterval string) (string, error) {
+	s := strings.TrimSpace(dateInterval)
+	if s == "" {
+		return "", nil
+	}
+	parts := strings.Split(s, "..")
+	if len(parts) != 2 {
+		return "", errors.New("incorrect date interval provided, expected YYYYMMDD..YYYYMMDD")
+	}
+	start, err := time.Parse("20060102", parts[0])
+	if err != nil {
+		return "", errors.New("invalid start date format, expected YYYYMMDD")
+	}
+	end, err := time.Parse("20060102", parts[1])
+	if err != nil {
+		return "", errors.New("invalid end date format, expected YYYYMMDD")
+	}
+	span := end.Sub(start)
+	if span < 0 {
+		return "", errors.New("date interval end is before start")
+	}
+	switch {
+	case span <= 24*time.Hour:
+		return "day", nil
+	case span <= 7*24*time.Hour:
+		return "week", nil
+	case span <= 31*24*time.Hour:
+		return "month", nil
+	default:
+		return "", nil
+	}
+}
+
+// Operators is Qwant's spelling of the structured query operators. Its Bing
+// backend has no inurl:.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	Or:          " OR ",
+}
+
+// safeSearchLevels maps SafeSearch levels to the safesearch= parameter.
+var safeSearchLevels = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "0",
+	core.SafeSearchModerate: "1",
+	core.SafeSearchStrict:   "2",
+}
+
+// SupportsSafeSearch reports whether Qwant URLs can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := safeSearchLevels[level]
+	return ok || level == ""
+}
+
+// buildParams holds the parameters shared by web and image URLs.
+func buildParams(q core.Query, vertical string) (url.Values, error) {
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if vertical == "web" {
+		if q.Filetype != "" {
+			text += " filetype:" + q.Filetype
+		}
+		operators, _ := Operators.Compile(q)
+		text += operators
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("empty query built")
+	}
+
+	params := url.Values{}
+	params.Set("q", text)
+	params.Set("t", vertical)
+	if locale := qwantLocale(q); locale != "" {
+		params.Set("locale", locale)
+	}
+	if level, ok := safeSearchLevels[q.SafeSearch]; ok {
+		params.Set("safesearch", level)
+	}
+
+	freshness, err := qwantFreshness(q.DateInterval)
+	if err != nil {
+		return nil, err
+	}
+	if freshness != "" {
+		params.Set("freshness", freshness)
+	}
+	return params, nil
+}
+
+// BuildURL builds a Qwant Lite web search URL for the supplied query and
+// 0-based page index. Pagination uses the 1-based p= page number.
+func BuildURL(q core.Query, page int) (string, error) {
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+	base, err := url.Parse(liteURL)
+	if err != nil {
+		return "", err
+	}
+	params, err := buildParams(q, "web")
+	if err != nil {
+		return "", err
+	}
+	if page > 0 {
+		params.Set("p", strconv.Itoa(page+1))
+	}
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildImageURL builds a Qwant image search URL. The image grid loads more
+// cards on scroll rather than by page, so there is no page parameter.
+func BuildImageURL(q core.Query) (string, error) {
+	base, err := url.Parse(imagesURL)
+	if err != nil {
+		return "", err
+	}
+	params, err := buildParams(q, "images")
+	if err != nil {
+		return "", err
+	}
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildSuggestURL builds a Qwant autocomplete URL for q.Text. Unlike search,
+// the suggest API is not bot-protected, so it is called directly.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params := url.Values{}
+	params.Add("q", q.Text)
+	if locale := qwantLocale(q); locale != "" {
+		params.Add("locale", locale)
+	}
+	return suggestURL + "?" + params.Encode(), nil
+}
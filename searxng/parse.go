@@ -0,0 +1,259 @@
+package searxng
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+// response mirrors the subset of SearXNG's format=json payload we consume.
+type response struct {
+	Results      []result       `json:"results"`
+	Answers      []answer       `json:"answers"`
+	Infoboxes    []infobox      `json:"infoboxes"`
+	Suggestions  []string       `json:"suggestions"`
+	Corrections  []string       `json:"corrections"`
+	Unresponsive []unresponsive `json:"unresponsive_engines"`
+	// NumberOfResults is the estimate from the upstream engines that report one;
+	// some instances send it as a float.
+	NumberOfResults float64 `json:"number_of_results"`
+}
+
+type result struct {
+	URL          string   `json:"url"`
+	Title        string   `json:"title"`
+	Content      string   `json:"content"`
+	Engine       string   `json:"engine"`
+	Engines      []string `json:"engines"`
+	ImgSrc       string   `json:"img_src"`
+	ThumbnailSrc string   `json:"thumbnail_src"`
+	Resolution   string   `json:"resolution"`
+}
+
+// answer is either a plain string (older instances) or an object with an
+// answer field (2024+), so it decodes both.
+type answer struct {
+	Text string
+	URL  string
+}
+
+func (a *answer) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		a.Text = text
+		return nil
+	}
+	var obj struct {
+		Answer string `json:"answer"`
+		URL    string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	a.Text, a.URL = obj.Answer, obj.URL
+	return nil
+}
+
+// unresponsive is one unresponsive_engines entry, a [name, reason] pair.
+type unresponsive struct {
+	Engine string
+	Reason string
+}
+
+func (u *unresponsive) UnmarshalJSON(data []byte) error {
+	var pair []string
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) > 0 {
+		u.Engine = pair[0]
+	}
+	if len(pair) > 1 {
+		u.Reason = pair[1]
+	}
+	return nil
+}
+
+type infobox struct {
+	Infobox string `json:"infobox"`
+	Content string `json:"content"`
+	URLs    []struct {
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	} `json:"urls"`
+}
+
+var resolutionPattern = regexp.MustCompile(`(\d+)\s*[x×]\s*(\d+)`)
+
+// decodeResponse parses a SearXNG JSON body. A non-JSON body usually means
+// the instance served its HTML UI because format=json is disabled.
+func decodeResponse(r io.Reader) (response, error) {
+	var resp response
+	if err := json.NewDecoder(r).Decode(&resp); err != nil {
+		return response{}, fmt.Errorf("%w: searxng response is not JSON (is format=json enabled?): %v", core.ErrParser, err)
+	}
+	return resp, nil
+}
+
+// sources returns the upstream engines behind a result, falling back to the
+// single engine field when engines is absent.
+func (r result) sources() []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, name := range append(append([]string{}, r.Engines...), r.Engine) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	return out
+}
+
+// parseSerpMeta reads the result estimate and spelling correction. SearXNG
+// only offers corrections as links; the query still runs as typed, so they
+// are suggestions.
+func parseSerpMeta(resp response) *core.SerpMeta {
+	meta := &core.SerpMeta{TotalResults: int64(resp.NumberOfResults)}
+	if len(resp.Corrections) > 0 {
+		meta.SuggestedQuery = strings.TrimSpace(resp.Corrections[0])
+	}
+	return meta
+}
+
+// parseResults converts web results in SearXNG's merged order. SearXNG drops
+// ads from its upstreams, so every row is organic.
+func parseResults(resp response, rank *core.RankState) []core.SearchResult {
+	var results []core.SearchResult
+	for _, row := range resp.Results {
+		if res, ok := assembleResult(row, rank); ok {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+func assembleResult(row result, rank *core.RankState) (core.SearchResult, bool) {
+	link := strings.TrimSpace(row.URL)
+	if !core.IsHTTPURL(link) {
+		return core.SearchResult{}, false
+	}
+	title := strings.TrimSpace(row.Title)
+	if title == "" {
+		return core.SearchResult{}, false
+	}
+	r, abs := rank.Next(false)
+	return core.SearchResult{
+		Rank:         r,
+		AbsoluteRank: abs,
+		URL:          link,
+		Title:        title,
+		Description:  strings.TrimSpace(row.Content),
+		Sources:      row.sources(),
+	}, true
+}
+
+// parseImageResults converts image results, formatting the description the
+// way core.EnrichImageResult reads it (source page, thumbnail, dimensions).
+func parseImageResults(resp response, startRank int) []core.SearchResult {
+	var results []core.SearchResult
+	rank := startRank
+	for _, row := range resp.Results {
+		img := strings.TrimSpace(row.ImgSrc)
+		if strings.HasPrefix(img, "//") {
+			img = "https:" + img
+		}
+		if !core.IsHTTPURL(img) {
+			continue
+		}
+		parts := []string{}
+		if page := strings.TrimSpace(row.URL); page != "" {
+			parts = append(parts, "Source page: "+page)
+		}
+		if thumb := strings.TrimSpace(row.ThumbnailSrc); thumb != "" {
+			if strings.HasPrefix(thumb, "//") {
+				thumb = "https:" + thumb
+			}
+			parts = append(parts, "thumb_url:"+thumb)
+		}
+		if m := resolutionPattern.FindStringSubmatch(row.Resolution); m != nil {
+			parts = append(parts, m[1]+"x"+m[2])
+		}
+		results = append(results, core.SearchResult{
+			Rank:        rank,
+			URL:         img,
+			Title:       strings.TrimSpace(row.Title),
+			Description: strings.Join(parts, ", "),
+			Sources:     row.sources(),
+		})
+		rank++
+	}
+	return core.DeduplicateResults(results)
+}
+
+// extractFeatures maps SearXNG's answers, infoboxes and suggestions onto the
+// shared SERP feature shapes.
+func extractFeatures(resp response) []core.SerpFeature {
+	var features []core.SerpFeature
+	for _, a := range resp.Answers {
+		text := strings.TrimSpace(a.Text)
+		if text == "" {
+			continue
+		}
+		feature := core.SerpFeature{Type: core.ResultTypeAnswerBox, Title: "Answer", Text: text, Confidence: 0.7}
+		if a.URL != "" {
+			feature.Links = []core.FeatureLink{{URL: a.URL}}
+		}
+		features = append(features, feature)
+	}
+	for _, box := range resp.Infoboxes {
+		if strings.TrimSpace(box.Infobox) == "" {
+			continue
+		}
+		feature := core.SerpFeature{
+			Type:       core.ResultTypeKnowledgePanel,
+			Title:      strings.TrimSpace(box.Infobox),
+			Text:       strings.TrimSpace(box.Content),
+			Confidence: 0.7,
+		}
+		for _, u := range box.URLs {
+			if u.URL != "" {
+				feature.Links = append(feature.Links, core.FeatureLink{Title: u.Title, URL: u.URL})
+			}
+		}
+		features = append(features, feature)
+	}
+	if len(resp.Suggestions) > 0 {
+		feature := core.SerpFeature{Type: core.ResultTypeRelatedSearches, Title: "Related searches", Confidence: 0.7}
+		for _, s := range resp.Suggestions {
+			if s = strings.TrimSpace(s); s != "" {
+				feature.Items = append(feature.Items, core.FeatureItem{Text: s})
+			}
+		}
+		if len(feature.Items) > 0 {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// unresponsiveSummary renders unresponsive_engines as "google (timeout), ...".
+func unresponsiveSummary(resp response) string {
+	parts := make([]string, 0, len(resp.Unresponsive))
+	for _, entry := range resp.Unresponsive {
+		switch {
+		case entry.Engine == "":
+			continue
+		case entry.Reason == "":
+			parts = append(parts, entry.Engine)
+		default:
+			parts = append(parts, fmt.Sprintf("%s (%s)", entry.Engine, entry.Reason))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,198 @@
+// Package searxng adapts a SearXNG instance's JSON API as an openserp engine.
+//
+// SearXNG (https://docs.searxng.org/) is a self-hosted metasearch frontend
+// that queries many engines and merges their results. The adapter needs no
+// browser: it calls /search?format=json on the configured instance and keeps
+// each result's upstream engine list as provenance, so /mega/search can blend
+// SearXNG with the scrapers in this repo. The instance must have "json" listed
+// under search.formats in its settings.yml.
+package searxng
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+// searxngPageSize approximates results per SearXNG page. The merged page
+// size varies with the upstream set, so it only maps Start onto pageno.
+const searxngPageSize = 10
+
+// Config is the searxng: block in config.yaml.
+type Config struct {
+	// BaseURL is the instance root, e.g. "http://localhost:8888/".
+	BaseURL string `mapstructure:"base_url"`
+	// Categories restricts web searches, e.g. ["general", "science"].
+	// Empty uses the instance default.
+	Categories []string `mapstructure:"categories"`
+	// Engines optionally pins the upstream engines, e.g. ["google", "brave"].
+	Engines []string `mapstructure:"engines"`
+	// Insecure skips TLS verification, for instances behind a self-signed
+	// certificate.
+	Insecure bool `mapstructure:"insecure"`
+}
+
+// Enabled reports whether an instance is configured.
+func (c Config) Enabled() bool {
+	return strings.TrimSpace(c.BaseURL) != ""
+}
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index, rounding off-grid offsets down to a page boundary.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / searxngPageSize, nil
+}
+
+// SearXNG implements core.SearchEngine over a SearXNG JSON endpoint.
+type SearXNG struct {
+	core.SearchEngineOptions
+	cfg       Config
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a SearXNG engine for the configured instance.
+func New(cfg Config, opts core.SearchEngineOptions) *SearXNG {
+	s := SearXNG{cfg: cfg}
+	opts.Init()
+	s.SearchEngineOptions = opts
+	s.logger = core.NewEngineLogger("SearXNG")
+	s.pageSleep = time.Second
+	return &s
+}
+
+// Name returns the stable engine identifier.
+func (s *SearXNG) Name() string { return "searxng" }
+
+// IsInitialized reports whether a base URL is configured.
+func (s *SearXNG) IsInitialized() bool { return s.cfg.Enabled() }
+
+// SupportsSafeSearch implements core.SafeSearchSupporter.
+func (s *SearXNG) SupportsSafeSearch(level core.SafeSearch) bool { return SupportsSafeSearch(level) }
+
+// QueryOperators implements core.OperatorCompiler.
+func (s *SearXNG) QueryOperators() core.OperatorSyntax { return Operators }
+
+// fetch runs one JSON request and decodes the body.
+func (s *SearXNG) fetch(ctx context.Context, searchURL string, query core.Query) (response, error) {
+	s.logger.Debug("SearXNG URL built: %s", searchURL)
+	res, err := core.RawSearchRequest(ctx, searchURL, query)
+	if err != nil {
+		return response{}, err
+	}
+	defer core.DrainAndCloseResponse(res)
+	s.logger.Debug("SearXNG response: code=%d", res.StatusCode)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return response{}, err
+	}
+	return decodeResponse(bytes.NewReader(body))
+}
+
+// emptyResultError tells a genuinely empty SERP apart from one where every
+// upstream failed, which SearXNG reports as 200 with unresponsive_engines.
+func emptyResultError(resp response) error {
+	if summary := unresponsiveSummary(resp); summary != "" {
+		return fmt.Errorf("%w: searxng upstreams unresponsive: %s", core.ErrBlocked, summary)
+	}
+	return nil
+}
+
+// Search executes a web search on the SearXNG instance. Results carry the
+// upstream engines that returned them in SearchResult.Sources.
+func (s *SearXNG) Search(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, s.Name(), false)
+	scoped := *s
+	scoped.logger = s.logger.WithRequest(ctx)
+	s = &scoped
+	query.Insecure = query.Insecure || s.cfg.Insecure
+
+	s.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	rank := core.NewRankState(pageNum)
+	all := []core.SearchResult{}
+	var features []core.SerpFeature
+	var serpMeta *core.SerpMeta
+
+	for fetched := 0; core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, fetched); fetched++ {
+		if fetched > 0 {
+			if err := core.SleepContext(ctx, s.pageSleep); err != nil {
+				return nil, err
+			}
+		}
+		u, err := BuildURL(s.cfg, query, pageNum+fetched)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.fetch(ctx, u, query)
+		if err != nil {
+			return nil, err
+		}
+		page := parseResults(resp, rank)
+		if fetched == 0 {
+			features = extractFeatures(resp)
+			serpMeta = parseSerpMeta(resp)
+			if len(page) == 0 {
+				if err := emptyResultError(resp); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if len(page) == 0 {
+			break
+		}
+		all = append(all, page...)
+	}
+
+	all = core.DeduplicateResults(all)
+	all = core.LimitOrganicResults(all, query.Limit)
+	all = core.AttachFeaturesToFirstResult(all, features)
+	all = core.AttachSerpMetaToFirstResult(all, serpMeta)
+	return core.StripResultFeatures(all, query.Features), nil
+}
+
+// SearchImage executes an image search in SearXNG's "images" category.
+func (s *SearXNG) SearchImage(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, s.Name(), false)
+	scoped := *s
+	scoped.logger = s.logger.WithRequest(ctx)
+	s = &scoped
+	query.Insecure = query.Insecure || s.cfg.Insecure
+
+	s.logger.Debug("Starting image search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	u, err := BuildImageURL(s.cfg, query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.fetch(ctx, u, query)
+	if err != nil {
+		return nil, err
+	}
+	results := parseImageResults(resp, query.Start+1)
+	if len(results) == 0 {
+		if err := emptyResultError(resp); err != nil {
+			return nil, err
+		}
+		return []core.SearchResult{}, nil
+	}
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
@@ -0,0 +1,323 @@
+package searxng
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	cfg := Config{BaseURL: "http://localhost:8888/searx/", Categories: []string{"general", "it"}}
+	tests := []struct {
+		name    string
+		cfg     Config
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, *url.URL)
+	}{
+		{
+			name:  "basic search keeps path prefix and requests json",
+			cfg:   cfg,
+			query: core.Query{Text: "golang"},
+			check: func(t *testing.T, u *url.URL) {
+				t.Helper()
+				if u.Host != "localhost:8888" || u.Path != "/searx/search" {
+					t.Fatalf("unexpected endpoint: %s%s", u.Host, u.Path)
+				}
+				params := u.Query()
+				if got := params.Get("format"); got != "json" {
+					t.Fatalf("unexpected format: %q", got)
+				}
+				if got := params.Get("categories"); got != "general,it" {
+					t.Fatalf("unexpected categories: %q", got)
+				}
+				for _, key := range []string{"pageno", "language", "time_range", "engines"} {
+					if got := params.Get(key); got != "" {
+						t.Fatalf("expected no %s param, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, filetype, locale and pagination",
+			cfg:   Config{BaseURL: "https://searx.example.org", Engines: []string{"google", "brave"}},
+			query: core.Query{Text: "report", Site: "example.com", Filetype: "pdf", LangCode: "de", Region: "AT"},
+			page:  2,
+			check: func(t *testing.T, u *url.URL) {
+				t.Helper()
+				params := u.Query()
+				if got := params.Get("q"); got != "report site:example.com filetype:pdf" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				if got := params.Get("language"); got != "de-AT" {
+					t.Fatalf("unexpected language: %q", got)
+				}
+				if got := params.Get("pageno"); got != "3" {
+					t.Fatalf("unexpected pageno: %q", got)
+				}
+				if got := params.Get("engines"); got != "google,brave" {
+					t.Fatalf("unexpected engines: %q", got)
+				}
+				if got := params.Get("categories"); got != "" {
+					t.Fatalf("expected no categories, got %q", got)
+				}
+			},
+		},
+		{
+			name:  "locale-style region supplies language",
+			cfg:   cfg,
+			query: core.Query{Text: "news", Region: "en-GB"},
+			check: func(t *testing.T, u *url.URL) {
+				t.Helper()
+				if got := u.Query().Get("language"); got != "en-GB" {
+					t.Fatalf("unexpected language: %q", got)
+				}
+			},
+		},
+		{
+			name:  "date interval buckets into time_range",
+			cfg:   cfg,
+			query: core.Query{Text: "news", DateInterval: "20240101..20240601"},
+			check: func(t *testing.T, u *url.URL) {
+				t.Helper()
+				if got := u.Query().Get("time_range"); got != "year" {
+					t.Fatalf("unexpected time_range: %q", got)
+				}
+			},
+		},
+		{
+			name:    "missing base url errors",
+			query:   core.Query{Text: "golang"},
+			wantErr: true,
+		},
+		{
+			name:    "non-http base url errors",
+			cfg:     Config{BaseURL: "ftp://searx.example.org"},
+			query:   core.Query{Text: "golang"},
+			wantErr: true,
+		},
+		{
+			name:    "end before start errors",
+			cfg:     cfg,
+			query:   core.Query{Text: "news", DateInterval: "20240301..20240101"},
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			cfg:     cfg,
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.cfg, tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed)
+			}
+		})
+	}
+}
+
+func TestBuildImageURLUsesImagesCategory(t *testing.T) {
+	got, err := BuildImageURL(Config{BaseURL: "http://localhost:8888", Categories: []string{"general"}}, core.Query{Text: "gopher"}, 0)
+	if err != nil {
+		t.Fatalf("BuildImageURL() error = %v", err)
+	}
+	parsed, _ := url.Parse(got)
+	if cat := parsed.Query().Get("categories"); cat != "images" {
+		t.Fatalf("unexpected categories: %q", cat)
+	}
+}
+
+// stubInstance answers every /search request with status and body and
+// records the last query string.
+func stubInstance(t *testing.T, status int, body []byte) (*httptest.Server, *url.Values) {
+	t.Helper()
+	var last url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search" {
+			http.NotFound(w, r)
+			return
+		}
+		last = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &last
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return data
+}
+
+func TestSearchAgainstStubInstance(t *testing.T) {
+	server, last := stubInstance(t, http.StatusOK, readFixture(t, "search.json"))
+	engine := New(Config{BaseURL: server.URL, Categories: []string{"general"}}, core.SearchEngineOptions{})
+
+	results, err := engine.Search(context.Background(), core.Query{Text: "golang", Limit: 10, Features: true})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := last.Get("categories"); got != "general" {
+		t.Fatalf("unexpected categories sent: %q", got)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d: %+v", len(results), results)
+	}
+
+	first := results[0]
+	if first.URL != "https://go.dev/" || first.Rank != 1 || first.AbsoluteRank != 1 || first.Ad {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if want := []string{"google", "duckduckgo", "brave"}; !reflect.DeepEqual(first.Sources, want) {
+		t.Fatalf("unexpected sources: %v, want %v", first.Sources, want)
+	}
+	if want := []string{"duckduckgo"}; !reflect.DeepEqual(results[2].Sources, want) {
+		t.Fatalf("expected engine fallback to supply sources, got %v", results[2].Sources)
+	}
+	if results[2].Rank != 3 {
+		t.Fatalf("expected skipped row not to consume a rank, got %d", results[2].Rank)
+	}
+
+	types := map[core.ResultType]bool{}
+	for _, feature := range first.Features {
+		types[feature.Type] = true
+	}
+	if !types[core.ResultTypeKnowledgePanel] || !types[core.ResultTypeRelatedSearches] {
+		t.Fatalf("expected knowledge panel and related searches features, got %+v", first.Features)
+	}
+
+	enriched := core.EnrichResult(first, core.EnrichContext{Engine: engine.Name()})
+	if enriched.Provenance == nil || enriched.Provenance.Via != "searxng" || len(enriched.Provenance.Engines) != 3 {
+		t.Fatalf("unexpected provenance: %+v", enriched.Provenance)
+	}
+}
+
+func TestParseSerpMeta(t *testing.T) {
+	resp, err := decodeResponse(strings.NewReader(`{"results":[],"corrections":["python tutorial"],"number_of_results":1230000}`))
+	if err != nil {
+		t.Fatalf("decodeResponse() error = %v", err)
+	}
+	meta := parseSerpMeta(resp)
+	if meta.SuggestedQuery != "python tutorial" || meta.TotalResults != 1230000 {
+		t.Fatalf("unexpected serp meta: %+v", meta)
+	}
+}
+
+func TestSearchImageAgainstStubInstance(t *testing.T) {
+	server, last := stubInstance(t, http.StatusOK, readFixture(t, "images.json"))
+	engine := New(Config{BaseURL: server.URL}, core.SearchEngineOptions{})
+
+	results, err := engine.SearchImage(context.Background(), core.Query{Text: "gopher", Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchImage() error = %v", err)
+	}
+	if got := last.Get("categories"); got != "images" {
+		t.Fatalf("unexpected categories sent: %q", got)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 image, got %d: %+v", len(results), results)
+	}
+
+	image := core.EnrichImageResult(results[0], core.EnrichContext{Engine: engine.Name()})
+	if image.Image.URL != "https://go.dev/blog/gopher/header.jpg" {
+		t.Fatalf("unexpected image URL: %q", image.Image.URL)
+	}
+	if image.Source.PageURL != "https://go.dev/blog/gopher" {
+		t.Fatalf("unexpected source page: %q", image.Source.PageURL)
+	}
+	if image.Image.Thumbnail != "https://tse1.mm.bing.net/th?id=OIP.gopher" {
+		t.Fatalf("unexpected thumbnail: %q", image.Image.Thumbnail)
+	}
+	if image.Image.Width != 1200 || image.Image.Height != 630 {
+		t.Fatalf("unexpected dimensions: %dx%d", image.Image.Width, image.Image.Height)
+	}
+	if image.Provenance == nil || len(image.Provenance.Engines) != 2 {
+		t.Fatalf("unexpected provenance: %+v", image.Provenance)
+	}
+}
+
+func TestSearchErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{
+			name:   "empty result set",
+			status: http.StatusOK,
+			body:   `{"results": [], "unresponsive_engines": []}`,
+		},
+		{
+			name:    "all upstreams unresponsive",
+			status:  http.StatusOK,
+			body:    `{"results": [], "unresponsive_engines": [["google", "CAPTCHA"], ["brave", "timeout"]]}`,
+			wantErr: core.ErrBlocked,
+		},
+		{
+			name:    "json format disabled",
+			status:  http.StatusForbidden,
+			body:    `Forbidden`,
+			wantErr: core.ErrBlocked,
+		},
+		{
+			name:    "html instead of json",
+			status:  http.StatusOK,
+			body:    `<!DOCTYPE html><html></html>`,
+			wantErr: core.ErrParser,
+		},
+		{
+			name:    "instance limiter",
+			status:  http.StatusTooManyRequests,
+			body:    `Too Many Requests`,
+			wantErr: core.ErrRateLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := stubInstance(t, tt.status, []byte(tt.body))
+			engine := New(Config{BaseURL: server.URL}, core.SearchEngineOptions{})
+
+			results, err := engine.Search(context.Background(), core.Query{Text: "golang", Limit: 10})
+			if tt.wantErr == nil {
+				if err != nil || len(results) != 0 {
+					t.Fatalf("expected empty results without error, got %v, %v", results, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
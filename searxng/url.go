@@ -0,0 +1,142 @@
+package searxng
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+// searxngLanguage returns the language= value for q: a bare language
+// ("de") or a language-country pair ("de-AT") when Region or LangCode names a
+// country. Returns "" to let the instance use its own default.
+func searxngLanguage(q core.Query) string {
+	parsed := core.ParseLocale(q.LangCode)
+	if parsed.Language == "" {
+		// A locale-style region such as en-GB carries its own language.
+		parsed = core.ParseLocale(q.Region)
+		if parsed.Country == "" {
+			return ""
+		}
+	}
+	if country := core.CountryFromRegion(q.Region); country != "" {
+		parsed.Country = country
+	}
+	if parsed.Country == "" {
+		return parsed.Language
+	}
+	return parsed.Language + "-" + parsed.Country
+}
+
+// searxngTimeRanges is SearXNG's time_range parameter per span.
+var searxngTimeRanges = map[core.DateSpan]string{
+	core.DateSpanDay:   "day",
+	core.DateSpanWeek:  "week",
+	core.DateSpanMonth: "month",
+	core.DateSpanYear:  "year",
+}
+
+// searxngTimeRange maps a DateInterval to SearXNG's time_range value.
+func searxngTimeRange(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return searxngTimeRanges[span], nil
+}
+
+// Operators lists the structured operators sent to SearXNG. The query text
+// is forwarded to each upstream engine, so only the syntax they all share is
+// used.
+var Operators = core.OperatorSyntax{
+	Phrase:  true,
+	Exclude: "-",
+}
+
+// safeSearchLevels maps SafeSearch levels to SearXNG's safesearch= values.
+var safeSearchLevels = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "0",
+	core.SafeSearchModerate: "1",
+	core.SafeSearchStrict:   "2",
+}
+
+// SupportsSafeSearch reports whether SearXNG URLs can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := safeSearchLevels[level]
+	return ok || level == ""
+}
+
+// buildURL assembles a /search?format=json URL on the configured instance.
+// The base URL may carry a path prefix (e.g. https://example.org/searx/).
+func buildURL(cfg Config, q core.Query, page int, categories []string) (string, error) {
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+	if strings.TrimSpace(cfg.BaseURL) == "" {
+		return "", errors.New("searxng base_url is not configured")
+	}
+	base, err := url.Parse(strings.TrimSpace(cfg.BaseURL))
+	if err != nil {
+		return "", err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return "", errors.New("searxng base_url must be an http(s) URL")
+	}
+
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+
+	params := url.Values{}
+	params.Set("q", strings.TrimSpace(text))
+	params.Set("format", "json")
+	if len(categories) > 0 {
+		params.Set("categories", strings.Join(categories, ","))
+	}
+	if len(cfg.Engines) > 0 {
+		params.Set("engines", strings.Join(cfg.Engines, ","))
+	}
+	if lang := searxngLanguage(q); lang != "" {
+		params.Set("language", lang)
+	}
+	timeRange, err := searxngTimeRange(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if timeRange != "" {
+		params.Set("time_range", timeRange)
+	}
+	if level, ok := safeSearchLevels[q.SafeSearch]; ok {
+		params.Set("safesearch", level)
+	}
+	if page > 0 {
+		params.Set("pageno", strconv.Itoa(page+1))
+	}
+
+	base.Path = strings.TrimRight(base.Path, "/") + "/search"
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildURL builds a SearXNG JSON web search URL for the supplied query and
+// 0-based page index, restricted to the configured categories.
+func BuildURL(cfg Config, q core.Query, page int) (string, error) {
+	operators, _ := Operators.Compile(q)
+	q.Text += operators
+	return buildURL(cfg, q, page, cfg.Categories)
+}
+
+// BuildImageURL builds a SearXNG JSON image search URL. The configured
+// categories are replaced by "images".
+func BuildImageURL(cfg Config, q core.Query, page int) (string, error) {
+	return buildURL(cfg, q, page, []string{"images"})
+}
@@ -0,0 +1,40 @@
+package seznam
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// seznamFeatureSelectors are the SERP modules extractSeznamFeatures reads; their
+// containers are also measured for layout=true.
+var seznamFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"[data-dot='knowledgeBox']"},
+		TitleSelector: []string{"h2", "h3"},
+		TextSelector:  []string{"[data-dot='snippet']", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.7,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"[data-dot='relatedSearch']"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.7,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Seznam SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, seznamFeatureSelectors)
+}
+
+func extractSeznamFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, seznamFeatureSelectors)
+}
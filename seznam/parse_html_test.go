@@ -0,0 +1,141 @@
+package seznam
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseSeznamHTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var organic []core.SearchResult
+	adCount := 0
+	for _, r := range results {
+		if r.Ad {
+			adCount++
+			continue
+		}
+		organic = append(organic, r)
+	}
+	if adCount != 1 || len(organic) != 3 {
+		t.Fatalf("expected 1 ad and 3 organic results, got %d ads, %d organic", adCount, len(organic))
+	}
+	testutil.AssertSequentialRanks(t, organic)
+	testutil.AssertFirstResultFilled(t, organic)
+	if organic[0].URL != "https://cs.wikipedia.org/wiki/Pivo" {
+		t.Fatalf("unexpected first organic URL: %s", organic[0].URL)
+	}
+	assertFeatureType(t, results, core.ResultTypeRelatedSearches)
+}
+
+func TestSeznamClassifyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    error
+	}{
+		{"search_no_results.html", core.ErrEmptyResult},
+		{"search_captcha.html", core.ErrCaptcha},
+		{"search_results.html", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseHTML(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			switch {
+			case tt.want == core.ErrEmptyResult:
+				if err != nil || len(results) != 0 {
+					t.Fatalf("expected zero results for %s, got %d (err=%v)", tt.fixture, len(results), err)
+				}
+			case tt.want != nil:
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("expected %v for %s, got %v", tt.want, tt.fixture, err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("expected nil for %s, got %v", tt.fixture, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSeznamImageDocument(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "images.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseSeznamImageDocument(doc, 1)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 image results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+	if results[0].Title != "Karlův most za úsvitu" {
+		t.Fatalf("unexpected title: %q", results[0].Title)
+	}
+	if results[0].Description != "prague.eu (2048 × 1365)" {
+		t.Fatalf("unexpected description: %q", results[0].Description)
+	}
+	if results[1].Description != "cs.wikipedia.org" {
+		t.Fatalf("unexpected description without dimensions: %q", results[1].Description)
+	}
+}
+
+func assertFeatureType(t *testing.T, results []core.SearchResult, want core.ResultType) {
+	t.Helper()
+	for _, result := range results {
+		for _, feature := range result.Features {
+			if feature.Type == want {
+				return
+			}
+		}
+	}
+	t.Fatalf("expected feature type %q in %#v", want, results)
+}
+
+func TestParseSeznamHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div data-dot="spellCorrected">Zobrazujeme výsledky pro <a href="/?q=python">python</a></div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.CorrectedQuery != "python" {
+		t.Errorf("CorrectedQuery = %q, want python", meta.CorrectedQuery)
+	}
+	if meta.EffectiveQuery != "python" {
+		t.Errorf("EffectiveQuery = %q, want the corrected query", meta.EffectiveQuery)
+	}
+}
@@ -0,0 +1,64 @@
+package seznam
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+func Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, "seznam", false)
+
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL, err := BuildURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", searchURL).Debug(fmt.Sprintf("Seznam URL built: %s", searchURL))
+
+	res, err := core.RawSearchRequest(ctx, searchURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+	core.WithRequest(ctx).WithField("status_code", res.StatusCode).Debug(
+		fmt.Sprintf("Seznam Raw response: code=%d", res.StatusCode),
+	)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	htmlStatus := classifySeznamDocument(doc)
+	if htmlStatus != nil && !errors.Is(htmlStatus, core.ErrEmptyResult) {
+		return nil, htmlStatus
+	}
+
+	parsedResults := parseSeznamDocument(doc, core.NewRankState(pageNum))
+	if len(parsedResults) == 0 {
+		if errors.Is(htmlStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, fmt.Errorf("%w: seznam raw search returned no parseable results", core.ErrParser)
+	}
+	parsedResults = core.AttachFeaturesToFirstResult(parsedResults, extractSeznamFeatures(doc))
+	parsedResults = core.AttachSerpMetaToFirstResult(parsedResults, parseSeznamSerpMeta(doc))
+
+	core.WithRequest(ctx).WithField("results_count", len(parsedResults)).Debug(
+		fmt.Sprintf("Seznam Raw results : %v", parsedResults),
+	)
+
+	return core.StripResultFeatures(parsedResults, query.Features), nil
+}
@@ -0,0 +1,130 @@
+package seznam
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values, string)
+	}{
+		{
+			name:  "basic search omits paging",
+			query: core.Query{Text: "pivo"},
+			check: func(t *testing.T, params url.Values, host string) {
+				t.Helper()
+				if host != "search.seznam.cz" {
+					t.Fatalf("unexpected host: %s", host)
+				}
+				if got := params.Get("q"); got != "pivo" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				for _, key := range []string{"from", "count", "stari"} {
+					if got := params.Get(key); got != "" {
+						t.Fatalf("expected no %s param, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, filetype and pagination",
+			query: core.Query{Text: "výroční zpráva", Site: "cnb.cz", Filetype: "pdf"},
+			page:  2,
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("q"); got != "výroční zpráva site:cnb.cz filetype:pdf" {
+					t.Fatalf("unexpected q: %q", got)
+				}
+				if got := params.Get("from"); got != "20" {
+					t.Fatalf("unexpected from: %q", got)
+				}
+				if got := params.Get("count"); got != "10" {
+					t.Fatalf("unexpected count: %q", got)
+				}
+			},
+		},
+		{
+			name:  "locale is not encoded",
+			query: core.Query{Text: "pivo", LangCode: "en", Region: "DE"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if len(params) != 1 {
+					t.Fatalf("expected only q, got %v", params)
+				}
+			},
+		},
+		{
+			name:  "date interval buckets into stari",
+			query: core.Query{Text: "zprávy", DateInterval: "20240101..20240301"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("stari"); got != "rok" {
+					t.Fatalf("unexpected stari: %q", got)
+				}
+			},
+		},
+		{
+			name:    "end before start errors",
+			query:   core.Query{Text: "zprávy", DateInterval: "20240301..20240101"},
+			wantErr: true,
+		},
+		{
+			name:    "negative page errors",
+			query:   core.Query{Text: "pivo"},
+			page:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed.Query(), parsed.Host)
+			}
+		})
+	}
+}
+
+func TestBuildImageURL(t *testing.T) {
+	got, err := BuildImageURL(core.Query{Text: "karlův most", Site: "prague.eu", Filetype: "pdf"})
+	if err != nil {
+		t.Fatalf("BuildImageURL() error = %v", err)
+	}
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("BuildImageURL() returned invalid URL: %v", err)
+	}
+	if parsed.Host != "obrazky.seznam.cz" {
+		t.Fatalf("unexpected image host: %s", parsed.Host)
+	}
+	if q := parsed.Query().Get("q"); q != "karlův most site:prague.eu" {
+		t.Fatalf("unexpected image q: %q", q)
+	}
+
+	if _, err := BuildImageURL(core.Query{Text: "most", DateInterval: "week"}); err == nil {
+		t.Fatal("expected error for malformed date interval")
+	}
+}
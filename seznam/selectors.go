@@ -0,0 +1,58 @@
+package seznam
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Seznam SERP CSS selectors.
+// Seznam's class names are build hashes, so entries key off the data-dot
+// analytics attributes, which have stayed stable across redesigns.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	AdContainer    string
+	AdLabels       []string
+	Title          string
+	Link           string
+	Desc           string
+	ImageResult    string
+	ImageLink      string
+	ImageSource    string
+	ImageDims      string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Sitelinks of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	Captcha: "form[action*='captcha'], [data-dot='captcha']",
+	CaptchaMarkers: []string{
+		"nejste robot",
+		"neobvyklý provoz",
+	},
+	NoResults:    "[data-dot='noResults']",
+	EmptyMarkers: []string{"nenašli jsme žádné výsledky", "nebyly nalezeny žádné výsledky"},
+	// Results matches Sklik (sponsored) blocks above and below the organic
+	// list together with organic rows, in DOM order.
+	Results:     "[data-dot='sklik-top'] > div, [data-dot='results'] > div[data-dot-data], [data-dot='sklik-bottom'] > div",
+	AdContainer: "[data-dot^='sklik']",
+	AdLabels:    []string{"Reklama", "Sponzorováno"},
+	Title:       "h3",
+	Link:        "h3 a[href]",
+	Desc:        "[data-dot='snippet'], p",
+	ImageResult: "[data-dot='img']",
+	ImageLink:   "a[href]",
+	ImageSource: "[data-dot='imgSource']",
+	ImageDims:   "[data-dot='imgSize']",
+	// SpellCorrected is "Zobrazujeme výsledky pro …" (Seznam already ran
+	// the fixed query); SpellSuggested is "Mysleli jste …".
+	SpellCorrected: "[data-dot='spellCorrected'] a",
+	SpellSuggested: "[data-dot='spellSuggested'] a",
+	SearchBox:      "input[name='q']",
+	// Extras: sitelinks sit in their own data-dot block under the snippet.
+	Extras: core.ResultExtrasSelectors{
+		Sitelink: "[data-dot='sitelinks'] a[href]",
+	},
+}
@@ -0,0 +1,75 @@
+package seznam
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name: "sitelinks skip the result's own URL",
+			html: string(fixture),
+			url:  "https://www.prazdroj.cz/",
+			wantSitelinks: []core.Sitelink{
+				{Title: "Pivovar", URL: "https://www.prazdroj.cz/pivovar"},
+				{Title: "Prohlídky", URL: "https://www.prazdroj.cz/prohlidky"},
+			},
+		},
+		{
+			name:     "snippet date",
+			html:     string(fixture),
+			url:      "https://www.pivnidenicek.cz/",
+			wantRich: &core.RichSnippet{Date: "05.03.2024", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name: "result without extras",
+			html: string(fixture),
+			url:  "https://cs.wikipedia.org/wiki/Pivo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package seznam
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+const (
+	baseURL   = "https://search.seznam.cz/"
+	imagesURL = "https://obrazky.seznam.cz/"
+)
+
+// seznamPeriods is Seznam's stari (age) parameter per span, in Czech.
+var seznamPeriods = map[core.DateSpan]string{
+	core.DateSpanDay:   "den",
+	core.DateSpanWeek:  "tyden",
+	core.DateSpanMonth: "mesic",
+	core.DateSpanYear:  "rok",
+}
+
+// seznamPeriod maps a DateInterval to Seznam's stari value.
+func seznamPeriod(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return seznamPeriods[span], nil
+}
+
+// Operators is Seznam's spelling of the structured query operators. Only
+// phrases and exclusions are understood.
+var Operators = core.OperatorSyntax{
+	Phrase:  true,
+	Exclude: "-",
+}
+
+// seznamQueryText appends the site: operator Seznam understands, plus
+// filetype: and the structured operators for web search.
+func seznamQueryText(q core.Query, web bool) (string, error) {
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if web {
+		if q.Filetype != "" {
+			text += " filetype:" + q.Filetype
+		}
+		operators, _ := Operators.Compile(q)
+		text += operators
+	}
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+	return text, nil
+}
+
+// SupportsSafeSearch reports whether Seznam URLs can carry level. Seznam's
+// filter is a saved account setting with no URL parameter, so no explicit level
+// can be honoured.
+func SupportsSafeSearch(core.SafeSearch) bool {
+	return false
+}
+
+// BuildURL builds a Seznam web search URL for the supplied query and 0-based
+// page index. Pagination uses from=, the 0-based offset of the first result.
+// q.LangCode/Region are not encoded: Seznam serves a single Czech index and
+// has no language or market parameter, so locale only reaches it through the
+// profile's Accept-Language.
+func BuildURL(q core.Query, page int) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	text, err := seznamQueryText(q, true)
+	if err != nil {
+		return "", err
+	}
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+
+	params := url.Values{}
+	params.Set("q", text)
+
+	period, err := seznamPeriod(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if period != "" {
+		params.Set("stari", period)
+	}
+
+	if page > 0 {
+		params.Set("count", strconv.Itoa(seznamPageSize))
+		params.Set("from", strconv.Itoa(page*seznamPageSize))
+	}
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildImageURL builds a Seznam image search (Obrázky) URL. Like the web SERP
+// it has no locale parameter, and it has no date filter either, so
+// q.DateInterval is only validated.
+func BuildImageURL(q core.Query) (string, error) {
+	base, err := url.Parse(imagesURL)
+	if err != nil {
+		return "", err
+	}
+	text, err := seznamQueryText(q, false)
+	if err != nil {
+		return "", err
+	}
+	if _, err := seznamPeriod(q.DateInterval); err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("q", text)
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
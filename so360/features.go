@@ -0,0 +1,40 @@
+package so360
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// so360FeatureSelectors are the SERP modules extractSo360Features reads; their
+// containers are also measured for layout=true.
+var so360FeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"#side .mh-wrap", "#side .mohe-cont"},
+		TitleSelector: []string{"h3", "h2"},
+		TextSelector:  []string{".mh-detail", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.65,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"#rs", ".rs-wrap"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.75,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a 360 Search SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, so360FeatureSelectors)
+}
+
+func extractSo360Features(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, so360FeatureSelectors)
+}
@@ -0,0 +1,191 @@
+package so360
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a 360 Search SERP HTML document and returns search
+// results. GBK documents are decoded from their <meta charset>. No network
+// I/O, so rows without a data-mdurl keep their so.com/link redirect.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := newSo360Document(data, "")
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifySo360Document(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseSo360Document(doc, core.NewRankState(0))
+	results = core.AttachFeaturesToFirstResult(results, extractSo360Features(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseSo360SerpMeta(doc)), nil
+}
+
+// newSo360Document decodes body to UTF-8 (contentType takes precedence over
+// the <meta> prescan when set) and builds a goquery document from it.
+func newSo360Document(body []byte, contentType string) (*goquery.Document, error) {
+	decoded, err := core.DecodeHTMLBody(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(decoded))
+}
+
+func classifySo360Document(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// isSo360CaptchaRedirect reports whether a raw response is the redirect to
+// qcaptcha.so.com that 360 serves instead of a SERP once it flags the client.
+func isSo360CaptchaRedirect(res *http.Response) bool {
+	if res.StatusCode < 300 || res.StatusCode >= 400 {
+		return false
+	}
+	return strings.Contains(res.Header.Get("Location"), "qcaptcha")
+}
+
+// parseSo360Document walks result rows in DOM order. rank carries the
+// organic/ad/absolute counters so the browser path can continue them across
+// pages.
+func parseSo360Document(doc *goquery.Document, rank *core.RankState) []core.SearchResult {
+	var results []core.SearchResult
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		link := item.Find(Selectors.Link).First()
+		href, _ := link.Attr("href")
+		target, ok := link.Attr("data-mdurl")
+		if !ok {
+			target, _ = link.Attr("data-url")
+		}
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleSo360Row(href, target, title, desc, so360SelectionIsAd(item), rank); ok {
+			if !res.Ad {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			}
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleSo360Row validates an already-extracted row and reserves its rank.
+// target (data-mdurl/data-url) wins over href; otherwise the so.com/link
+// redirect is unwrapped from its url= parameter when present, or kept as-is
+// for the raw path to resolve.
+func assembleSo360Row(href, target, title, desc string, ad bool, rank *core.RankState) (core.SearchResult, bool) {
+	link := strings.TrimSpace(target)
+	if !core.IsHTTPURL(link) {
+		link = unwrapSo360URL(absoluteSo360URL(strings.TrimSpace(href)))
+	}
+	if link == "" || title == "" {
+		return core.SearchResult{}, false
+	}
+	resultRank, absoluteRank := rank.Next(ad)
+	return core.SearchResult{
+		Rank:         resultRank,
+		AbsoluteRank: absoluteRank,
+		URL:          link,
+		Title:        title,
+		Description:  desc,
+		Ad:           ad,
+	}, true
+}
+
+// absoluteSo360URL returns href as an absolute URL. Relative hrefs are only
+// kept when they are /link redirects; other on-site links (verticals, related
+// searches) are not results.
+func absoluteSo360URL(href string) string {
+	if core.IsHTTPURL(href) {
+		return href
+	}
+	if !strings.HasPrefix(href, "/link?") {
+		return ""
+	}
+	base, _ := url.Parse(homeURL)
+	ref, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(ref).String()
+}
+
+// unwrapSo360URL returns the url= destination of a so.com/link redirect, or
+// link unchanged when it is not one or carries only the opaque m= token.
+func unwrapSo360URL(link string) string {
+	if !isSo360Redirect(link) {
+		return link
+	}
+	u, _ := url.Parse(link)
+	if target := u.Query().Get("url"); core.IsHTTPURL(target) {
+		return target
+	}
+	return link
+}
+
+// isSo360Redirect reports whether link is still a 360 Search click redirect.
+func isSo360Redirect(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return (host == "so.com" || strings.HasSuffix(host, ".so.com")) && u.Path == "/link"
+}
+
+// resolveSo360Links replaces the /link redirects parsing could not unwrap
+// with their destinations. Resolution is best-effort: a failed hop keeps the
+// redirect rather than dropping the row.
+func resolveSo360Links(ctx context.Context, results []core.SearchResult, query core.Query) []core.SearchResult {
+	for i := range results {
+		if !isSo360Redirect(results[i].URL) {
+			continue
+		}
+		target, err := core.ResolveWrappedLink(ctx, results[i].URL, query)
+		if err != nil {
+			core.WithRequest(ctx).WithError(err).Debug("360 Search redirect left unresolved")
+			continue
+		}
+		results[i].URL = target
+	}
+	return results
+}
+
+func so360SelectionIsAd(item *goquery.Selection) bool {
+	if item.Closest(Selectors.AdContainer).Length() > 0 {
+		return true
+	}
+	isAd := false
+	item.Find("span, i, em").EachWithBreak(func(_ int, marker *goquery.Selection) bool {
+		text := strings.TrimSpace(marker.Text())
+		for _, label := range Selectors.AdLabels {
+			if text == label {
+				isAd = true
+				return false
+			}
+		}
+		return true
+	})
+	return isAd
+}
@@ -0,0 +1,142 @@
+package so360
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseSo360HTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var organic []core.SearchResult
+	var ads []core.SearchResult
+	for _, r := range results {
+		if r.Ad {
+			ads = append(ads, r)
+		} else {
+			organic = append(organic, r)
+		}
+	}
+	if len(ads) != 1 || len(organic) != 3 {
+		t.Fatalf("expected 1 ad and 3 organic results, got %d ads, %d organic", len(ads), len(organic))
+	}
+	testutil.AssertSequentialRanks(t, organic)
+	testutil.AssertFirstResultFilled(t, organic)
+
+	if ads[0].URL != "https://www.hotpot-chain.example.cn/" {
+		t.Fatalf("expected ad data-url to win over eclk link, got %s", ads[0].URL)
+	}
+	wantURLs := []string{
+		"https://baike.so.com/doc/5373928-5609826.html",
+		"https://www.dianping.com/search/keyword/2/0_火锅",
+		"https://www.so.com/link?m=uT7ZpQ2xL",
+	}
+	for i, want := range wantURLs {
+		if organic[i].URL != want {
+			t.Fatalf("organic[%d].URL = %s, want %s", i, organic[i].URL, want)
+		}
+	}
+	if organic[1].Description == "" {
+		t.Fatal("expected rich-card description to be parsed")
+	}
+	assertFeatureType(t, results, core.ResultTypeRelatedSearches)
+}
+
+func TestParseSo360HTMLDecodesGBK(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results_gbk.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Title != "西湖龙井_360百科" {
+		t.Fatalf("expected decoded GBK title, got %q", results[0].Title)
+	}
+}
+
+func TestUnwrapSo360URL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"https://www.so.com/link?m=abc&url=https%3A%2F%2Fexample.com%2Fa": "https://example.com/a",
+		"https://www.so.com/link?m=abc":                                   "https://www.so.com/link?m=abc",
+		"https://example.com/link?url=https%3A%2F%2Fother.com":            "https://example.com/link?url=https%3A%2F%2Fother.com",
+	}
+	for link, want := range tests {
+		if got := unwrapSo360URL(link); got != want {
+			t.Fatalf("unwrapSo360URL(%q) = %q, want %q", link, got, want)
+		}
+	}
+}
+
+func TestParseSo360HTMLEmpty(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(bytes.NewReader([]byte("")))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected zero results for empty HTML, got %d", len(results))
+	}
+}
+
+func assertFeatureType(t *testing.T, results []core.SearchResult, want core.ResultType) {
+	t.Helper()
+	for _, result := range results {
+		for _, feature := range result.Features {
+			if feature.Type == want {
+				return
+			}
+		}
+	}
+	t.Fatalf("expected feature type %q in %#v", want, results)
+}
+
+func TestParseSo360HTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<p id="so-correct">以下是<a class="correct-word" href="/s?q=python">python</a>的搜索结果</p>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.CorrectedQuery != "python" {
+		t.Errorf("CorrectedQuery = %q, want python", meta.CorrectedQuery)
+	}
+	if meta.EffectiveQuery != "python" {
+		t.Errorf("EffectiveQuery = %q, want the corrected query", meta.EffectiveQuery)
+	}
+}
@@ -0,0 +1,164 @@
+// Package so360 implements a 360 Search SERP scraper (web search).
+//
+// 360 Search (https://www.so.com/) is Qihoo 360's engine and the
+// second-largest in mainland China by share after Baidu. It is far less
+// aggressive about captcha-walling datacenter traffic than Baidu.
+package so360
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+// so360PageSize is the organic-results-per-page count on the web SERP.
+const so360PageSize = 10
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index, rounding off-grid offsets down to a page boundary.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / so360PageSize, nil
+}
+
+// So360 implements core.SearchEngine for 360 Search SERP pages.
+type So360 struct {
+	core.Browser
+	core.SearchEngineOptions
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a 360 Search engine instance with browser/runtime options applied.
+func New(browser core.Browser, opts core.SearchEngineOptions) *So360 {
+	s := So360{Browser: browser}
+	opts.Init()
+	s.SearchEngineOptions = opts
+	s.logger = core.NewEngineLogger("So360")
+	s.pageSleep = time.Second
+	return &s
+}
+
+// Name returns the stable engine identifier.
+func (s *So360) Name() string { return "so360" }
+
+// Search executes a 360 Search web search and returns normalized search
+// results. It may return core.ErrCaptcha or core.ErrSearchTimeout.
+func (s *So360) Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, s.Name(), false)
+	scoped := *s
+	scoped.logger = s.logger.WithRequest(ctx)
+	s = &scoped
+
+	s.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	firstPage := pageNum
+	// One RankState spans all pages so organic ranks keep counting across
+	// pages while ads keep their own sequence.
+	rank := core.NewRankState(pageNum)
+	all := []core.SearchResult{}
+	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
+
+	// fetchPage loads one SERP page and appends parsed results.
+	// Returns (done, error): done=true ends the outer loop without error.
+	fetchPage := func() (bool, error) {
+		u, err := BuildURL(query, pageNum)
+		if err != nil {
+			return false, err
+		}
+
+		page, err := s.Navigate(ctx, u)
+		if err != nil {
+			return false, err
+		}
+		defer core.DeferClosePage(ctx, page, &s.Browser)()
+
+		waitFor := []string{Selectors.Results, Selectors.NoResults, Selectors.Captcha}
+		if _, _, err := core.WaitForElements(ctx, page, waitFor, s.GetSelectorTimeout()); err != nil {
+			if pageErr := core.ClassifyFromPage(page, classifySo360Document); pageErr != nil {
+				if errors.Is(pageErr, core.ErrEmptyResult) {
+					return true, nil
+				}
+				s.logger.Error("Page classified as %v: %s", pageErr, u)
+				core.ArchivePage(ctx, page)
+				return false, pageErr
+			}
+			if core.IsContextDone(err) {
+				return false, err
+			}
+			core.ArchivePage(ctx, page)
+			return false, core.ErrSearchTimeout
+		}
+
+		// Archive the page before StampLayout marks it, then measure before
+		// snapshotting so result rows read their boxes.
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
+		doc, err := core.DocumentFromPage(page)
+		if err != nil {
+			return false, core.ErrParser
+		}
+		if pageErr := classifySo360Document(doc); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				s.logger.Debug("No results on page %d", pageNum)
+				return true, nil
+			}
+			s.logger.Error("Page classified as %v: %s", pageErr, u)
+			return false, pageErr
+		}
+
+		rows := parseSo360Document(doc, rank)
+		if len(rows) == 0 {
+			s.logger.Debug("No parseable results on page %d", pageNum)
+			return true, nil
+		}
+		all = append(all, rows...)
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = parseSo360SerpMeta(doc)
+		}
+		if query.Features && pageNum == firstPage {
+			pageFeatures = extractSo360Features(doc)
+		}
+		return false, nil
+	}
+
+	for core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+		done, err := fetchPage()
+		if err != nil {
+			return nil, err
+		}
+		pageNum++
+		if done || !core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+			break
+		}
+		if err := core.SleepContext(ctx, s.pageSleep); err != nil {
+			return nil, err
+		}
+	}
+
+	deduped := core.DeduplicateResults(all)
+	if query.Limit > 0 {
+		deduped = core.LimitOrganicResults(deduped, query.Limit)
+	}
+	s.logger.Info("Search completed: %d results", len(deduped))
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
+}
+
+// SearchImage is not implemented: image.so.com loads its grid from a
+// separate JSON API this package does not scrape.
+func (s *So360) SearchImage(_ context.Context, _ core.Query) ([]core.SearchResult, error) {
+	return nil, errors.New("image search is not supported for so360")
+}
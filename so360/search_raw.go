@@ -0,0 +1,66 @@
+package so360
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+func Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, "so360", false)
+
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL, err := BuildURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", searchURL).Debug(fmt.Sprintf("360 Search URL built: %s", searchURL))
+
+	res, err := core.RawSearchRequest(ctx, searchURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+	core.WithRequest(ctx).WithField("status_code", res.StatusCode).Debug(
+		fmt.Sprintf("360 Search Raw response: code=%d", res.StatusCode),
+	)
+	if isSo360CaptchaRedirect(res) {
+		return nil, core.ErrCaptcha
+	}
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := newSo360Document(body, res.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	htmlStatus := classifySo360Document(doc)
+	if htmlStatus != nil && !errors.Is(htmlStatus, core.ErrEmptyResult) {
+		return nil, htmlStatus
+	}
+
+	parsedResults := parseSo360Document(doc, core.NewRankState(pageNum))
+	if len(parsedResults) == 0 {
+		if errors.Is(htmlStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, fmt.Errorf("%w: so360 raw search returned no parseable results", core.ErrParser)
+	}
+	parsedResults = resolveSo360Links(ctx, parsedResults, query)
+	parsedResults = core.AttachFeaturesToFirstResult(parsedResults, extractSo360Features(doc))
+	parsedResults = core.AttachSerpMetaToFirstResult(parsedResults, parseSo360SerpMeta(doc))
+
+	core.WithRequest(ctx).WithField("results_count", len(parsedResults)).Debug(
+		fmt.Sprintf("360 Search Raw results : %v", parsedResults),
+	)
+
+	return core.StripResultFeatures(parsedResults, query.Features), nil
+}
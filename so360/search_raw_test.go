@@ -0,0 +1,77 @@
+package so360
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestSo360ClassifyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    error
+	}{
+		{"search_no_results.html", core.ErrEmptyResult},
+		{"search_captcha.html", core.ErrCaptcha},
+		{"search_results.html", nil},
+		{"search_results_gbk.html", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := io.ReadAll(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			if err != nil {
+				t.Fatalf("read fixture body: %v", err)
+			}
+			doc, err := newSo360Document(body, "")
+			if err != nil {
+				t.Fatalf("decode fixture: %v", err)
+			}
+
+			got := classifySo360Document(doc)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil for %s, got %v", tt.fixture, got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.want) {
+				t.Fatalf("expected %v for %s, got %v", tt.want, tt.fixture, got)
+			}
+		})
+	}
+}
+
+func TestIsSo360CaptchaRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		location string
+		want     bool
+	}{
+		{"qcaptcha redirect", http.StatusFound, "https://qcaptcha.so.com/?ret=https%3A%2F%2Fwww.so.com%2Fs%3Fq%3Dtest", true},
+		{"other redirect", http.StatusFound, "https://www.so.com/s?q=test&pn=2", false},
+		{"serp", http.StatusOK, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			if tt.location != "" {
+				res.Header.Set("Location", tt.location)
+			}
+			if got := isSo360CaptchaRedirect(res); got != tt.want {
+				t.Fatalf("isSo360CaptchaRedirect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package so360
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for 360 Search SERP CSS selectors.
+// Both the browser path (search.go) and the HTML parser (parse_html.go) read
+// these.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	AdContainer    string
+	AdLabels       []string
+	Title          string
+	Link           string
+	Desc           string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Breadcrumbs and the page date of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	// Captcha matches the qcaptcha.so.com verification page.
+	Captcha: "form[action*='qcaptcha'], img[src*='qcaptcha'], #captcha-form",
+	CaptchaMarkers: []string{
+		"系统检测到您的访问异常",
+		"为了保障您的正常访问",
+		"请输入验证码",
+	},
+	NoResults:    "#no-result, .no-result",
+	EmptyMarkers: []string{"抱歉，未找到和", "找不到和您查询的"},
+	// Results matches the sponsored lists (top and bottom) and organic rows in
+	// DOM order.
+	Results:     "#e_idea_pp > li, ul.result > li.res-list, #e_idea_pp_vip_bottom > li",
+	AdContainer: "#e_idea_pp, #e_idea_pp_vip_bottom",
+	AdLabels:    []string{"广告", "推广"},
+	Title:       "h3",
+	// Link's data-mdurl (organic) or data-url (sponsored) carries the
+	// destination behind the so.com/link redirect in href.
+	Link: "h3 a[href]",
+	Desc: "p.res-desc, .res-comm-con, .res-rich p",
+	// SpellCorrected is the "以下是…的搜索结果" notice (360 Search already
+	// ran the fixed query); SpellSuggested is "您是不是要找".
+	SpellCorrected: "#so-correct .correct-word",
+	SpellSuggested: "#so-suggest a",
+	SearchBox:      "input#keyword, input[name='q']",
+	// Extras: the g-linkinfo line holds the display URL cite followed by the
+	// page date.
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "p.g-linkinfo cite, p.res-linkinfo cite",
+		Date:       "p.g-linkinfo span.gray, p.res-linkinfo span.gray",
+	},
+}
@@ -0,0 +1,74 @@
+package so360
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "linkinfo cite and date",
+			html:            string(fixture),
+			url:             "https://baike.so.com/doc/5373928-5609826.html",
+			wantBreadcrumbs: []string{"baike.so.com", "doc", "5373928-5609826.html"},
+			wantRich:        &core.RichSnippet{Date: "2024年3月5日", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name:            "res-linkinfo variant",
+			html:            strings.Replace(string(fixture), `class="g-linkinfo"`, `class="res-linkinfo"`, 1),
+			url:             "https://baike.so.com/doc/5373928-5609826.html",
+			wantBreadcrumbs: []string{"baike.so.com", "doc", "5373928-5609826.html"},
+			wantRich:        &core.RichSnippet{Date: "2024年3月5日", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name: "row without a linkinfo line",
+			html: string(fixture),
+			url:  "https://www.dianping.com/search/keyword/2/0_火锅",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package so360
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+const (
+	homeURL = "https://www.so.com/"
+	baseURL = "https://www.so.com/s"
+)
+
+// so360Periods is 360 Search's adv_t parameter per span.
+var so360Periods = map[core.DateSpan]string{
+	core.DateSpanDay:   "d",
+	core.DateSpanWeek:  "w",
+	core.DateSpanMonth: "m",
+	core.DateSpanYear:  "y",
+}
+
+// so360Period maps a DateInterval to 360 Search's adv_t value.
+func so360Period(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return so360Periods[span], nil
+}
+
+// Operators is 360 Search's spelling of the structured query operators. Like
+// Baidu it uses | for OR and has no -site: exclusion.
+var Operators = core.OperatorSyntax{
+	Phrase:  true,
+	Exclude: "-",
+	InTitle: "intitle:",
+	InURL:   "inurl:",
+	Or:      " | ",
+}
+
+// SupportsSafeSearch reports whether 360 Search URLs can carry level. 360
+// Search has no adult-content filter parameter; its index is filtered
+// server-side for the mainland market, so no explicit level can be honoured.
+func SupportsSafeSearch(core.SafeSearch) bool {
+	return false
+}
+
+// BuildURL builds a 360 Search web search URL for the supplied query and
+// 0-based page index. Pagination uses the 1-based pn= page number.
+// q.LangCode/Region are not encoded: 360 only serves the mainland Chinese
+// index.
+func BuildURL(q core.Query, page int) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	operators, _ := Operators.Compile(q)
+	text += operators
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+
+	params := url.Values{}
+	params.Set("q", text)
+	params.Set("ie", "utf-8")
+
+	period, err := so360Period(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if period != "" {
+		params.Set("adv_t", period)
+	}
+
+	if page > 0 {
+		params.Set("pn", strconv.Itoa(page+1))
+	}
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
@@ -0,0 +1,40 @@
+package sogou
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// sogouFeatureSelectors are the SERP modules extractSogouFeatures reads; their
+// containers are also measured for layout=true.
+var sogouFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"#right .vr-baike", "#right .kmap"},
+		TitleSelector: []string{"h3", "h2"},
+		TextSelector:  []string{".baike-text", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.65,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"#hint_container", ".hint-mid"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.75,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Sogou SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, sogouFeatureSelectors)
+}
+
+func extractSogouFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, sogouFeatureSelectors)
+}
@@ -0,0 +1,174 @@
+package sogou
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a Sogou SERP HTML document and returns search results.
+// GBK documents are decoded from their <meta charset>. No network I/O, so
+// rows without a data-url keep their sogou.com/link redirect.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := newSogouDocument(data, "")
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifySogouDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseSogouDocument(doc, core.NewRankState(0))
+	results = core.AttachFeaturesToFirstResult(results, extractSogouFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseSogouSerpMeta(doc)), nil
+}
+
+// newSogouDocument decodes body to UTF-8 (contentType takes precedence over
+// the <meta> prescan when set) and builds a goquery document from it.
+func newSogouDocument(body []byte, contentType string) (*goquery.Document, error) {
+	decoded, err := core.DecodeHTMLBody(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(decoded))
+}
+
+func classifySogouDocument(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// isSogouCaptchaRedirect reports whether a raw response is the 302 Sogou
+// answers with instead of a SERP once it flags the client.
+func isSogouCaptchaRedirect(res *http.Response) bool {
+	if res.StatusCode < 300 || res.StatusCode >= 400 {
+		return false
+	}
+	return strings.Contains(res.Header.Get("Location"), "antispider")
+}
+
+// parseSogouDocument walks result cards in DOM order. rank carries the
+// organic/ad/absolute counters so the browser path can continue them across
+// pages.
+func parseSogouDocument(doc *goquery.Document, rank *core.RankState) []core.SearchResult {
+	var results []core.SearchResult
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		href, _ := item.Find(Selectors.Link).First().Attr("href")
+		target, _ := item.Find(Selectors.TargetURL).First().Attr("data-url")
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleSogouRow(href, target, title, desc, sogouSelectionIsAd(item), rank); ok {
+			if !res.Ad {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			}
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleSogouRow validates an already-extracted row and reserves its rank.
+// target (the card's data-url) wins over href, which for organic rows is an
+// opaque /link?url= redirect; that redirect is kept absolute when no target
+// is present so the raw path can still resolve it.
+func assembleSogouRow(href, target, title, desc string, ad bool, rank *core.RankState) (core.SearchResult, bool) {
+	link := strings.TrimSpace(target)
+	if !core.IsHTTPURL(link) {
+		link = absoluteSogouURL(strings.TrimSpace(href))
+	}
+	if link == "" || title == "" {
+		return core.SearchResult{}, false
+	}
+	resultRank, absoluteRank := rank.Next(ad)
+	return core.SearchResult{
+		Rank:         resultRank,
+		AbsoluteRank: absoluteRank,
+		URL:          link,
+		Title:        title,
+		Description:  desc,
+		Ad:           ad,
+	}, true
+}
+
+// absoluteSogouURL returns href as an absolute URL. Relative hrefs are only
+// kept when they are /link redirects; other on-site links (verticals, related
+// searches) are not results.
+func absoluteSogouURL(href string) string {
+	if core.IsHTTPURL(href) {
+		return href
+	}
+	if !strings.HasPrefix(href, "/link?") {
+		return ""
+	}
+	base, _ := url.Parse(homeURL)
+	ref, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(ref).String()
+}
+
+// isSogouRedirect reports whether link is still a Sogou click redirect.
+func isSogouRedirect(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return (host == "sogou.com" || strings.HasSuffix(host, ".sogou.com")) && u.Path == "/link"
+}
+
+// resolveSogouLinks replaces the /link redirects parsing could not unwrap
+// with their destinations. Resolution is best-effort: a failed hop keeps the
+// redirect rather than dropping the row.
+func resolveSogouLinks(ctx context.Context, results []core.SearchResult, query core.Query) []core.SearchResult {
+	for i := range results {
+		if !isSogouRedirect(results[i].URL) {
+			continue
+		}
+		target, err := core.ResolveWrappedLink(ctx, results[i].URL, query)
+		if err != nil {
+			core.WithRequest(ctx).WithError(err).Debug("Sogou redirect left unresolved")
+			continue
+		}
+		results[i].URL = target
+	}
+	return results
+}
+
+func sogouSelectionIsAd(item *goquery.Selection) bool {
+	if item.Is(Selectors.Ad) || item.Find(Selectors.Ad).Length() > 0 {
+		return true
+	}
+	isAd := false
+	item.Find("span, i, em").EachWithBreak(func(_ int, marker *goquery.Selection) bool {
+		text := strings.TrimSpace(marker.Text())
+		for _, label := range Selectors.AdLabels {
+			if text == label {
+				isAd = true
+				return false
+			}
+		}
+		return true
+	})
+	return isAd
+}
@@ -0,0 +1,161 @@
+package sogou
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseSogouHTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var organic []core.SearchResult
+	var ads []core.SearchResult
+	for _, r := range results {
+		if r.Ad {
+			ads = append(ads, r)
+		} else {
+			organic = append(organic, r)
+		}
+	}
+	if len(ads) != 1 || len(organic) != 4 {
+		t.Fatalf("expected 1 ad and 4 organic results, got %d ads, %d organic", len(ads), len(organic))
+	}
+	testutil.AssertSequentialRanks(t, organic)
+	testutil.AssertFirstResultFilled(t, organic)
+
+	if ads[0].URL != "https://franchise.example.cn/hotpot" {
+		t.Fatalf("expected ad data-url to win over bill_cpc link, got %s", ads[0].URL)
+	}
+	wantURLs := []string{
+		"https://baike.baidu.com/item/%E7%81%AB%E9%94%85/14316",
+		"https://www.xiachufang.com/recipe/100012345/",
+		"https://www.sogou.com/link?url=ZD2X5iBlWq7qsOr0YUQ1jRMGeeqDJpZ1",
+		"https://www.zhihu.com/question/20318421",
+	}
+	for i, want := range wantURLs {
+		if organic[i].URL != want {
+			t.Fatalf("organic[%d].URL = %s, want %s", i, organic[i].URL, want)
+		}
+	}
+	if organic[0].Rank != 1 || organic[0].AbsoluteRank != 2 {
+		t.Fatalf("expected first organic row after the ad, got rank=%d absolute=%d", organic[0].Rank, organic[0].AbsoluteRank)
+	}
+	assertFeatureType(t, results, core.ResultTypeRelatedSearches)
+}
+
+func TestParseSogouHTMLDecodesGBK(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results_gbk.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].Title != "西湖龙井_百科" {
+		t.Fatalf("expected decoded GBK title, got %q", results[0].Title)
+	}
+	if results[1].URL != "https://www.puer.example.cn/brew.html" {
+		t.Fatalf("unexpected second URL: %s", results[1].URL)
+	}
+}
+
+func TestParseSogouHTMLMobile(t *testing.T) {
+	t.Parallel()
+
+	html := `<div class="results">
+  <div class="vrResult"><a class="resultLink" href="https://m.example.cn/recipe"><h3 class="vr-title">手机火锅</h3></a></div>
+</div>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 1 || results[0].URL != "https://m.example.cn/recipe" || results[0].Title != "手机火锅" {
+		t.Fatalf("unexpected mobile results: %+v", results)
+	}
+}
+
+func TestIsSogouRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		"https://www.sogou.com/link?url=abc":   true,
+		"http://sogou.com/link?url=abc":        true,
+		"https://www.sogou.com/web?query=test": false,
+		"https://example.com/link?url=abc":     false,
+		"https://baike.baidu.com/item/x":       false,
+	}
+	for link, want := range tests {
+		if got := isSogouRedirect(link); got != want {
+			t.Fatalf("isSogouRedirect(%q) = %v, want %v", link, got, want)
+		}
+	}
+}
+
+func TestParseSogouHTMLEmpty(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(bytes.NewReader([]byte("")))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected zero results for empty HTML, got %d", len(results))
+	}
+}
+
+func assertFeatureType(t *testing.T, results []core.SearchResult, want core.ResultType) {
+	t.Helper()
+	for _, result := range results {
+		for _, feature := range result.Features {
+			if feature.Type == want {
+				return
+			}
+		}
+	}
+	t.Fatalf("expected feature type %q in %#v", want, results)
+}
+
+func TestParseSogouHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div class="qc-sug">您要找的是不是: <a href="/web?query=python">python</a></div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.SuggestedQuery != "python" {
+		t.Errorf("SuggestedQuery = %q, want python", meta.SuggestedQuery)
+	}
+}
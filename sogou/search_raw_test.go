@@ -0,0 +1,77 @@
+package sogou
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestSogouClassifyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    error
+	}{
+		{"search_no_results.html", core.ErrEmptyResult},
+		{"search_captcha.html", core.ErrCaptcha},
+		{"search_results.html", nil},
+		{"search_results_gbk.html", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := io.ReadAll(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			if err != nil {
+				t.Fatalf("read fixture body: %v", err)
+			}
+			doc, err := newSogouDocument(body, "")
+			if err != nil {
+				t.Fatalf("decode fixture: %v", err)
+			}
+
+			got := classifySogouDocument(doc)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil for %s, got %v", tt.fixture, got)
+				}
+				return
+			}
+			if !errors.Is(got, tt.want) {
+				t.Fatalf("expected %v for %s, got %v", tt.want, tt.fixture, got)
+			}
+		})
+	}
+}
+
+func TestIsSogouCaptchaRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		location string
+		want     bool
+	}{
+		{"antispider redirect", http.StatusFound, "https://www.sogou.com/antispider/?from=%2Fweb%3Fquery%3Dtest", true},
+		{"other redirect", http.StatusFound, "https://www.sogou.com/web?query=test", false},
+		{"serp", http.StatusOK, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			if tt.location != "" {
+				res.Header.Set("Location", tt.location)
+			}
+			if got := isSogouCaptchaRedirect(res); got != tt.want {
+				t.Fatalf("isSogouCaptchaRedirect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package sogou
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values, string)
+	}{
+		{
+			name:  "basic search omits page",
+			query: core.Query{Text: "火锅"},
+			check: func(t *testing.T, params url.Values, host string) {
+				t.Helper()
+				if host != "www.sogou.com" {
+					t.Fatalf("unexpected host: %s", host)
+				}
+				if got := params.Get("query"); got != "火锅" {
+					t.Fatalf("unexpected query: %q", got)
+				}
+				if got := params.Get("ie"); got != "utf8" {
+					t.Fatalf("unexpected ie: %q", got)
+				}
+				for _, key := range []string{"page", "tsn"} {
+					if got := params.Get(key); got != "" {
+						t.Fatalf("expected no %s param, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, filetype and pagination",
+			query: core.Query{Text: "年报", Site: "sse.com.cn", Filetype: "pdf"},
+			page:  2,
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("query"); got != "年报 site:sse.com.cn filetype:pdf" {
+					t.Fatalf("unexpected query: %q", got)
+				}
+				if got := params.Get("page"); got != "3" {
+					t.Fatalf("unexpected page: %q", got)
+				}
+			},
+		},
+		{
+			name:  "date interval buckets into tsn",
+			query: core.Query{Text: "新闻", DateInterval: "20240101..20240120"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("tsn"); got != "3" {
+					t.Fatalf("unexpected tsn: %q", got)
+				}
+			},
+		},
+		{
+			name:  "multi-year interval drops tsn",
+			query: core.Query{Text: "新闻", DateInterval: "20200101..20240101"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("tsn"); got != "" {
+					t.Fatalf("expected no tsn, got %q", got)
+				}
+			},
+		},
+		{
+			name:    "end before start errors",
+			query:   core.Query{Text: "新闻", DateInterval: "20240301..20240101"},
+			wantErr: true,
+		},
+		{
+			name:    "negative page errors",
+			query:   core.Query{Text: "新闻"},
+			page:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed.Query(), parsed.Host)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package sogou
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Sogou SERP CSS selectors.
+// Both the browser path (search.go) and the HTML parser (parse_html.go) read
+// these.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	Ad             string
+	AdLabels       []string
+	Title          string
+	Link           string
+	TargetURL      string
+	Desc           string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Breadcrumbs and the page date of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	// Captcha matches the antispider verification form Sogou redirects to.
+	Captcha: "form#seccodeForm, #seccodeImage, form[action*='antispider']",
+	CaptchaMarkers: []string{
+		"系统检测到您网络中存在异常访问请求",
+		"此验证码用于确认这些请求是您的正常行为",
+		"请输入验证码",
+	},
+	NoResults:    "#noresult_part1_container, .no-result",
+	EmptyMarkers: []string{"抱歉，没有找到与", "没有找到相关的网页"},
+	// Results matches sponsored and organic cards in DOM order; sponsored
+	// blocks render above and below the organic list. .vrResult cards are the
+	// mobile SERP (wap.sogou.com), where the result link wraps the heading.
+	Results:  "#promotion_adv_container .biz_rb, #main .results > .vrwrap, #main .results > .rb, #bottom_adv .biz_rb, .results > .vrResult",
+	Ad:       ".biz_rb, .biz_sponsor",
+	AdLabels: []string{"广告", "推广"},
+	Title:    "h3",
+	Link:     "h3 a[href], a.resultLink[href]",
+	// TargetURL carries the destination on cards whose visible link is a
+	// /link?url= redirect.
+	TargetURL: "[data-url]",
+	Desc:      ".star-wiki, .space-txt, .str-text-info, .str_info, .ft",
+	// SpellCorrected is the "已为您显示…的搜索结果" notice (Sogou already
+	// ran the fixed query); SpellSuggested is "您要找的是不是".
+	SpellCorrected: "#correct_box .correct-word, .qc-tip em",
+	SpellSuggested: "#sug_box a, .qc-sug a",
+	SearchBox:      "input#upquery, input[name='query']",
+	// Extras: the fb footer holds the display URL cite; the page date, when
+	// shown, is a separate span after it.
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "div.fb cite, div.citeurl",
+		Date:       "div.fb span.cite-date",
+	},
+}
+
+// SupportsDevice reports whether Sogou results can be parsed from device's
+// SERP on vertical. The .vrResult cards of wap.sogou.com cover web search
+// only.
+func SupportsDevice(device core.Device, vertical core.Vertical) bool {
+	return device != core.DeviceMobile || vertical == core.VerticalWeb
+}
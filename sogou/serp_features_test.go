@@ -0,0 +1,73 @@
+package sogou
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "fb footer cite and date",
+			html:            string(fixture),
+			url:             "https://www.xiachufang.com/recipe/100012345/",
+			wantBreadcrumbs: []string{"www.xiachufang.com", "recipe", "100012345"},
+			wantRich:        &core.RichSnippet{Date: "2024-03-05", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name:     "bare host cite is not a path",
+			html:     strings.Replace(string(fixture), "www.xiachufang.com › recipe › 100012345", "www.xiachufang.com", 1),
+			url:      "https://www.xiachufang.com/recipe/100012345/",
+			wantRich: &core.RichSnippet{Date: "2024-03-05", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name: "vrwrap card without a footer",
+			html: string(fixture),
+			url:  "https://www.zhihu.com/question/20318421",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package sogou
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+const (
+	homeURL = "https://www.sogou.com/"
+	baseURL = "https://www.sogou.com/web"
+)
+
+// sogouTimeSpans is Sogou's numeric tsn parameter per span.
+var sogouTimeSpans = map[core.DateSpan]string{
+	core.DateSpanDay:   "1",
+	core.DateSpanWeek:  "2",
+	core.DateSpanMonth: "3",
+	core.DateSpanYear:  "4",
+}
+
+// sogouTimeSpan maps a DateInterval to Sogou's tsn value.
+func sogouTimeSpan(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return sogouTimeSpans[span], nil
+}
+
+// Operators is Sogou's spelling of the structured query operators. Sogou
+// uses | for OR and has no -site: exclusion.
+var Operators = core.OperatorSyntax{
+	Phrase:  true,
+	Exclude: "-",
+	InTitle: "intitle:",
+	InURL:   "inurl:",
+	Or:      " | ",
+}
+
+// SupportsSafeSearch reports whether Sogou URLs can carry level. Sogou has no
+// adult-content filter parameter; its index is filtered server-side for the
+// mainland market, so no explicit level can be honoured.
+func SupportsSafeSearch(core.SafeSearch) bool {
+	return false
+}
+
+// BuildURL builds a Sogou web search URL for the supplied query and 0-based
+// page index. q.LangCode/Region are not encoded: Sogou only serves the
+// mainland Chinese index.
+func BuildURL(q core.Query, page int) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	operators, _ := Operators.Compile(q)
+	text += operators
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+
+	params := url.Values{}
+	params.Set("query", text)
+	// ie declares the query encoding; without it Sogou assumes GBK.
+	params.Set("ie", "utf8")
+
+	tsn, err := sogouTimeSpan(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if tsn != "" {
+		params.Set("tsn", tsn)
+	}
+
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page+1))
+	}
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
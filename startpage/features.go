@@ -0,0 +1,40 @@
+package startpage
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// startpageFeatureSelectors are the SERP modules extractStartpageFeatures reads; their
+// containers are also measured for layout=true.
+var startpageFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{"div.sx-kp", "div.knowledge-panel"},
+		TitleSelector: []string{"h2", "h3"},
+		TextSelector:  []string{".sx-kp-short-extract", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.7,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{"div.related-searches", "div.w-gl__related-searches"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.7,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Startpage SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, startpageFeatureSelectors)
+}
+
+func extractStartpageFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, startpageFeatureSelectors)
+}
@@ -0,0 +1,79 @@
+package startpage
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a Startpage SERP HTML document and returns search results.
+// No network I/O.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyStartpageDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseStartpageDocument(doc, 1)
+	results = core.AttachFeaturesToFirstResult(results, extractStartpageFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseStartpageSerpMeta(doc)), nil
+}
+
+func classifyStartpageDocument(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// scToken returns the homepage search form's sc value, or "" when absent.
+func scToken(doc *goquery.Document) string {
+	value, _ := doc.Find(Selectors.SCToken).First().Attr("value")
+	return strings.TrimSpace(value)
+}
+
+// parseStartpageDocument extracts organic rows ranked from startRank.
+// Startpage's sponsored results render inside third-party iframes that are
+// not part of the document, so every parsed row is organic.
+func parseStartpageDocument(doc *goquery.Document, startRank int) []core.SearchResult {
+	var results []core.SearchResult
+	rank := startRank
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		href, _ := item.Find(Selectors.Link).First().Attr("href")
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleStartpageRow(href, title, desc, rank); ok {
+			core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+			rank++
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleStartpageRow validates an already-extracted row and builds the result.
+func assembleStartpageRow(href, title, desc string, rank int) (core.SearchResult, bool) {
+	href = strings.TrimSpace(href)
+	if title == "" || !core.IsHTTPURL(href) {
+		return core.SearchResult{}, false
+	}
+	return core.SearchResult{
+		Rank:         rank,
+		AbsoluteRank: rank,
+		URL:          href,
+		Title:        title,
+		Description:  desc,
+	}, true
+}
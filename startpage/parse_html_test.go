@@ -0,0 +1,145 @@
+package startpage
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseStartpageHTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	// The relative related-search row and the duplicate go.dev row are dropped;
+	// the legacy w-gl__result row is still parsed.
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+	testutil.AssertFirstResultFilled(t, results)
+	if results[0].URL != "https://go.dev/" {
+		t.Fatalf("unexpected first URL: %s", results[0].URL)
+	}
+	if results[2].Title != "Go (programming language) - Wikipedia" {
+		t.Fatalf("unexpected legacy-layout title: %q", results[2].Title)
+	}
+	for _, r := range results {
+		if r.Ad {
+			t.Fatalf("unexpected ad row: %+v", r)
+		}
+	}
+}
+
+func TestStartpageClassifyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    error
+	}{
+		{"search_no_results.html", core.ErrEmptyResult},
+		{"search_captcha.html", core.ErrCaptcha},
+		{"search_results.html", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseHTML(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			switch {
+			case tt.want == core.ErrEmptyResult:
+				if err != nil || len(results) != 0 {
+					t.Fatalf("expected zero results for %s, got %d (err=%v)", tt.fixture, len(results), err)
+				}
+			case tt.want != nil:
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("expected %v for %s, got %v", tt.want, tt.fixture, err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("expected nil for %s, got %v", tt.fixture, err)
+				}
+			}
+		})
+	}
+}
+
+func TestStartpageSCToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    string
+	}{
+		{"home.html", "Vx9kQ2mTzL4a20"},
+		{"search_captcha.html", ""},
+		{"search_results.html", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, tt.fixture).Body)
+			if err != nil {
+				t.Fatalf("parse fixture: %v", err)
+			}
+			if got := scToken(doc); got != tt.want {
+				t.Fatalf("scToken() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStartpageHTMLEmpty(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(bytes.NewReader([]byte("")))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected zero results for empty HTML, got %d", len(results))
+	}
+}
+
+func TestParseStartpageHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div class="spelling-correction">Showing results for <a href="/sp/search?query=python">python</a></div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.CorrectedQuery != "python" {
+		t.Errorf("CorrectedQuery = %q, want python", meta.CorrectedQuery)
+	}
+	if meta.EffectiveQuery != "python" {
+		t.Errorf("EffectiveQuery = %q, want the corrected query", meta.EffectiveQuery)
+	}
+}
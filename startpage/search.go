@@ -0,0 +1,199 @@
+// Package startpage implements a Startpage SERP scraper (web search).
+//
+// Startpage (https://www.startpage.com/) is a Dutch privacy front-end that
+// serves Google's organic results without forwarding user identifiers.
+// Searches are form submits carrying a per-session sc token taken from the
+// homepage, so every search starts from there.
+package startpage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-rod/rod"
+	"github.com/karust/openserp/core"
+)
+
+// startpagePageSize is the organic-results-per-page count on the web SERP.
+const startpagePageSize = 10
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index, rounding off-grid offsets down to a page boundary.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / startpagePageSize, nil
+}
+
+// Startpage implements core.SearchEngine for Startpage SERP pages.
+type Startpage struct {
+	core.Browser
+	core.SearchEngineOptions
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a Startpage engine instance with browser/runtime options applied.
+func New(browser core.Browser, opts core.SearchEngineOptions) *Startpage {
+	s := Startpage{Browser: browser}
+	opts.Init()
+	s.SearchEngineOptions = opts
+	s.logger = core.NewEngineLogger("Startpage")
+	s.pageSleep = time.Second
+	return &s
+}
+
+// Name returns the stable engine identifier.
+func (s *Startpage) Name() string { return "startpage" }
+
+// waitDocument waits for any of selectors and snapshots the page. Pages that
+// never render them are classified, so captcha interstitials surface as
+// core.ErrCaptcha rather than a timeout.
+func (s *Startpage) waitDocument(ctx context.Context, page *rod.Page, selectors []string) (*goquery.Document, error) {
+	if _, _, err := core.WaitForElements(ctx, page, selectors, s.GetSelectorTimeout()); err != nil {
+		if pageErr := core.ClassifyFromPage(page, classifyStartpageDocument); pageErr != nil {
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	return doc, nil
+}
+
+// Search executes a Startpage web search and returns normalized search
+// results. It may return core.ErrCaptcha or core.ErrSearchTimeout.
+func (s *Startpage) Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, s.Name(), false)
+	scoped := *s
+	scoped.logger = s.logger.WithRequest(ctx)
+	s = &scoped
+
+	s.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := BuildForm(query, pageNum); err != nil {
+		return nil, err
+	}
+
+	page, err := s.Navigate(ctx, homeURL)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &s.Browser)()
+
+	home, err := s.waitDocument(ctx, page, []string{Selectors.SCToken, Selectors.Captcha})
+	if err != nil {
+		s.logger.Error("Homepage classified as %v", err)
+		return nil, err
+	}
+	if pageErr := classifyStartpageDocument(home); errors.Is(pageErr, core.ErrCaptcha) {
+		return nil, pageErr
+	}
+	sc := scToken(home)
+	if sc == "" {
+		return nil, core.ErrParser
+	}
+
+	firstPage := pageNum
+	all := []core.SearchResult{}
+	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
+
+	// fetchPage submits the search for one page and appends parsed results,
+	// reusing the tab and the homepage's sc token.
+	// Returns (done, error): done=true ends the outer loop without error.
+	fetchPage := func() (bool, error) {
+		u, err := BuildURL(query, pageNum, sc)
+		if err != nil {
+			return false, err
+		}
+		if err := page.Navigate(u); err != nil {
+			return false, err
+		}
+
+		doc, err := s.waitDocument(ctx, page, []string{Selectors.Results, Selectors.NoResults, Selectors.Captcha})
+		if errors.Is(err, core.ErrEmptyResult) {
+			return true, nil
+		}
+		if err != nil {
+			s.logger.Error("Page classified as %v: %s", err, u)
+			core.ArchivePage(ctx, page)
+			return false, err
+		}
+		if pageErr := classifyStartpageDocument(doc); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				s.logger.Debug("No results on page %d", pageNum)
+				return true, nil
+			}
+			s.logger.Error("Page classified as %v: %s", pageErr, u)
+			core.ArchivePage(ctx, page)
+			return false, pageErr
+		}
+
+		// waitDocument snapshots as soon as the page settles; archive that
+		// page, then measure and snapshot again so result rows read their
+		// boxes.
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+			if doc, err = core.DocumentFromPage(page); err != nil {
+				return false, core.ErrParser
+			}
+		}
+
+		rows := parseStartpageDocument(doc, pageNum*startpagePageSize+1)
+		if len(rows) == 0 {
+			s.logger.Debug("No parseable results on page %d", pageNum)
+			return true, nil
+		}
+		all = append(all, rows...)
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = parseStartpageSerpMeta(doc)
+		}
+		if query.Features && pageNum == firstPage {
+			pageFeatures = extractStartpageFeatures(doc)
+		}
+		return false, nil
+	}
+
+	for core.ShouldFetchResultPage(len(all), query.Limit, pageNum-firstPage) {
+		done, err := fetchPage()
+		if err != nil {
+			return nil, err
+		}
+		pageNum++
+		if done || !core.ShouldFetchResultPage(len(all), query.Limit, pageNum-firstPage) {
+			break
+		}
+		if err := core.SleepContext(ctx, s.pageSleep); err != nil {
+			return nil, err
+		}
+	}
+
+	deduped := core.DeduplicateResults(all)
+	if query.Limit > 0 {
+		deduped = core.LimitOrganicResults(deduped, query.Limit)
+	}
+	s.logger.Info("Search completed: %d results", len(deduped))
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
+}
+
+// SearchImage is not implemented: Startpage's image tab proxies Google Images
+// through an API this package does not scrape.
+func (s *Startpage) SearchImage(_ context.Context, _ core.Query) ([]core.SearchResult, error) {
+	return nil, errors.New("image search is not supported for startpage")
+}
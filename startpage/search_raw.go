@@ -0,0 +1,102 @@
+package startpage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// readStartpageDocument reads a raw response and parses it, classifying the
+// HTTP status on the way.
+func readStartpageDocument(res *http.Response) (*goquery.Document, error) {
+	defer core.DrainAndCloseResponse(res)
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(body))
+}
+
+// fetchSCToken loads the homepage and returns the sc token its search form
+// carries. Startpage rejects form submits without a fresh token.
+func fetchSCToken(ctx context.Context, query core.Query) (string, error) {
+	res, err := core.RawSearchRequest(ctx, homeURL, query)
+	if err != nil {
+		return "", err
+	}
+	defer core.DrainAndCloseResponse(res)
+	// The homepage is not a SERP, so it is read without archiving.
+	body, err := core.ReadRawResponseBody(res)
+	if err != nil {
+		return "", err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	if pageErr := classifyStartpageDocument(doc); errors.Is(pageErr, core.ErrCaptcha) {
+		return "", pageErr
+	}
+	sc := scToken(doc)
+	if sc == "" {
+		return "", fmt.Errorf("%w: startpage homepage has no sc token", core.ErrParser)
+	}
+	return sc, nil
+}
+
+func Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, "startpage", false)
+
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	form, err := BuildForm(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := fetchSCToken(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	form.Set("sc", sc)
+	core.WithRequest(ctx).WithField("url", searchURL).Debug(fmt.Sprintf("Startpage form built: %s", form.Encode()))
+
+	res, err := core.RawFormRequest(ctx, searchURL, form, query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("status_code", res.StatusCode).Debug(
+		fmt.Sprintf("Startpage Raw response: code=%d", res.StatusCode),
+	)
+	doc, err := readStartpageDocument(res)
+	if err != nil {
+		return nil, err
+	}
+	htmlStatus := classifyStartpageDocument(doc)
+	if htmlStatus != nil && !errors.Is(htmlStatus, core.ErrEmptyResult) {
+		return nil, htmlStatus
+	}
+
+	parsedResults := parseStartpageDocument(doc, pageNum*startpagePageSize+1)
+	if len(parsedResults) == 0 {
+		if errors.Is(htmlStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, fmt.Errorf("%w: startpage raw search returned no parseable results", core.ErrParser)
+	}
+	parsedResults = core.AttachFeaturesToFirstResult(parsedResults, extractStartpageFeatures(doc))
+	parsedResults = core.AttachSerpMetaToFirstResult(parsedResults, parseStartpageSerpMeta(doc))
+
+	core.WithRequest(ctx).WithField("results_count", len(parsedResults)).Debug(
+		fmt.Sprintf("Startpage Raw results : %v", parsedResults),
+	)
+
+	return core.StripResultFeatures(parsedResults, query.Features), nil
+}
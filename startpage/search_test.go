@@ -0,0 +1,153 @@
+package startpage
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildForm(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values)
+	}{
+		{
+			name:  "basic search omits page and locale",
+			query: core.Query{Text: "open source search"},
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("query"); got != "open source search" {
+					t.Fatalf("unexpected query: %q", got)
+				}
+				if got := form.Get("cat"); got != "web" {
+					t.Fatalf("unexpected cat: %q", got)
+				}
+				for _, key := range []string{"page", "language", "search_results_region", "with_date", "sc"} {
+					if got := form.Get(key); got != "" {
+						t.Fatalf("expected no %s field, got %q", key, got)
+					}
+				}
+			},
+		},
+		{
+			name:  "site, filetype and pagination",
+			query: core.Query{Text: "report", Site: "example.com", Filetype: "pdf"},
+			page:  2,
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("query"); got != "report site:example.com filetype:pdf" {
+					t.Fatalf("unexpected query: %q", got)
+				}
+				if got := form.Get("page"); got != "3" {
+					t.Fatalf("unexpected page: %q", got)
+				}
+			},
+		},
+		{
+			name:  "lang code maps to language names and region",
+			query: core.Query{Text: "bier", LangCode: "de-AT"},
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("language"); got != "deutsch" {
+					t.Fatalf("unexpected language: %q", got)
+				}
+				if got := form.Get("lui"); got != "deutsch" {
+					t.Fatalf("unexpected lui: %q", got)
+				}
+				if got := form.Get("search_results_region"); got != "de-AT" {
+					t.Fatalf("unexpected search_results_region: %q", got)
+				}
+			},
+		},
+		{
+			name:  "region without lang defaults to english half",
+			query: core.Query{Text: "news", Region: "NL"},
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("search_results_region"); got != "en-NL" {
+					t.Fatalf("unexpected search_results_region: %q", got)
+				}
+				if got := form.Get("language"); got != "" {
+					t.Fatalf("expected no language, got %q", got)
+				}
+			},
+		},
+		{
+			name:  "date interval buckets into with_date",
+			query: core.Query{Text: "golang", DateInterval: "20240101..20240105"},
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("with_date"); got != "w" {
+					t.Fatalf("unexpected with_date: %q", got)
+				}
+			},
+		},
+		{
+			name:  "multi-year interval drops with_date",
+			query: core.Query{Text: "golang", DateInterval: "20200101..20240101"},
+			check: func(t *testing.T, form url.Values) {
+				t.Helper()
+				if got := form.Get("with_date"); got != "" {
+					t.Fatalf("expected no with_date, got %q", got)
+				}
+			},
+		},
+		{
+			name:    "malformed date errors",
+			query:   core.Query{Text: "golang", DateInterval: "20240101"},
+			wantErr: true,
+		},
+		{
+			name:    "negative page errors",
+			query:   core.Query{Text: "golang"},
+			page:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildForm(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildForm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+		})
+	}
+}
+
+func TestBuildURLCarriesSCToken(t *testing.T) {
+	got, err := BuildURL(core.Query{Text: "golang"}, 1, "abc123")
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("BuildURL() returned invalid URL: %v", err)
+	}
+	if parsed.Host != "www.startpage.com" || parsed.Path != "/sp/search" {
+		t.Fatalf("unexpected endpoint: %s", got)
+	}
+	params := parsed.Query()
+	if params.Get("sc") != "abc123" || params.Get("query") != "golang" || params.Get("page") != "2" {
+		t.Fatalf("unexpected params: %v", params)
+	}
+
+	if _, err := BuildURL(core.Query{Text: "golang"}, 0, ""); err == nil {
+		t.Fatal("expected error for missing sc token")
+	}
+}
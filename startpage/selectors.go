@@ -0,0 +1,50 @@
+package startpage
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Startpage SERP CSS selectors.
+// Results lists the current result markup first and the older w-gl layout
+// second; both are still served depending on the A/B segment.
+var Selectors = struct {
+	SCToken        string
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	Title          string
+	Link           string
+	Desc           string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Breadcrumbs of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	// SCToken is the hidden per-session field on the homepage search form.
+	SCToken: "form#search input[name='sc'], input[name='sc']",
+	Captcha: "form[action*='captcha'], #captcha",
+	// CaptchaMarkers cover the interstitial Startpage shows once it flags a
+	// session as automated.
+	CaptchaMarkers: []string{
+		"we have detected unusual activity",
+		"please complete the captcha",
+		"not a robot",
+	},
+	NoResults:    ".no-results, .w-gl__no-results",
+	EmptyMarkers: []string{"did not match any documents", "no results found for"},
+	Results:      "div.result, div.w-gl__result",
+	Title:        "h2.wgl-title, a.result-title h2, h3",
+	Link:         "a.result-link, a.w-gl__result-title, a[href]",
+	Desc:         "p.description, p.w-gl__description",
+	// SpellCorrected is "Showing results for …" (Startpage already ran the
+	// fixed query); SpellSuggested is "Did you mean …".
+	SpellCorrected: ".spelling-correction a",
+	SpellSuggested: ".spelling-suggestion a",
+	SearchBox:      "input[name='query']",
+	// Extras: the display URL under the title reads "go.dev › doc › tutorial".
+	Extras: core.ResultExtrasSelectors{
+		Breadcrumb: "a.result-link span.link-text, a.w-gl__result-url",
+	},
+}
@@ -0,0 +1,73 @@
+package startpage
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	fixture, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		html            string
+		url             string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name:            "display path and snippet date",
+			html:            string(fixture),
+			url:             "https://go.dev/doc/tutorial/getting-started",
+			wantBreadcrumbs: []string{"go.dev", "doc", "tutorial", "getting-started"},
+			wantRich:        &core.RichSnippet{Date: "Jan 17, 2024", PublishedAt: "2024-01-17T00:00:00Z"},
+		},
+		{
+			name:            "older w-gl layout",
+			html:            string(fixture),
+			url:             "https://en.wikipedia.org/wiki/Go_(programming_language)",
+			wantBreadcrumbs: []string{"en.wikipedia.org", "wiki", "Go_(programming_language)"},
+		},
+		{
+			name: "result without extras",
+			html: string(fixture),
+			url:  "https://go.dev/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if results[i].URL == tt.url {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected a result for %s, got %+v", tt.url, results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package startpage
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+const (
+	homeURL   = "https://www.startpage.com/"
+	searchURL = "https://www.startpage.com/sp/search"
+)
+
+// startpageLanguages maps ISO 639-1 codes to the language names Startpage
+// uses for its language (results) and lui (interface) parameters. Codes
+// missing here are left to Startpage's Accept-Language detection.
+var startpageLanguages = map[string]string{
+	"da": "dansk",
+	"de": "deutsch",
+	"en": "english",
+	"es": "espanol",
+	"fi": "suomi",
+	"fr": "francais",
+	"it": "italiano",
+	"ja": "nihongo",
+	"ko": "hangul",
+	"nl": "nederlands",
+	"no": "norsk",
+	"pl": "polski",
+	"pt": "portugues",
+	"sv": "svenska",
+	"tr": "turkce",
+	"zh": "jiantizhongwen",
+}
+
+// startpageDateFilters is Startpage's with_date parameter per span.
+var startpageDateFilters = map[core.DateSpan]string{
+	core.DateSpanDay:   "d",
+	core.DateSpanWeek:  "w",
+	core.DateSpanMonth: "m",
+	core.DateSpanYear:  "y",
+}
+
+// startpageDateFilter maps a DateInterval to Startpage's with_date value.
+func startpageDateFilter(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return startpageDateFilters[span], nil
+}
+
+// Operators is Startpage's spelling of the structured query operators, which
+// it forwards to Google.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	InURL:       "inurl:",
+	Or:          " OR ",
+}
+
+// startpageSafe maps SafeSearch levels to the qadf family filter field.
+var startpageSafe = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "none",
+	core.SafeSearchModerate: "moderate",
+	core.SafeSearchStrict:   "heavy",
+}
+
+// SupportsSafeSearch reports whether the Startpage form can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := startpageSafe[level]
+	return ok || level == ""
+}
+
+// BuildForm builds the search-form fields Startpage expects for the supplied
+// query and 0-based page index, without the sc token (see BuildURL and
+// Search). Region is sent as search_results_region ("de-DE"), defaulting the
+// language half to English when q.LangCode is unset.
+func BuildForm(q core.Query, page int) (url.Values, error) {
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	operators, _ := Operators.Compile(q)
+	text += operators
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("empty query built")
+	}
+	if page < 0 {
+		return nil, errors.New("incorrect page provided")
+	}
+
+	form := url.Values{}
+	form.Set("query", text)
+	form.Set("cat", "web")
+	form.Set("t", "device")
+
+	parsed := core.ParseLocale(q.LangCode)
+	if name, ok := startpageLanguages[parsed.Language]; ok {
+		form.Set("language", name)
+		form.Set("lui", name)
+	}
+	country := strings.ToUpper(core.CountryFromRegion(q.Region))
+	if country == "" {
+		country = strings.ToUpper(parsed.Country)
+	}
+	if country != "" {
+		language := parsed.Language
+		if language == "" {
+			language = "en"
+		}
+		form.Set("search_results_region", language+"-"+country)
+	}
+
+	dateFilter, err := startpageDateFilter(q.DateInterval)
+	if err != nil {
+		return nil, err
+	}
+	if dateFilter != "" {
+		form.Set("with_date", dateFilter)
+	}
+	if qadf := startpageSafe[q.SafeSearch]; qadf != "" {
+		form.Set("qadf", qadf)
+	}
+
+	if page > 0 {
+		form.Set("page", strconv.Itoa(page+1))
+	}
+	return form, nil
+}
+
+// BuildURL builds a GET form of the Startpage search for the browser path.
+// sc is the per-session token scraped from the homepage search form; without
+// it Startpage treats the request as automated and serves a captcha.
+func BuildURL(q core.Query, page int, sc string) (string, error) {
+	form, err := BuildForm(q, page)
+	if err != nil {
+		return "", err
+	}
+	if sc == "" {
+		return "", errors.New("missing startpage sc token")
+	}
+	form.Set("sc", sc)
+
+	base, err := url.Parse(searchURL)
+	if err != nil {
+		return "", err
+	}
+	base.RawQuery = form.Encode()
+	return base.String(), nil
+}
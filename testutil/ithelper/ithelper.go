@@ -3,6 +3,10 @@ package ithelper
 import (
 	"context"
 	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -80,6 +84,60 @@ func CreateBrowser(t *testing.T) *core.Browser {
 	return b
 }
 
+// ServeSERP answers every HTTPS page the browser opens with body, through a
+// local CONNECT proxy in front of a self-signed TLS server, so an engine's
+// browser path can be driven against a fixed page without the live site. It
+// returns a browser using that proxy, closed when the test finishes.
+func ServeSERP(t *testing.T, body string) *core.Browser {
+	t.Helper()
+	serp := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(serp.Close)
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "CONNECT only", http.StatusMethodNotAllowed)
+			return
+		}
+		upstream, err := net.Dial("tcp", serp.Listener.Addr().String())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		client, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			_ = upstream.Close()
+			return
+		}
+		_, _ = io.WriteString(client, "HTTP/1.1 200 Connection Established\r\n\r\n")
+		go func() {
+			_, _ = io.Copy(upstream, client)
+			_ = upstream.Close()
+		}()
+		_, _ = io.Copy(client, upstream)
+		_ = client.Close()
+	}))
+	t.Cleanup(proxy.Close)
+
+	b, err := core.NewBrowser(core.BrowserOpts{
+		IsHeadless: true,
+		Timeout:    15 * time.Second,
+		ProxyURL:   proxy.URL,
+		Insecure:   true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create test browser: %v", err)
+	}
+	t.Cleanup(func() {
+		if cerr := b.Close(); cerr != nil {
+			t.Logf("close test browser: %v", cerr)
+		}
+	})
+	return b
+}
+
 // RunEngineTests runs the live web + image search smoke checks shared by every
 // engine's integration test: results come back, and the first one has a URL
 // and a title. newEngine receives a fresh browser per subtest.
@@ -0,0 +1,40 @@
+package yahoojp
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// yahooJPFeatureSelectors are the SERP modules extractYahooJPFeatures reads; their
+// containers are also measured for layout=true.
+var yahooJPFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeKnowledgePanel,
+		Title:         "Knowledge panel",
+		Container:     []string{".sw-Knowledge", "#KnowledgePanel", ".KnowledgePanel"},
+		TitleSelector: []string{"h2", ".sw-Knowledge__title", "h3"},
+		TextSelector:  []string{".sw-Knowledge__description", ".sw-Knowledge__summary", "p"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.7,
+		SingleMatch:   true,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{".sw-Related", "#Sk2", "#Sk"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.75,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Yahoo! JAPAN SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	return core.LayoutContainers([]string{Selectors.Results}, yahooJPFeatureSelectors)
+}
+
+func extractYahooJPFeatures(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractSerpFeaturesBySelectors(doc, yahooJPFeatureSelectors)
+}
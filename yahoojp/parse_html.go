@@ -0,0 +1,170 @@
+package yahoojp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// ParseHTML parses a Yahoo! JAPAN SERP HTML document and returns search
+// results. Shift_JIS/EUC-JP documents are decoded from their <meta charset>.
+// No network I/O.
+func ParseHTML(r io.Reader) ([]core.SearchResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := newYahooJPDocument(data, "")
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyYahooJPDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	results := parseYahooJPDocument(doc, core.NewRankState(0))
+	results = core.AttachFeaturesToFirstResult(results, extractYahooJPFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseYahooJPSerpMeta(doc)), nil
+}
+
+// newYahooJPDocument decodes body to UTF-8 (contentType takes precedence over
+// the <meta> prescan when set) and builds a goquery document from it.
+func newYahooJPDocument(body []byte, contentType string) (*goquery.Document, error) {
+	decoded, err := core.DecodeHTMLBody(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return goquery.NewDocumentFromReader(bytes.NewReader(decoded))
+}
+
+func classifyYahooJPDocument(doc *goquery.Document) error {
+	return core.ClassifyChallengeDocument(doc, core.DocSignals{
+		CaptchaSelectors: []string{Selectors.Captcha},
+		CaptchaMarkers:   Selectors.CaptchaMarkers,
+		EmptySelectors:   []string{Selectors.NoResults},
+		EmptyMarkers:     Selectors.EmptyMarkers,
+	})
+}
+
+// parseYahooJPDocument walks result cards in DOM order. rank carries the
+// organic/ad/absolute counters so the browser path can continue them across
+// pages; ads keep their own sequence and never shift organic ranks.
+func parseYahooJPDocument(doc *goquery.Document, rank *core.RankState) []core.SearchResult {
+	var results []core.SearchResult
+	doc.Find(Selectors.Results).Each(func(_ int, item *goquery.Selection) {
+		// Nested cards (e.g. a sitelink block reusing sw-CardBase) are parsed
+		// through their outermost card only.
+		if item.ParentsFiltered(Selectors.Results).Length() > 0 {
+			return
+		}
+		href, _ := item.Find(Selectors.Link).First().Attr("href")
+		title := strings.TrimSpace(item.Find(Selectors.Title).First().Text())
+		desc := strings.TrimSpace(item.Find(Selectors.Desc).First().Text())
+		if res, ok := assembleYahooJPRow(href, title, desc, yahooJPSelectionIsAd(item), rank); ok {
+			if !res.Ad {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, unwrapYahooJPURL)
+			}
+			res.Layout = core.SelectionLayout(item)
+			results = append(results, res)
+		}
+	})
+	return core.DeduplicateResults(results)
+}
+
+// assembleYahooJPRow validates an already-extracted row, unwraps the click
+// redirect and reserves its rank. Rows are rejected before rank.Next so
+// skipped cards leave no gaps in the sequence.
+func assembleYahooJPRow(href, title, desc string, ad bool, rank *core.RankState) (core.SearchResult, bool) {
+	href = unwrapYahooJPURL(strings.TrimSpace(href))
+	if href == "" || title == "" {
+		return core.SearchResult{}, false
+	}
+	// Relative links point back into Yahoo (related searches, tabs), never to
+	// a result page.
+	if !core.IsHTTPURL(href) {
+		return core.SearchResult{}, false
+	}
+	resultRank, absoluteRank := rank.Next(ad)
+	return core.SearchResult{
+		Rank:         resultRank,
+		AbsoluteRank: absoluteRank,
+		URL:          href,
+		Title:        title,
+		Description:  desc,
+		Ad:           ad,
+	}, true
+}
+
+func yahooJPSelectionIsAd(item *goquery.Selection) bool {
+	if item.Is(Selectors.Ad) || item.Find(Selectors.Ad).Length() > 0 {
+		return true
+	}
+	isAd := false
+	item.Find("span, em, p").EachWithBreak(func(_ int, marker *goquery.Selection) bool {
+		text := strings.TrimSpace(marker.Text())
+		for _, label := range Selectors.AdLabels {
+			if text == label {
+				isAd = true
+				return false
+			}
+		}
+		return true
+	})
+	return isAd
+}
+
+// unwrapYahooJPURL returns the destination of a Yahoo! JAPAN click-tracking
+// link. Organic and sponsored hrefs hop through *.yahoo.co.jp and carry the
+// target in a /cl= or /RU= path segment (percent-escaped or base64), or in a
+// url= query parameter. Non-redirect hrefs are returned unchanged.
+func unwrapYahooJPURL(href string) string {
+	u, err := url.Parse(href)
+	if err != nil || !isYahooJPHost(u.Hostname()) {
+		return href
+	}
+	for _, segment := range strings.Split(u.EscapedPath(), "/") {
+		for _, prefix := range []string{"cl=", "RU="} {
+			if value, ok := strings.CutPrefix(segment, prefix); ok {
+				if target := decodeYahooJPTarget(value); target != "" {
+					return target
+				}
+			}
+		}
+	}
+	if target := decodeYahooJPTarget(u.Query().Get("url")); target != "" {
+		return target
+	}
+	return href
+}
+
+func isYahooJPHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "yahoo.co.jp" || strings.HasSuffix(host, ".yahoo.co.jp")
+}
+
+// decodeYahooJPTarget accepts a percent-escaped absolute URL or a URL-safe
+// base64 one. Yahoo pads base64 with "-" rather than "=".
+func decodeYahooJPTarget(value string) string {
+	if value == "" {
+		return ""
+	}
+	if unescaped, err := url.PathUnescape(value); err == nil && core.IsHTTPURL(unescaped) {
+		return unescaped
+	}
+	trimmed := strings.TrimRight(value, "-=")
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.RawStdEncoding} {
+		if decoded, err := enc.DecodeString(trimmed); err == nil && core.IsHTTPURL(string(decoded)) {
+			return string(decoded)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+package yahoojp
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseYahooJPHTML(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+
+	rank := 0
+	ads := 0
+	for i, r := range results {
+		if !strings.HasPrefix(r.URL, "http") || strings.Contains(r.URL, "yahoo.co.jp/p/") {
+			t.Fatalf("result %d: URL not unwrapped: %s", i, r.URL)
+		}
+		if r.Title == "" {
+			t.Fatalf("result %d: empty Title", i)
+		}
+		if r.AbsoluteRank != i+1 {
+			t.Fatalf("result %d: absolute rank = %d, want %d", i, r.AbsoluteRank, i+1)
+		}
+		if r.Ad {
+			ads++
+			if r.Rank != ads {
+				t.Fatalf("ad rank sequence broken at index %d: got %d, want %d", i, r.Rank, ads)
+			}
+			continue
+		}
+		rank++
+		if r.Rank != rank {
+			t.Fatalf("rank sequence broken at index %d: got %d, want %d", i, r.Rank, rank)
+		}
+	}
+	if rank != 5 || ads != 3 {
+		t.Fatalf("expected 5 organic and 3 ad results, got %d and %d", rank, ads)
+	}
+	if results[0].URL != "https://www.example-travel.jp/tokyo/" {
+		t.Fatalf("unexpected first ad URL: %s", results[0].URL)
+	}
+	if results[3].URL != "https://weather.yahoo.co.jp/weather/jp/13/4410.html" {
+		t.Fatalf("unexpected RU= organic URL: %s", results[3].URL)
+	}
+}
+
+func TestParseYahooJPHTMLShiftJIS(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results_sjis.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+	if !results[0].Ad || results[0].URL != "https://www.example-ramen.jp/" {
+		t.Fatalf("expected unwrapped legacy ad first, got %+v", results[0])
+	}
+	if results[1].Title != "ラーメン - Wikipedia" {
+		t.Fatalf("Shift_JIS title not decoded: %q", results[1].Title)
+	}
+	if results[1].Rank != 1 || results[3].Rank != 3 {
+		t.Fatalf("unexpected organic ranks: %d..%d", results[1].Rank, results[3].Rank)
+	}
+}
+
+func TestParseYahooJPHTMLEmpty(t *testing.T) {
+	t.Parallel()
+
+	results, err := ParseHTML(bytes.NewReader([]byte("")))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected zero results for empty HTML, got %d", len(results))
+	}
+}
+
+func TestParseYahooJPHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	page, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	notice := `<div class="sw-Suggestion">もしかして: <a href="/search?p=python">python</a></div>`
+	html := strings.Replace(string(page), "</body>", notice+"</body>", 1)
+
+	results, err := ParseHTML(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.SuggestedQuery != "python" {
+		t.Errorf("SuggestedQuery = %q, want python", meta.SuggestedQuery)
+	}
+}
@@ -0,0 +1,166 @@
+// Package yahoojp implements a Yahoo! JAPAN SERP scraper (web search).
+//
+// Yahoo! JAPAN (https://search.yahoo.co.jp/) is operated by LY Corporation
+// independently of Yahoo Inc. Its organic results are licensed from Google
+// but re-ranked and blended with Yahoo's own verticals and sponsored blocks,
+// so positions differ from Google JP for the same query.
+package yahoojp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/karust/openserp/core"
+)
+
+// yahooJPPageSize is the organic-results-per-page count on the web SERP.
+const yahooJPPageSize = 10
+
+// startPage translates q.Start (a 0-based result offset) into a 0-based page
+// index. Off-grid offsets round down to a page boundary so ranks stay aligned
+// with the b= offsets Yahoo actually serves.
+func startPage(start int) (int, error) {
+	if start < 0 {
+		return 0, errors.New("incorrect start provided")
+	}
+	return start / yahooJPPageSize, nil
+}
+
+// YahooJP implements core.SearchEngine for Yahoo! JAPAN SERP pages.
+type YahooJP struct {
+	core.Browser
+	core.SearchEngineOptions
+	pageSleep time.Duration // Sleep between pages
+	logger    *core.EngineLogger
+}
+
+// New creates a Yahoo! JAPAN engine instance with browser/runtime options applied.
+func New(browser core.Browser, opts core.SearchEngineOptions) *YahooJP {
+	y := YahooJP{Browser: browser}
+	opts.Init()
+	y.SearchEngineOptions = opts
+	y.logger = core.NewEngineLogger("YahooJP")
+	y.pageSleep = time.Second
+	return &y
+}
+
+// Name returns the stable engine identifier.
+func (y *YahooJP) Name() string { return "yahoojp" }
+
+// Search executes a Yahoo! JAPAN web search and returns normalized search
+// results. It may return core.ErrCaptcha or core.ErrSearchTimeout.
+func (y *YahooJP) Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, y.Name(), false)
+	scoped := *y
+	scoped.logger = y.logger.WithRequest(ctx)
+	y = &scoped
+
+	y.logger.Debug("Starting search, query: %+v", query)
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+	firstPage := pageNum
+	// One RankState spans all pages so organic ranks keep counting across b=
+	// offsets while ads keep their own sequence.
+	rank := core.NewRankState(pageNum)
+	all := []core.SearchResult{}
+	var pageFeatures []core.SerpFeature
+	var layout *core.SerpLayout
+	var serpMeta *core.SerpMeta
+
+	// fetchPage loads one SERP page and appends parsed results.
+	// Returns (done, error): done=true ends the outer loop without error.
+	fetchPage := func() (bool, error) {
+		u, err := BuildURL(query, pageNum)
+		if err != nil {
+			return false, err
+		}
+
+		page, err := y.Navigate(ctx, u)
+		if err != nil {
+			return false, err
+		}
+		defer core.DeferClosePage(ctx, page, &y.Browser)()
+
+		waitFor := []string{Selectors.Results, Selectors.NoResults, Selectors.Captcha}
+		if _, _, err := core.WaitForElements(ctx, page, waitFor, y.GetSelectorTimeout()); err != nil {
+			if pageErr := core.ClassifyFromPage(page, classifyYahooJPDocument); pageErr != nil {
+				if errors.Is(pageErr, core.ErrEmptyResult) {
+					return true, nil
+				}
+				y.logger.Error("Page classified as %v: %s", pageErr, u)
+				core.ArchivePage(ctx, page)
+				return false, pageErr
+			}
+			if core.IsContextDone(err) {
+				return false, err
+			}
+			core.ArchivePage(ctx, page)
+			return false, core.ErrSearchTimeout
+		}
+
+		// Archive the page before StampLayout marks it, then measure before
+		// snapshotting so result rows read their boxes.
+		core.ArchivePage(ctx, page)
+		if query.Layout && pageNum == firstPage {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
+		doc, err := core.DocumentFromPage(page)
+		if err != nil {
+			return false, core.ErrParser
+		}
+		if pageErr := classifyYahooJPDocument(doc); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				y.logger.Debug("No results on page %d", pageNum)
+				return true, nil
+			}
+			y.logger.Error("Page classified as %v: %s", pageErr, u)
+			return false, pageErr
+		}
+
+		rows := parseYahooJPDocument(doc, rank)
+		if len(rows) == 0 {
+			y.logger.Debug("No parseable results on page %d", pageNum)
+			return true, nil
+		}
+		all = append(all, rows...)
+		if pageNum == firstPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = parseYahooJPSerpMeta(doc)
+		}
+		if query.Features && pageNum == firstPage {
+			pageFeatures = extractYahooJPFeatures(doc)
+		}
+		return false, nil
+	}
+
+	for core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+		done, err := fetchPage()
+		if err != nil {
+			return nil, err
+		}
+		pageNum++
+		if done || !core.ShouldFetchResultPage(core.CountOrganicResults(all), query.Limit, pageNum-firstPage) {
+			break
+		}
+		if err := core.SleepContext(ctx, y.pageSleep); err != nil {
+			return nil, err
+		}
+	}
+
+	deduped := core.DeduplicateResults(all)
+	if query.Limit > 0 {
+		deduped = core.LimitOrganicResults(deduped, query.Limit)
+	}
+	y.logger.Info("Search completed: %d results", len(deduped))
+	deduped = core.AttachFeaturesToFirstResult(deduped, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(deduped, core.WithSerpLayout(serpMeta, layout)), nil
+}
+
+// SearchImage is not implemented: Yahoo! JAPAN image search renders its grid
+// from a client-side API that this package does not scrape yet.
+func (y *YahooJP) SearchImage(_ context.Context, _ core.Query) ([]core.SearchResult, error) {
+	return nil, errors.New("image search is not supported for yahoojp")
+}
@@ -0,0 +1,64 @@
+package yahoojp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+func Search(ctx context.Context, query core.Query) (results []core.SearchResult, err error) {
+	ctx = core.PrepareEngineContext(ctx, query, "yahoojp", false)
+
+	pageNum, err := startPage(query.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL, err := BuildURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", searchURL).Debug(fmt.Sprintf("Yahoo JP URL built: %s", searchURL))
+
+	res, err := core.RawSearchRequest(ctx, searchURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+	core.WithRequest(ctx).WithField("status_code", res.StatusCode).Debug(
+		fmt.Sprintf("Yahoo JP Raw response: code=%d", res.StatusCode),
+	)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	// Decode once with the response Content-Type, which is authoritative
+	// over the <meta> prescan when Yahoo serves a legacy encoding.
+	doc, err := newYahooJPDocument(body, res.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	htmlStatus := classifyYahooJPDocument(doc)
+	if htmlStatus != nil && !errors.Is(htmlStatus, core.ErrEmptyResult) {
+		return nil, htmlStatus
+	}
+
+	parsedResults := parseYahooJPDocument(doc, core.NewRankState(pageNum))
+	if len(parsedResults) == 0 {
+		if errors.Is(htmlStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, fmt.Errorf("%w: yahoojp raw search returned no parseable results", core.ErrParser)
+	}
+	parsedResults = core.AttachFeaturesToFirstResult(core.DeduplicateResults(parsedResults), extractYahooJPFeatures(doc))
+	parsedResults = core.AttachSerpMetaToFirstResult(parsedResults, parseYahooJPSerpMeta(doc))
+
+	core.WithRequest(ctx).WithField("results_count", len(parsedResults)).Debug(
+		fmt.Sprintf("Yahoo JP Raw results : %v", parsedResults),
+	)
+
+	return core.StripResultFeatures(parsedResults, query.Features), nil
+}
@@ -0,0 +1,201 @@
+package yahoojp
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   core.Query
+		page    int
+		wantErr bool
+		check   func(*testing.T, url.Values, string)
+	}{
+		{
+			name:  "basic search omits b on page 0",
+			query: core.Query{Text: "東京 天気"},
+			page:  0,
+			check: func(t *testing.T, params url.Values, host string) {
+				t.Helper()
+				if host != "search.yahoo.co.jp" {
+					t.Fatalf("unexpected host: %s", host)
+				}
+				if got := params.Get("p"); got != "東京 天気" {
+					t.Fatalf("unexpected p: %q", got)
+				}
+				if got := params.Get("ei"); got != "UTF-8" {
+					t.Fatalf("unexpected ei: %q", got)
+				}
+				if got := params.Get("b"); got != "" {
+					t.Fatalf("expected no b param on page 0, got %q", got)
+				}
+			},
+		},
+		{
+			name:  "site, filetype and pagination",
+			query: core.Query{Text: "ラーメン", Site: "tabelog.com", Filetype: "pdf"},
+			page:  2,
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("p"); got != "ラーメン site:tabelog.com filetype:pdf" {
+					t.Fatalf("unexpected p: %q", got)
+				}
+				if got := params.Get("b"); got != "21" {
+					t.Fatalf("unexpected b: %q", got)
+				}
+			},
+		},
+		{
+			name:  "week span maps to vd=w",
+			query: core.Query{Text: "golang", DateInterval: "20240101..20240106"},
+			check: func(t *testing.T, params url.Values, _ string) {
+				t.Helper()
+				if got := params.Get("vd"); got != "w" {
+					t.Fatalf("unexpected vd: %q", got)
+				}
+			},
+		},
+		{
+			name:    "malformed date errors",
+			query:   core.Query{Text: "golang", DateInterval: "2024-01-01..2024-01-31"},
+			wantErr: true,
+		},
+		{
+			name:    "negative page errors",
+			query:   core.Query{Text: "golang"},
+			page:    -1,
+			wantErr: true,
+		},
+		{
+			name:    "empty query returns error",
+			query:   core.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildURL(tt.query, tt.page)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parsed, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("BuildURL() returned invalid URL: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, parsed.Query(), parsed.Host)
+			}
+		})
+	}
+}
+
+func TestYahooJPPeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty input is no-op", input: "", want: ""},
+		{name: "same day", input: "20240101..20240101", want: "d"},
+		{name: "2-day span buckets to week", input: "20240101..20240103", want: "w"},
+		{name: "8-day span buckets to month", input: "20240101..20240109", want: "m"},
+		{name: "32-day span buckets to year", input: "20240101..20240202", want: "y"},
+		{name: "very long span is dropped", input: "20200101..20240101", want: ""},
+		{name: "missing separator errors", input: "20240101", wantErr: true},
+		{name: "end before start errors", input: "20240131..20240101", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := yahooJPPeriod(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("yahooJPPeriod(%q) err = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Fatalf("yahooJPPeriod(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartPage(t *testing.T) {
+	tests := []struct {
+		start    int
+		wantPage int
+		wantErr  bool
+	}{
+		{start: 0, wantPage: 0},
+		{start: 10, wantPage: 1},
+		{start: 15, wantPage: 1},
+		{start: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		page, err := startPage(tt.start)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("startPage(%d) err = %v, wantErr %v", tt.start, err, tt.wantErr)
+		}
+		if !tt.wantErr && page != tt.wantPage {
+			t.Fatalf("startPage(%d) = %d, want %d", tt.start, page, tt.wantPage)
+		}
+	}
+}
+
+func TestUnwrapYahooJPURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		href string
+		want string
+	}{
+		{
+			name: "direct link unchanged",
+			href: "https://tenki.jp/forecast/",
+			want: "https://tenki.jp/forecast/",
+		},
+		{
+			name: "percent-escaped cl segment",
+			href: "https://ad-cc.yahoo.co.jp/p/ylt=A2RiX9/cl=https%3A%2F%2Fshop.example.jp%2Fitem%3Fid%3D1/RK=0",
+			want: "https://shop.example.jp/item?id=1",
+		},
+		{
+			name: "base64 cl segment with dash padding",
+			href: "https://ad-cc.yahoo.co.jp/p/cl=aHR0cHM6Ly93d3cuZXhhbXBsZS1yYWluLmpwLw--/RK=0",
+			want: "https://www.example-rain.jp/",
+		},
+		{
+			name: "base64 RU segment",
+			href: "https://rdsig.yahoo.co.jp/search/result/RV=1/RU=aHR0cHM6Ly93ZWF0aGVyLnlhaG9vLmNvLmpwL3dlYXRoZXIvanAvMTMvNDQxMC5odG1s/RK=2/RS=abc-",
+			want: "https://weather.yahoo.co.jp/weather/jp/13/4410.html",
+		},
+		{
+			name: "url query parameter",
+			href: "https://search.yahoo.co.jp/clear.gif?url=https%3A%2F%2Fexample.jp%2F",
+			want: "https://example.jp/",
+		},
+		{
+			name: "non-yahoo host with cl segment unchanged",
+			href: "https://example.com/cl=https%3A%2F%2Fevil.example%2F",
+			want: "https://example.com/cl=https%3A%2F%2Fevil.example%2F",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := unwrapYahooJPURL(tt.href); got != tt.want {
+				t.Fatalf("unwrapYahooJPURL(%q) = %q, want %q", tt.href, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package yahoojp
+
+import "github.com/karust/openserp/core"
+
+// Selectors is the single source of truth for Yahoo! JAPAN SERP CSS selectors.
+// Both the browser path (search.go) and the HTML parser (parse_html.go) read
+// these. Each entry lists the current sw-* card markup first and the legacy
+// #WS2m layout, still served to some clients, second.
+var Selectors = struct {
+	Captcha        string
+	CaptchaMarkers []string
+	NoResults      string
+	EmptyMarkers   []string
+	Results        string
+	Ad             string
+	AdLabels       []string
+	Title          string
+	Link           string
+	Desc           string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// Sitelinks and breadcrumbs of organic results.
+	Extras core.ResultExtrasSelectors
+}{
+	Captcha: "form[action*='captcha'], iframe[src*='recaptcha'], .g-recaptcha",
+	// CaptchaMarkers is the page-text fallback for the "unusual access"
+	// interstitial, which has no stable markup.
+	CaptchaMarkers: []string{
+		"ロボットではありません",
+		"通常と異なるアクセス",
+		"unusual traffic",
+		"not a robot",
+	},
+	NoResults:    ".sw-NoResult, #NoRes",
+	EmptyMarkers: []string{"に一致するウェブページは見つかりませんでした", "に一致する情報は見つかりませんでした"},
+	// Results matches organic and sponsored cards in DOM order so ads at the
+	// top and bottom of the page interleave with organic rows correctly.
+	Results: "#contents .sw-CardBase, #WS2m .w, #So1 .ss, #So2 .ss",
+	// Ad marks sponsored cards. data-cl-params carries the click-logging module
+	// name; "ad" is only used by the sponsored blocks.
+	Ad:       "[data-cl-params*='_cl_vmodule:ad'], .sw-Card--ad, .ss",
+	AdLabels: []string{"広告", "スポンサー"},
+	Title:    ".sw-Card__title h3, .sw-Card__titleMain, h3",
+	Link:     ".sw-Card__title a[href], h3 a[href], a[href]",
+	Desc:     ".sw-Card__summary, .sw-Card__description, .bd p",
+	// SpellCorrected is the "…の検索結果を表示しています" notice (Yahoo!
+	// JAPAN already ran the fixed query); SpellSuggested is "もしかして".
+	SpellCorrected: ".sw-Correction a",
+	SpellSuggested: ".sw-Suggestion a",
+	SearchBox:      "input[name='p']",
+	// Extras: cards cite "https://tenki.jp › forecast › 3" under the title,
+	// and sites with sitelinks get a sw-Card__sitelinks link row.
+	Extras: core.ResultExtrasSelectors{
+		Sitelink:   ".sw-Card__sitelinks a[href]",
+		Breadcrumb: ".sw-Card__titleCite, cite",
+	},
+}
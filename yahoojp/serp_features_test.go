@@ -0,0 +1,160 @@
+package yahoojp
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestParseHTMLFixtureExtractsRealFeatures(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	assertFeatureType(t, results, core.ResultTypeRelatedSearches)
+}
+
+func TestParseHTMLExtractsSerpFeatures(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<div id="contents">
+  <div class="sw-Knowledge">
+    <h2>東京タワー</h2>
+    <p class="sw-Knowledge__description">東京都港区芝公園にある総合電波塔。</p>
+    <a href="https://www.tokyotower.co.jp/">公式サイト</a>
+  </div>
+  <div class="sw-CardBase">
+    <div class="sw-Card__title"><a href="https://example.jp/result"><h3>Organic result</h3></a></div>
+    <div class="sw-Card__summary">Snippet</div>
+  </div>
+  <div class="sw-Related"><a href="/search?p=tower">東京タワー 高さ</a></div>
+</div>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	assertFeatureType(t, results, core.ResultTypeKnowledgePanel)
+	assertFeatureType(t, results, core.ResultTypeRelatedSearches)
+}
+
+func TestParseHTMLOrganicOnlyHasNoSerpFeatures(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<div id="contents">
+  <div class="sw-CardBase">
+    <div class="sw-Card__title"><a href="https://example.jp/result"><h3>Organic result</h3></a></div>
+    <div class="sw-Card__summary">Snippet</div>
+  </div>
+</div>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	assertNoFeatures(t, results)
+}
+
+func assertFeatureType(t *testing.T, results []core.SearchResult, want core.ResultType) {
+	t.Helper()
+	for _, result := range results {
+		for _, feature := range result.Features {
+			if feature.Type == want {
+				return
+			}
+		}
+	}
+	t.Fatalf("expected feature type %q in %#v", want, results)
+}
+
+func assertNoFeatures(t *testing.T, results []core.SearchResult) {
+	t.Helper()
+	for _, result := range results {
+		if len(result.Features) > 0 {
+			t.Fatalf("expected no features, got %#v", result.Features)
+		}
+	}
+}
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		html            string
+		wantSitelinks   []core.Sitelink
+		wantBreadcrumbs []string
+		wantRich        *core.RichSnippet
+	}{
+		{
+			name: "sitelinks behind the click redirect",
+			html: `
+<div id="contents">
+  <div class="sw-CardBase" data-cl-params="_cl_vmodule:web;_cl_link:title;_cl_position:1;">
+    <div class="sw-Card__title"><a href="https://tenki.jp/"><h3>tenki.jp</h3></a><cite class="sw-Card__titleCite">https://tenki.jp › forecast › 3</cite></div>
+    <div class="sw-Card__summary">2024/03/05 — 日本気象協会の天気予報。</div>
+    <div class="sw-Card__sitelinks">
+      <a href="https://rdsig.yahoo.co.jp/search/result/RV=1/RU=aHR0cHM6Ly90ZW5raS5qcC9yYWRhci8-/RK=2/RS=x-">雨雲レーダー</a>
+      <a href="https://tenki.jp/week/">週間天気</a>
+    </div>
+  </div>
+</div>`,
+			wantSitelinks: []core.Sitelink{
+				{Title: "雨雲レーダー", URL: "https://tenki.jp/radar/"},
+				{Title: "週間天気", URL: "https://tenki.jp/week/"},
+			},
+			wantBreadcrumbs: []string{"https://tenki.jp", "forecast", "3"},
+			wantRich:        &core.RichSnippet{Date: "2024/03/05", PublishedAt: "2024-03-05T00:00:00Z"},
+		},
+		{
+			name: "plain result",
+			html: `
+<div id="contents">
+  <div class="sw-CardBase" data-cl-params="_cl_vmodule:web;_cl_link:title;_cl_position:1;">
+    <div class="sw-Card__title"><a href="https://tenki.jp/"><h3>tenki.jp</h3></a></div>
+    <div class="sw-Card__summary">日本気象協会の天気予報。</div>
+  </div>
+</div>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseHTML(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("ParseHTML() error = %v", err)
+			}
+			var res *core.SearchResult
+			for i := range results {
+				if !results[i].Ad {
+					res = &results[i]
+					break
+				}
+			}
+			if res == nil {
+				t.Fatalf("expected an organic result, got %+v", results)
+			}
+			if !reflect.DeepEqual(res.Sitelinks, tt.wantSitelinks) {
+				t.Errorf("sitelinks = %+v, want %+v", res.Sitelinks, tt.wantSitelinks)
+			}
+			if !reflect.DeepEqual(res.Breadcrumbs, tt.wantBreadcrumbs) {
+				t.Errorf("breadcrumbs = %q, want %q", res.Breadcrumbs, tt.wantBreadcrumbs)
+			}
+			if !reflect.DeepEqual(res.RichSnippet, tt.wantRich) {
+				t.Errorf("rich snippet = %+v, want %+v", res.RichSnippet, tt.wantRich)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package yahoojp
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/karust/openserp/core"
+)
+
+const baseURL = "https://search.yahoo.co.jp/search"
+
+// yahooJPPeriods is Yahoo! JAPAN's vd parameter per span; the SERP exposes
+// no custom range.
+var yahooJPPeriods = map[core.DateSpan]string{
+	core.DateSpanDay:   "d",
+	core.DateSpanWeek:  "w",
+	core.DateSpanMonth: "m",
+	core.DateSpanYear:  "y",
+}
+
+// yahooJPPeriod maps a DateInterval to Yahoo! JAPAN's vd value.
+func yahooJPPeriod(dateInterval string) (string, error) {
+	span, err := core.DateIntervalSpan(dateInterval)
+	if err != nil {
+		return "", err
+	}
+	return yahooJPPeriods[span], nil
+}
+
+// Operators is Yahoo! JAPAN's spelling of the structured query operators.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "intitle:",
+	InURL:       "inurl:",
+	Or:          " OR ",
+}
+
+// yahooJPSafe maps SafeSearch levels to the vm= parameter: p (off),
+// i (moderate) and r (strict).
+var yahooJPSafe = map[core.SafeSearch]string{
+	core.SafeSearchOff:      "p",
+	core.SafeSearchModerate: "i",
+	core.SafeSearchStrict:   "r",
+}
+
+// SupportsSafeSearch reports whether Yahoo! JAPAN URLs can carry level.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	_, ok := yahooJPSafe[level]
+	return ok || level == ""
+}
+
+// BuildURL builds a Yahoo! JAPAN web search URL for the supplied query and
+// 0-based page index. Pagination uses b=, the 1-based offset of the first
+// result on the page. q.LangCode/Region are not encoded: the SERP only serves
+// the Japanese market, so locale comes from the browser profile.
+func BuildURL(q core.Query, page int) (string, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	text := q.Text
+	if q.Site != "" {
+		text += " site:" + q.Site
+	}
+	if q.Filetype != "" {
+		text += " filetype:" + q.Filetype
+	}
+	operators, _ := Operators.Compile(q)
+	text += operators
+	if strings.TrimSpace(text) == "" {
+		return "", errors.New("empty query built")
+	}
+	if page < 0 {
+		return "", errors.New("incorrect page provided")
+	}
+
+	params := url.Values{}
+	params.Set("p", text)
+	// ei tells Yahoo how p is encoded; without it legacy entry points assume
+	// Shift_JIS and mangle the query.
+	params.Set("ei", "UTF-8")
+	if vm := yahooJPSafe[q.SafeSearch]; vm != "" {
+		params.Set("vm", vm)
+	}
+
+	period, err := yahooJPPeriod(q.DateInterval)
+	if err != nil {
+		return "", err
+	}
+	if period != "" {
+		params.Set("vd", period)
+	}
+
+	if page > 0 {
+		params.Set("b", strconv.Itoa(page*yahooJPPageSize+1))
+	}
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
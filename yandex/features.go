@@ -6,42 +6,84 @@ import (
 	"github.com/karust/openserp/core"
 )
 
+// yandexFeatureSelectors are the SERP modules extractYandexFeatures reads; their
+// containers are also measured for layout=true.
+var yandexFeatureSelectors = []core.SerpFeatureSelector{
+	{
+		Type:          core.ResultTypeAnswerBox,
+		Title:         "Answer",
+		Container:     []string{".FactAnswer", ".fact-answer", "[data-fast-name='fact']", "[data-fast-name='calculator']", ".Calculator", ".AdaptiveCalc"},
+		TitleSelector: []string{".FactAnswer-Title", ".fact-answer__title", "h2"},
+		TextSelector:  []string{".FactAnswer-Text", ".fact-answer__text", ".calculator__result", ".AdaptiveCalc-Result", ".ConverterText", ".fact__answer"},
+		LinkSelector:  []string{"a[href^='http']"},
+		Position:      1,
+		Confidence:    0.75,
+	},
+	{
+		Type:         core.ResultTypeRelatedSearches,
+		Title:        "Related searches",
+		Container:    []string{".RelatedSearches", ".related", ".serp-footer__related", "[data-fast-name='related']", ".RelatedBottom", ".AppndQuestions"},
+		ItemSelector: []string{"a"},
+		LinkSelector: []string{"a[href^='http']", "a"},
+		Confidence:   0.7,
+	},
+}
+
+// layoutContainers lists what StampLayout measures on a Yandex SERP: the
+// result rows and every feature container the extractors read.
+func layoutContainers() []string {
+	results := []string{
+		Selectors.Results,
+		Selectors.NeuroAnswer.Container,
+		Selectors.ProductCarouselItem,
+		Selectors.LocalResults,
+		Selectors.KnowledgePanel.Container,
+	}
+	return core.LayoutContainers(results, yandexFeatureSelectors, []core.SerpFeatureSelector{yandexNeuroAnswerSelector})
+}
+
 func extractYandexFeatures(doc *goquery.Document) []core.SerpFeature {
-	return core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{
-		{
-			// Neuro/AI answer card (data-fast-name='neuro_answer'). Most of the
-			// answer body renders client-side from a data-state JSON blob, so the
-			// static snapshot exposes the visible teaser text + source links.
-			Type:          core.ResultTypeAISummary,
-			Title:         "Нейро",
-			Container:     []string{"li[data-fast-name='neuro_answer']", ".FuturisSearch", ".FuturisSearchCard"},
-			TitleSelector: []string{".FuturisInlineHeader-Text", ".FuturisSearchCard-Title"},
-			TextSelector:  []string{".FuturisGPTMessage-GroupContent", ".FuturisSearchCard-Content", ".FuturisSnippetText"},
-			// Restrict to cited sources; the block also contains reasoning-plan
-			// chips and follow-up suggestion links we don't want as citations.
-			LinkSelector: []string{"a.FuturisSource[href^='http']", ".FuturisSourceDetails a[href^='http']"},
-			Position:     1,
-			Confidence:   0.6,
-		},
-		{
-			Type:          core.ResultTypeAnswerBox,
-			Title:         "Answer",
-			Container:     []string{".FactAnswer", ".fact-answer", "[data-fast-name='fact']", "[data-fast-name='calculator']", "[data-fast-name='entity_search']", ".Calculator", ".AdaptiveCalc"},
-			TitleSelector: []string{".FactAnswer-Title", ".fact-answer__title", "h2"},
-			TextSelector:  []string{".FactAnswer-Text", ".fact-answer__text", ".calculator__result", ".AdaptiveCalc-Result", ".ConverterText", ".fact__answer"},
-			LinkSelector:  []string{"a[href^='http']"},
-			Position:      1,
-			Confidence:    0.75,
-		},
-		{
-			Type:         core.ResultTypeRelatedSearches,
-			Title:        "Related searches",
-			Container:    []string{".RelatedSearches", ".related", ".serp-footer__related", "[data-fast-name='related']", ".RelatedBottom", ".AppndQuestions"},
-			ItemSelector: []string{"a"},
-			LinkSelector: []string{"a[href^='http']", "a"},
-			Confidence:   0.7,
-		},
-	})
+	features := core.ExtractSerpFeaturesBySelectors(doc, yandexFeatureSelectors)
+	// The Neuro answer is read with its sections and citations; the generic
+	// selector only covers layouts Selectors.NeuroAnswer misses.
+	if answer, _ := extractYandexNeuroAnswer(doc); answer != nil {
+		features = append([]core.SerpFeature{*answer}, features...)
+	} else {
+		features = append(core.ExtractSerpFeaturesBySelectors(doc, []core.SerpFeatureSelector{yandexNeuroAnswerSelector}), features...)
+	}
+	features = append(features, extractYandexProductCarousel(doc)...)
+	features = append(features, extractYandexLocalPack(doc)...)
+	return append(features, core.ExtractKnowledgePanel(doc, Selectors.KnowledgePanel, yandexAbsoluteHref)...)
+}
+
+// yandexNeuroAnswerSelector reads the Neuro/AI answer card
+// (data-fast-name='neuro_answer') as one block of text when
+// Selectors.NeuroAnswer does not match it, as on snapshots where the answer
+// body has not rendered from its data-state JSON blob yet and only the
+// teaser text and source links are visible.
+var yandexNeuroAnswerSelector = core.SerpFeatureSelector{
+	Type:          core.ResultTypeAISummary,
+	Title:         "Нейро",
+	Container:     []string{"li[data-fast-name='neuro_answer']", ".FuturisSearch", ".FuturisSearchCard"},
+	TitleSelector: []string{".FuturisInlineHeader-Text", ".FuturisSearchCard-Title"},
+	TextSelector:  []string{".FuturisGPTMessage-GroupContent", ".FuturisSearchCard-Content", ".FuturisSnippetText"},
+	// Restrict to cited sources; the block also contains reasoning-plan
+	// chips and follow-up suggestion links we don't want as citations.
+	LinkSelector: []string{"a.FuturisSource[href^='http']", ".FuturisSourceDetails a[href^='http']"},
+	Position:     1,
+	Confidence:   0.6,
+}
+
+// extractYandexNeuroAnswer reads the Neuro answer with its sections and
+// numbered citations.
+func extractYandexNeuroAnswer(doc *goquery.Document) (*core.SerpFeature, core.AISummaryStatus) {
+	answer, status := core.ExtractAISummary(doc, Selectors.NeuroAnswer, yandexAbsoluteHref)
+	if answer == nil {
+		return nil, status
+	}
+	answer.Title = "Нейро"
+	answer.Position = &core.Position{Absolute: 1}
+	return answer, status
 }
 
 func extractYandexFeaturesFromPage(page *rod.Page) []core.SerpFeature {
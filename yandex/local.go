@@ -0,0 +1,12 @@
+package yandex
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// extractYandexLocalPack captures the companies block Yandex shows for place
+// queries.
+func extractYandexLocalPack(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractLocalPack(doc, Selectors.LocalPack, Selectors.LocalResults, Selectors.LocalCard, yandexAbsoluteHref)
+}
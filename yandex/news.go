@@ -0,0 +1,175 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+const yandexNewsPageSize = 10
+
+// ParseNewsHTML parses a Yandex News search HTML document.
+func ParseNewsHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyYandexDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseYandexNewsDocument(doc, time.Now()), nil
+}
+
+// parseYandexNewsDocument extracts mg-snippet story cards. Yandex prints
+// "14:05" for today, "вчера в 14:05" for yesterday and "5 марта" beyond
+// that; all of them resolve against now.
+func parseYandexNewsDocument(doc *goquery.Document, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankState(0)
+
+	doc.Find(Selectors.NewsResults).Each(func(_ int, card *goquery.Selection) {
+		link := card.Find(Selectors.NewsLink).First()
+		if link.Length() == 0 {
+			link = card.Find(Selectors.NewsTitle).Closest("a")
+		}
+		href := strings.TrimSpace(link.AttrOr("href", ""))
+		title := core.NormalizeWhitespace(card.Find(Selectors.NewsTitle).First().Text())
+		if href == "" || title == "" {
+			return
+		}
+
+		meta := &core.NewsMeta{
+			Source:    core.NormalizeWhitespace(card.Find(Selectors.NewsSource).First().Text()),
+			Thumbnail: strings.TrimSpace(card.Find(Selectors.NewsThumbnail).First().AttrOr("src", "")),
+		}
+		if published, ok := core.ParsePublishedTime(card.Find(Selectors.NewsTime).First().Text(), now); ok {
+			meta.PublishedAt = published
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Description:  core.NormalizeWhitespace(card.Find(Selectors.NewsSnippet).First().Text()),
+			News:         meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// pageYandexVertical trims and re-ranks one parsed news, video or product page for
+// query.Start.
+func pageYandexVertical(results []core.SearchResult, query core.Query, pageNum, skip, pageSize int) []core.SearchResult {
+	results = skipOrganicResults(results, skip)
+	rebaseOrganicRanks(results, query.Start)
+	offsetAbsoluteRanks(results, pageNum*pageSize)
+	return core.LimitOrganicResults(results, query.Limit)
+}
+
+// SearchNews runs a raw HTTP request against Yandex News search.
+func SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "yandex", false)
+
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexNewsPageSize)
+	if err != nil {
+		return nil, err
+	}
+	newsURL, err := BuildNewsURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", newsURL).Debug(fmt.Sprintf("Yandex News URL built: %s", newsURL))
+
+	res, err := core.RawSearchRequest(ctx, newsURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyYandexDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseYandexNewsDocument(doc, time.Now())
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: yandex news returned no parseable results", core.ErrParser)
+	}
+	return pageYandexVertical(results, query, pageNum, skip, yandexNewsPageSize), nil
+}
+
+// SearchNews executes a Yandex News search in the browser.
+func (yand *Yandex) SearchNews(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, yand.Name(), false)
+	scoped := *yand
+	scoped.logger = yand.logger.WithRequest(ctx)
+	yand = &scoped
+
+	yand.logger.Debug("Starting news search, query: %+v", query)
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexNewsPageSize)
+	if err != nil {
+		return nil, err
+	}
+	u, err := BuildNewsURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	page, err := yand.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &yand.Browser)()
+
+	waitFor := []string{Selectors.NewsResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, yand.GetSelectorTimeout()); err != nil {
+		if pageErr := yand.classifyPage(page); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			yand.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyYandexDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseYandexNewsDocument(doc, time.Now())
+	yand.logger.Info("News search completed: %d results", len(results))
+	return pageYandexVertical(results, query, pageNum, skip, yandexNewsPageSize), nil
+}
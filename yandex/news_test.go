@@ -0,0 +1,70 @@
+package yandex
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseYandexNewsDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "news_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseYandexNewsDocument(doc, now)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 news results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	wantTimes := []time.Time{
+		time.Date(2024, time.March, 5, 9, 30, 0, 0, time.UTC),
+		time.Date(2024, time.March, 4, 18, 15, 0, 0, time.UTC),
+		time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+	}
+	for i, want := range wantTimes {
+		if !results[i].News.PublishedAt.Equal(want) {
+			t.Fatalf("result %d published %s, want %s", i, results[i].News.PublishedAt, want)
+		}
+	}
+	if results[0].News.Source != "Хабр" || results[0].News.Thumbnail == "" {
+		t.Fatalf("unexpected news metadata: %+v", results[0].News)
+	}
+}
+
+func TestPageYandexVerticalOffsetsRanks(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "news_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := pageYandexVertical(parseYandexNewsDocument(doc, time.Now()), core.Query{Start: 11}, 1, 1, yandexNewsPageSize)
+	if len(results) != 2 || results[0].Rank != 12 || results[0].AbsoluteRank != 12 {
+		t.Fatalf("unexpected paged results: %+v", results)
+	}
+}
+
+func TestBuildNewsURL(t *testing.T) {
+	u, err := BuildNewsURL(core.Query{Text: "golang", DateInterval: "20240301..20240301"}, 2)
+	if err != nil {
+		t.Fatalf("BuildNewsURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	params := parsed.Query()
+	if params.Get("text") != "golang" || params.Get("p") != "2" {
+		t.Fatalf("unexpected news URL: %s", u)
+	}
+	if got := params.Get("filter_date"); got != "1709251200000,1709337599999" {
+		t.Fatalf("unexpected filter_date %q", got)
+	}
+	if _, err := BuildNewsURL(core.Query{Text: "golang", DateInterval: "bad"}, 0); err == nil {
+		t.Fatal("expected malformed date interval to fail")
+	}
+}
@@ -55,11 +55,15 @@ func parseYandexDocument(doc *goquery.Document) []core.SearchResult {
 		isAd := yandexSelectionHasAdMarker(item) || yandexURLLooksAd(href)
 
 		if res, ok := assembleYandexRow(href, title, desc, isAd, rank); ok {
+			if !isAd {
+				core.ApplyResultExtras(&res, item, Selectors.Extras, nil)
+			}
 			results = append(results, res)
 		}
 	})
 
-	return core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractYandexFeatures(doc))
+	results = core.AttachFeaturesToFirstResult(core.DeduplicateResults(results), extractYandexFeatures(doc))
+	return core.AttachSerpMetaToFirstResult(results, parseYandexSerpMeta(doc))
 }
 
 // assembleYandexRow validates an already-extracted Yandex row and assigns ranks.
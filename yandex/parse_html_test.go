@@ -164,3 +164,42 @@ func TestParseYandexHTMLYabsURLIsAd(t *testing.T) {
 		t.Fatalf("second result should be organic rank 1: %+v", results[1])
 	}
 }
+
+func TestParseYandexHTMLSerpMeta(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	results, err := ParseHTML(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta := results[0].SerpMeta
+	if meta == nil {
+		t.Fatal("expected serp meta on first result")
+	}
+	if meta.EffectiveQuery != "how to fetch in javascript" || meta.Locale != "ru" {
+		t.Fatalf("meta = %+v", meta)
+	}
+
+	html := `<html lang="ru"><body>
+<input name="text" value="яндкс"/>
+<div class="misspell misspell_type_reask">Исправлена опечатка «<a class="misspell__link" href="/search/?text=яндекс">яндекс</a>»</div>
+<div class="serp-adv__found">Нашлось 5 млн результатов</div>
+<ul>
+  <li class="serp-item">
+    <h2><a href="https://ya.example/">Яндекс</a></h2>
+    <div class="OrganicText">Поиск</div>
+  </li>
+</ul></body></html>`
+	results, err = ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	meta = results[0].SerpMeta
+	if meta == nil || meta.TotalResults != 5000000 || meta.CorrectedQuery != "яндекс" || meta.EffectiveQuery != "яндекс" {
+		t.Fatalf("meta = %+v", meta)
+	}
+}
@@ -86,6 +86,10 @@ func (yand *Yandex) parseResults(results rod.Elements, pageNum int) []core.Searc
 		isAd := yandexElementHasAdMarker(r) || yandexURLLooksAd(href)
 
 		if res, ok := assembleYandexRow(href, title, desc, isAd, rank); ok {
+			if !isAd {
+				core.ApplyElementExtras(&res, r, Selectors.Extras, nil)
+			}
+			res.Layout = core.ElementLayout(r)
 			searchResults = append(searchResults, res)
 		}
 	}
@@ -141,31 +145,44 @@ const (
 	resultPollInterval   = 120 * time.Millisecond
 )
 
-func (yand *Yandex) waitForParsedResults(ctx context.Context, page *rod.Page, pageNum, wantOrganic int) ([]core.SearchResult, error) {
+// waitForParsedResults parses the result rows, polling briefly while more
+// hydrate. With measure set the page is stamped before each parse so rows
+// carry their layout boxes, and the last page-level layout is returned.
+func (yand *Yandex) waitForParsedResults(ctx context.Context, page *rod.Page, pageNum, wantOrganic int, measure bool) ([]core.SearchResult, *core.SerpLayout, error) {
 	elements, _, err := core.WaitForElements(ctx, page, []string{Selectors.Results}, yand.GetSelectorTimeout())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	results := yand.parseResults(elements, pageNum)
+	var layout *core.SerpLayout
+	parse := func(elements rod.Elements) []core.SearchResult {
+		// Archive each parse's page before stamping marks it; the last
+		// snapshot replaces the earlier ones.
+		core.ArchivePage(ctx, page)
+		if measure {
+			layout = core.StampLayout(page, layoutContainers()...)
+		}
+		return yand.parseResults(elements, pageNum)
+	}
+	results := parse(elements)
 	if wantOrganic <= 0 {
-		return results, nil
+		return results, layout, nil
 	}
 
 	deadline := time.Now().Add(resultHydrationGrace)
 	for core.CountOrganicResults(results) < wantOrganic && time.Now().Before(deadline) {
 		if err := core.SleepContext(ctx, resultPollInterval); err != nil {
-			return results, err
+			return results, layout, err
 		}
 		nextElements, eerr := page.Elements(Selectors.Results)
 		if eerr != nil || len(nextElements) <= len(elements) {
 			continue
 		}
 		elements = nextElements
-		results = yand.parseResults(nextElements, pageNum)
+		results = parse(nextElements)
 	}
 
-	return results, nil
+	return results, layout, nil
 }
 
 func (yand *Yandex) parseImageEntities(items rod.Elements) map[string]ImageEntity {
@@ -203,6 +220,7 @@ func (yand *Yandex) Search(ctx context.Context, query core.Query) (results []cor
 
 	allResults := []core.SearchResult{}
 	var pageFeatures []core.SerpFeature
+	var serpMeta *core.SerpMeta
 	const pageSize = 10
 	searchPage, skipOnFirstPage, err := core.ComputePagination(query.Start, pageSize)
 	if err != nil {
@@ -231,17 +249,20 @@ func (yand *Yandex) Search(ctx context.Context, query core.Query) (results []cor
 		if searchPage == startPage && skipOnFirstPage > 0 {
 			wantOrganic += skipOnFirstPage
 		}
-		r, err := yand.waitForParsedResults(ctx, page, searchPage, wantOrganic)
+		// Only the first page is measured; later pages have their own fold.
+		r, layout, err := yand.waitForParsedResults(ctx, page, searchPage, wantOrganic, query.Layout && searchPage == startPage)
 		if err != nil {
 			switch pageErr := yand.classifyPage(page); {
 			case errors.Is(pageErr, core.ErrCaptcha):
 				yand.logger.Error("Captcha detected: %s", url)
+				core.ArchivePage(ctx, page)
 				return false, core.ErrCaptcha
 			case errors.Is(pageErr, core.ErrEmptyResult):
 				yand.logger.Warn("No results found")
 				return true, nil
 			default:
 				yand.logger.Error("Cannot parse search results: %s", err)
+				core.ArchivePage(ctx, page)
 				return false, core.ErrSearchTimeout
 			}
 		}
@@ -252,6 +273,10 @@ func (yand *Yandex) Search(ctx context.Context, query core.Query) (results []cor
 		if query.Features && searchPage == startPage {
 			pageFeatures = extractYandexFeaturesFromPage(page)
 		}
+		if searchPage == startPage {
+			core.CaptureScreenshot(ctx, page)
+			serpMeta = core.WithSerpLayout(core.SerpMetaFromPage(page, parseYandexSerpMeta), layout)
+		}
 		allResults = append(allResults, r...)
 		return false, nil
 	}
@@ -280,7 +305,8 @@ func (yand *Yandex) Search(ctx context.Context, query core.Query) (results []cor
 
 	yand.logger.Info("Search completed: %d results", len(allResults))
 	limited := core.LimitOrganicResults(core.DeduplicateResults(allResults), query.Limit)
-	return core.AttachFeaturesToFirstResult(limited, pageFeatures), nil
+	limited = core.AttachFeaturesToFirstResult(limited, pageFeatures)
+	return core.AttachSerpMetaToFirstResult(limited, serpMeta), nil
 }
 
 // SearchImage executes a Yandex image search and returns normalized image
@@ -1,5 +1,7 @@
 package yandex
 
+import "github.com/karust/openserp/core"
+
 // Selectors is the single source of truth for Yandex SERP CSS selectors.
 var Selectors = struct {
 	Captcha   string
@@ -16,6 +18,52 @@ var Selectors = struct {
 	ImageItems    string
 	ImageItemsAlt []string
 	ImageStateAll string
+
+	// Neuro (Алиса AI) answer above the results.
+	NeuroAnswer core.AISummarySelectors
+
+	// Sitelinks, breadcrumbs and rich snippet lines of organic results.
+	Extras core.ResultExtrasSelectors
+
+	// SERP meta: the "Нашлось N результатов" line, the typo notices and the
+	// search box.
+	ResultCount    string
+	SpellCorrected string
+	SpellSuggested string
+	SearchBox      string
+
+	// News search (newssearch.yandex.ru).
+	NewsResults   string
+	NewsLink      string
+	NewsTitle     string
+	NewsSnippet   string
+	NewsSource    string
+	NewsTime      string
+	NewsThumbnail string
+
+	// Video search (/video/search).
+	VideoResults   string
+	VideoLink      string
+	VideoTitle     string
+	VideoDuration  string
+	VideoChannel   string
+	VideoHost      string
+	VideoTime      string
+	VideoThumbnail string
+
+	// Product search (/products/search) and inline product carousels.
+	ShoppingResults     string
+	ShoppingCard        core.ProductCardSelectors
+	ProductCarousel     string
+	ProductCarouselItem string
+
+	// Organization block (local pack) on regular result pages.
+	LocalPack    string
+	LocalResults string
+	LocalCard    core.LocalCardSelectors
+
+	// Entity card (object answer) beside the results.
+	KnowledgePanel core.KnowledgePanelSelectors
 }{
 	Captcha:   "div.CheckboxCaptcha",
 	NoResults: "div.EmptySearchResults",
@@ -37,4 +85,108 @@ var Selectors = struct {
 	ImageItems:    "div[role='main'] div[data-state]",
 	ImageItemsAlt: []string{"div[data-state*='serpList']"},
 	ImageStateAll: "div[data-state]",
+
+	// NeuroAnswer is the neuro_answer card. Its prose is one or more
+	// FuturisMarkdown blocks of paragraphs, code, lists and h2 headings, with
+	// FuturisFootnote host links after the cited sentences. The numbered
+	// source list ("15 источников") repeats under the folded card; the
+	// reasoning skeleton stays empty until the answer starts streaming.
+	NeuroAnswer: core.AISummarySelectors{
+		Container:  "li[data-fast-name='neuro_answer']:has(div.FuturisMarkdown), div.FuturisSearch:has(div.FuturisMarkdown)",
+		Body:       "section.FuturisGPTMessage-GroupContent",
+		Block:      "div.FuturisMarkdown > *",
+		Heading:    "h2, h3",
+		Chip:       "a.FuturisFootnote, button.FuturisMarkdown-CopyText, h3.A11yHidden",
+		Source:     "li.FuturisGPTMessage-SourcesItem",
+		SourceLink: "a.FuturisSource",
+		SourceName: "div.FuturisSource-Host",
+		Loading:    "div.Futuris-SkeletonReasoning:not(.Futuris-SkeletonReasoning_withContent)",
+		Pending:    "li[data-fast-name='neuro_answer']",
+	},
+
+	// Extras: the Path line reads "example.com › docs › page" with
+	// Path-Separator spans between the parts. Sitelinks are a row of bare
+	// Sitelinks-Title links; the extended snippet's meta line holds the
+	// rating, review count and price separated by dots.
+	Extras: core.ResultExtrasSelectors{
+		Sitelink:   "div.Sitelinks a.Sitelinks-Title",
+		Breadcrumb: "div.Path",
+		RichLine:   "div.OrganicMeta, div.Organic-Meta",
+		Rating:     "span.Rating-Value",
+		Date:       "span.OrganicTextContentSpan > span.Date, div.Organic-Date",
+	},
+
+	ResultCount: ".serp-adv__found, .SerpStatistics",
+	// SpellCorrected is the "Исправлена опечатка" notice (Yandex already ran
+	// the fixed query); SpellSuggested is "Возможно, вы имели в виду".
+	SpellCorrected: ".misspell_type_reask .misspell__link, .Misspell_type_reask a",
+	SpellSuggested: ".misspell_type_misspell .misspell__link, .Misspell_type_misspell a",
+	SearchBox:      "input[name='text']",
+
+	NewsResults:   "article.mg-snippet",
+	NewsLink:      "a.mg-snippet__url",
+	NewsTitle:     ".mg-snippet__title",
+	NewsSnippet:   ".mg-snippet__text",
+	NewsSource:    ".mg-snippet-source-info__agency-name",
+	NewsTime:      ".mg-snippet-source-info__time",
+	NewsThumbnail: ".mg-snippet__image img",
+
+	// VideoHost is the hosting site label ("YouTube", "VK Видео"); the
+	// platform is still derived from the link when it is missing.
+	VideoResults:   "div.VideoSnippet",
+	VideoLink:      "a.VideoSnippet-Link",
+	VideoTitle:     ".VideoSnippet-Title",
+	VideoDuration:  ".VideoThumb-Duration",
+	VideoChannel:   ".VideoSnippet-Channel",
+	VideoHost:      ".VideoHostExtended-Host",
+	VideoTime:      ".VideoSnippet-Date",
+	VideoThumbnail: ".VideoThumb img",
+
+	// ShoppingResults selects one offer card on the Yandex products page.
+	// The carousel on regular result pages renders the same card component,
+	// so both share ShoppingCard.
+	ShoppingResults: "div.ProductCard",
+	ShoppingCard: core.ProductCardSelectors{
+		Link:      "a.EProductSnippetTitle, a.ProductCard-Link",
+		Title:     ".EProductSnippetTitle",
+		Price:     ".EPrice-Value, .EPriceGroup-Price",
+		Merchant:  ".EShopName",
+		Rating:    ".ERating-Value, .ShopRating-Value",
+		Reviews:   ".EReviews, .ERating-Reviews",
+		Thumbnail: "img.EThumb-Image, img",
+	},
+	ProductCarousel:     "li[data-fast-name='products'], li[data-fast-wrapper='products']",
+	ProductCarouselItem: "div.ProductCard",
+
+	// LocalPack is the companies list Yandex shows for place queries; every
+	// field has its own element, so no details line is classified.
+	LocalPack:    "li[data-fast-name='companies']",
+	LocalResults: "li.OrgsList-Item",
+	LocalCard: core.LocalCardSelectors{
+		Name:     ".OrgsList-Title",
+		Rating:   ".OrgRating-Value, .RatingOneStar-Value",
+		Reviews:  ".OrgRating-Reviews",
+		Category: ".OrgsList-Rubric",
+		Address:  ".OrgsList-Address",
+		Phone:    ".OrgsList-Phone",
+		Hours:    ".OrgsList-Hours",
+		Website:  "a.OrgsList-Site",
+		MapLink:  "a[href*='/maps/org/']",
+	},
+
+	// KnowledgePanel is the entity_search block; its facts are key/value
+	// pairs and its description ends with a source link.
+	KnowledgePanel: core.KnowledgePanelSelectors{
+		Container:   "li[data-fast-name='entity_search'], .EntitySearch",
+		Title:       ".EntityTitle-Title, .entity-search__title",
+		EntityType:  ".EntityTitle-Subtitle, .entity-search__subtitle",
+		Description: ".EntityDescription-Text, .entity-search__description",
+		Source:      ".EntityDescription-Source a[href], a.EntityDescription-SourceLink[href]",
+		Attribute:   ".EntityFacts-Item, .entity-search__fact",
+		Label:       ".EntityFacts-Key, .entity-search__fact-key",
+		Value:       ".EntityFacts-Value, .entity-search__fact-value",
+		Image:       ".EntityImages img, .Entity-Image img",
+		Website:     "a.EntitySite-Link[href], .EntitySite a[href]",
+		Profile:     ".EntitySocial a[href], .EntitySocialLinks a[href]",
+	},
 }
@@ -2,9 +2,11 @@ package yandex
 
 import (
 	"bytes"
+	"os"
 	"strings"
 	"testing"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/karust/openserp/core"
 )
 
@@ -122,3 +124,244 @@ func assertNoFeatures(t *testing.T, results []core.SearchResult) {
 		}
 	}
 }
+
+func TestParseHTMLExtractsLocalPack(t *testing.T) {
+	t.Parallel()
+
+	html := `
+<ul>
+  <li data-fast="1">
+    <a class="OrganicTitle-Link" href="https://example.com/result"><h2>Organic result</h2></a>
+    <span class="OrganicTextContentSpan">Snippet</span>
+  </li>
+  <li data-fast-name="companies">
+    <ul class="OrgsList">
+      <li class="OrgsList-Item">
+        <a href="https://yandex.ru/maps/org/kofemaniya/1001/"><div class="OrgsList-Title">Кофемания</div></a>
+        <span class="OrgRating-Value">4,7</span><span class="OrgRating-Reviews">2,1 тыс. отзывов</span>
+        <div class="OrgsList-Rubric">Кофейня</div>
+        <div class="OrgsList-Address">Большая Никитская ул., 13/6</div>
+        <div class="OrgsList-Hours">Открыто до 23:00</div>
+        <div class="OrgsList-Phone">+7 495 775-52-25</div>
+        <a class="OrgsList-Site" href="https://coffeemania.ru/">coffeemania.ru</a>
+      </li>
+    </ul>
+  </li>
+</ul>`
+
+	results, err := ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var pack *core.SerpFeature
+	for _, result := range results {
+		for i := range result.Features {
+			if result.Features[i].Type == core.ResultTypeLocal {
+				pack = &result.Features[i]
+			}
+		}
+	}
+	if pack == nil || len(pack.Items) != 1 {
+		t.Fatalf("expected local pack with one organization, got %#v", pack)
+	}
+	want := core.LocalMeta{
+		Position: 1, Rating: 4.7, ReviewCount: 2100, Category: "Кофейня",
+		Address: "Большая Никитская ул., 13/6", Phone: "+7 495 775-52-25", Hours: "Открыто до 23:00",
+		Website: "https://coffeemania.ru/", MapURL: "https://yandex.ru/maps/org/kofemaniya/1001/",
+	}
+	if got := pack.Items[0].Local; got == nil || *got != want {
+		t.Fatalf("unexpected organization: %+v", got)
+	}
+}
+
+func TestParseHTMLExtractsKnowledgePanel(t *testing.T) {
+	t.Parallel()
+	body, err := os.ReadFile("testdata/knowledge_panel.html")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	results, err := ParseHTML(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	var panel *core.SerpFeature
+	for _, result := range results {
+		if strings.Contains(result.Title, "Лев Николаевич Толстой") {
+			t.Fatalf("entity card leaked into organic results: %+v", result)
+		}
+		for i := range result.Features {
+			if result.Features[i].Type == core.ResultTypeKnowledgePanel {
+				panel = &result.Features[i]
+			}
+		}
+	}
+	if panel == nil || panel.Knowledge == nil {
+		t.Fatalf("expected knowledge panel feature in %#v", results)
+	}
+	kp := panel.Knowledge
+	if panel.Title != "Лев Николаевич Толстой" || kp.EntityType != "Русский писатель" || !strings.HasPrefix(panel.Text, "Граф Лев") {
+		t.Fatalf("unexpected panel header: %q %q %q", panel.Title, kp.EntityType, panel.Text)
+	}
+	if kp.DescriptionSource != "Википедия" || !strings.HasPrefix(kp.DescriptionURL, "https://ru.wikipedia.org/wiki/") {
+		t.Fatalf("unexpected description source: %+v", kp)
+	}
+	want := []core.KnowledgeAttribute{
+		{Label: "Дата рождения", Value: "9 сентября 1828 г."},
+		{Label: "Дата смерти", Value: "20 ноября 1910 г. (82 года)"},
+		{Label: "Супруга", Value: "Софья Андреевна Толстая"},
+		{Label: "Жанры", Value: "роман, повесть, рассказ"},
+	}
+	if len(kp.Attributes) != len(want) {
+		t.Fatalf("expected %d attributes, got %+v", len(want), kp.Attributes)
+	}
+	for i := range want {
+		if kp.Attributes[i] != want[i] {
+			t.Fatalf("attribute %d = %+v, want %+v", i, kp.Attributes[i], want[i])
+		}
+	}
+	if len(kp.Images) != 2 || kp.Images[0] != "https://avatars.mds.yandex.net/get-entity_search/tolstoy/S122x122" {
+		t.Fatalf("unexpected images: %v", kp.Images)
+	}
+	if kp.Website != "https://tolstoy.ru/" {
+		t.Fatalf("unexpected website: %q", kp.Website)
+	}
+	if len(kp.Profiles) != 3 || kp.Profiles[0].Network != "VK" || kp.Profiles[1].Network != "Telegram" || kp.Profiles[2].Network != "Дзен" {
+		t.Fatalf("unexpected profiles: %+v", kp.Profiles)
+	}
+}
+
+func TestParseHTMLExtractsResultExtras(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if len(results) == 0 || len(results[0].Breadcrumbs) != 2 || results[0].Breadcrumbs[0] != "developer.mozilla.org" {
+		t.Fatalf("expected the Path line as breadcrumbs, got %+v", results)
+	}
+
+	html := `
+<ul>
+  <li class="serp-item" data-fast="1">
+    <a class="OrganicTitle-Link" href="https://market.example.ru/"><h2>Маркет</h2></a>
+    <div class="Path"><a class="Path-Item" href="https://market.example.ru/"><b>market.example.ru</b><span class="Path-Separator">›</span>catalog</a></div>
+    <span class="OrganicTextContentSpan">Электроника и товары для дома.</span>
+    <div class="Sitelinks">
+      <div class="Sitelinks-Item"><a class="Sitelinks-Title" href="https://market.example.ru/electronics">Электроника</a></div>
+      <div class="Sitelinks-Item"><a class="Sitelinks-Title" href="https://market.example.ru/home">Товары для дома</a></div>
+    </div>
+    <div class="OrganicMeta">Рейтинг: 4,7 · 1 250 отзывов</div>
+  </li>
+</ul>`
+
+	results, err = ParseHTML(bytes.NewReader([]byte(html)))
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	res := results[0]
+	if len(res.Sitelinks) != 2 || res.Sitelinks[1].Title != "Товары для дома" {
+		t.Fatalf("unexpected sitelinks: %+v", res.Sitelinks)
+	}
+	if len(res.Breadcrumbs) != 2 || res.Breadcrumbs[1] != "catalog" {
+		t.Fatalf("unexpected breadcrumbs: %q", res.Breadcrumbs)
+	}
+	if res.RichSnippet == nil || res.RichSnippet.Rating != 4.7 || res.RichSnippet.ReviewCount != 1250 {
+		t.Fatalf("unexpected rich snippet: %+v", res.RichSnippet)
+	}
+}
+
+func TestParseHTMLFixtureNeuroAnswerSectionsAndCitations(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/search_results.html")
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	defer f.Close()
+
+	results, err := ParseHTML(f)
+	if err != nil {
+		t.Fatalf("ParseHTML() error = %v", err)
+	}
+	if results[0].SerpMeta == nil || results[0].SerpMeta.AISummary != core.AISummaryPresent {
+		t.Fatalf("expected the Neuro answer to be reported present, got %+v", results[0].SerpMeta)
+	}
+	var answer *core.SerpFeature
+	for i := range results[0].Features {
+		if results[0].Features[i].Type == core.ResultTypeAISummary {
+			if answer != nil {
+				t.Fatal("expected exactly 1 ai_summary feature")
+			}
+			answer = &results[0].Features[i]
+		}
+	}
+	if answer == nil || answer.Title != "Нейро" {
+		t.Fatalf("expected the Neuro answer, got %+v", answer)
+	}
+	if !strings.HasPrefix(answer.Text, "Fetch API— современный интерфейс") {
+		t.Fatalf("unexpected answer text: %q", answer.Text)
+	}
+	for _, noise := range []string{"Содержимое ответа", "Скопировать", "skillbox.rulearnjs.ru", "источников"} {
+		if strings.Contains(answer.Text, noise) {
+			t.Fatalf("Neuro text must leave out footnotes, copy buttons and the source list, found %q in %q", noise, answer.Text)
+		}
+	}
+
+	var headings []string
+	for _, item := range answer.Items {
+		if item.Title != "" {
+			headings = append(headings, item.Title)
+		}
+	}
+	want := "Базовый синтаксис|Пример GET-запроса|Дополнительные параметрыoptions|Обработка ответов|Обработка ошибок|Дополнительные возможности"
+	if strings.Join(headings, "|") != want {
+		t.Fatalf("unexpected sections: %q", headings)
+	}
+
+	// "15 источников" lists developer.mozilla.org twice, and the fixture
+	// rewrites every URL to the same placeholder, so those two count once.
+	if len(answer.Links) != 14 {
+		t.Fatalf("expected 14 cited sources, got %d: %+v", len(answer.Links), answer.Links)
+	}
+	first, last := answer.Links[0], answer.Links[13]
+	if first.Title != "developer.mozilla.org" || first.Source != "developer.mozilla.org" || first.Position != 1 {
+		t.Fatalf("unexpected first citation: %+v", first)
+	}
+	if last.Source != "trackjs.com" || last.Position != 14 {
+		t.Fatalf("unexpected last citation: %+v", last)
+	}
+}
+
+func TestExtractYandexNeuroAnswerStatus(t *testing.T) {
+	t.Parallel()
+
+	doc := func(html string) *goquery.Document {
+		d, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+		if err != nil {
+			t.Fatalf("parse html: %v", err)
+		}
+		return d
+	}
+
+	streaming := `<ul><li data-fast-name="neuro_answer"><div class="Futuris-SkeletonReasoning"></div>
+<section class="FuturisGPTMessage-GroupContent"><div class="FuturisMarkdown"><div>Fetch API — интерфейс</div></div></section></li></ul>`
+	answer, status := extractYandexNeuroAnswer(doc(streaming))
+	if status != core.AISummaryLoading || answer == nil || answer.Text != "Fetch API — интерфейс" {
+		t.Fatalf("streaming answer: status = %q, feature = %+v", status, answer)
+	}
+
+	shell := `<ul><li data-fast-name="neuro_answer"><div class="FuturisSearch"></div></li></ul>`
+	if answer, status := extractYandexNeuroAnswer(doc(shell)); status != core.AISummaryLoading || answer != nil {
+		t.Fatalf("empty shell: status = %q, feature = %+v", status, answer)
+	}
+
+	if _, status := extractYandexNeuroAnswer(doc(`<ul><li data-fast="1">Organic</li></ul>`)); status != core.AISummaryAbsent {
+		t.Fatalf("no answer: status = %q", status)
+	}
+}
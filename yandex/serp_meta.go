@@ -0,0 +1,22 @@
+package yandex
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+// parseYandexSerpMeta reads the result estimate, typo notices, Neuro answer
+// status and locale.
+// Yandex hides the result count on most current layouts and never prints a
+// search time, so those fields are often empty.
+func parseYandexSerpMeta(doc *goquery.Document) *core.SerpMeta {
+	meta := &core.SerpMeta{
+		TotalResults:   core.ParseResultCount(doc.Find(Selectors.ResultCount).First().Text()),
+		CorrectedQuery: strings.TrimSpace(doc.Find(Selectors.SpellCorrected).First().Text()),
+		SuggestedQuery: strings.TrimSpace(doc.Find(Selectors.SpellSuggested).First().Text()),
+	}
+	_, meta.AISummary = extractYandexNeuroAnswer(doc)
+	return core.FinishSerpMeta(doc, meta, doc.Find(Selectors.SearchBox).First().AttrOr("value", ""))
+}
@@ -0,0 +1,166 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+const yandexShoppingPageSize = 30
+
+// ParseShoppingHTML parses a Yandex products search HTML document.
+func ParseShoppingHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyYandexDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseYandexShoppingDocument(doc), nil
+}
+
+// parseYandexShoppingDocument extracts offer cards from the products page.
+func parseYandexShoppingDocument(doc *goquery.Document) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankState(0)
+
+	doc.Find(Selectors.ShoppingResults).Each(func(_ int, card *goquery.Selection) {
+		title, link, product, ok := core.ParseProductCard(card, Selectors.ShoppingCard, yandexAbsoluteHref)
+		if !ok {
+			return
+		}
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          link,
+			Title:        title,
+			Product:      product,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// yandexAbsoluteHref makes product page and map links absolute; Yandex links
+// its own pages relative to the search host.
+func yandexAbsoluteHref(href string) string {
+	switch {
+	case strings.HasPrefix(href, "//"):
+		return "https:" + href
+	case strings.HasPrefix(href, "/"):
+		return baseURL + href
+	}
+	return href
+}
+
+// extractYandexProductCarousel captures the product block Yandex mixes into
+// regular result pages for commercial queries.
+func extractYandexProductCarousel(doc *goquery.Document) []core.SerpFeature {
+	return core.ExtractProductCarousel(doc, Selectors.ProductCarousel, Selectors.ProductCarouselItem, Selectors.ShoppingCard, yandexAbsoluteHref)
+}
+
+// SearchShopping runs a raw HTTP request against Yandex products search.
+func SearchShopping(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "yandex", false)
+
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexShoppingPageSize)
+	if err != nil {
+		return nil, err
+	}
+	shoppingURL, err := BuildShoppingURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", shoppingURL).Debug(fmt.Sprintf("Yandex Products URL built: %s", shoppingURL))
+
+	res, err := core.RawSearchRequest(ctx, shoppingURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyYandexDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseYandexShoppingDocument(doc)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: yandex products returned no parseable results", core.ErrParser)
+	}
+	return pageYandexVertical(results, query, pageNum, skip, yandexShoppingPageSize), nil
+}
+
+// SearchShopping executes a Yandex products search in the browser.
+func (yand *Yandex) SearchShopping(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, yand.Name(), false)
+	scoped := *yand
+	scoped.logger = yand.logger.WithRequest(ctx)
+	yand = &scoped
+
+	yand.logger.Debug("Starting shopping search, query: %+v", query)
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexShoppingPageSize)
+	if err != nil {
+		return nil, err
+	}
+	u, err := BuildShoppingURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	page, err := yand.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &yand.Browser)()
+
+	waitFor := []string{Selectors.ShoppingResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, yand.GetSelectorTimeout()); err != nil {
+		if pageErr := yand.classifyPage(page); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			yand.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyYandexDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseYandexShoppingDocument(doc)
+	yand.logger.Info("Shopping search completed: %d results", len(results))
+	return pageYandexVertical(results, query, pageNum, skip, yandexShoppingPageSize), nil
+}
@@ -0,0 +1,47 @@
+package yandex
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseYandexShoppingDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "shopping_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	results := parseYandexShoppingDocument(doc)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 shopping results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0]
+	want := core.ProductMeta{Price: 52990, Currency: "RUB", Merchant: "Ситилинк", Rating: 4.8, ReviewCount: 1200, Thumbnail: "https://avatars.mds.yandex.net/get-mpic/123/pixel8/200x200"}
+	if first.URL != "https://www.yandex.com/products/product/101?text=pixel+8" || *first.Product != want {
+		t.Fatalf("unexpected first result: %+v %+v", first, first.Product)
+	}
+	second := results[1].Product
+	if second.Price != 79500 || second.ReviewCount != 87 || second.Rating != 0 {
+		t.Fatalf("unexpected second product metadata: %+v", second)
+	}
+}
+
+func TestBuildShoppingURL(t *testing.T) {
+	u, err := BuildShoppingURL(core.Query{Text: "pixel 8", Region: "RU"}, 2)
+	if err != nil {
+		t.Fatalf("BuildShoppingURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse URL: %v", err)
+	}
+	params := parsed.Query()
+	if parsed.Path != "/products/search" || params.Get("text") != "pixel 8" || params.Get("p") != "2" || params.Get("lr") == "" {
+		t.Fatalf("unexpected shopping URL: %s", u)
+	}
+}
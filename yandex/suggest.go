@@ -0,0 +1,21 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/karust/openserp/core"
+)
+
+// Suggest fetches Yandex's query completions for query.Text, ranked for the
+// lr region when one is set.
+func Suggest(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "yandex", false)
+
+	suggestURL, err := BuildSuggestURL(query)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", suggestURL).Debug(fmt.Sprintf("Yandex suggest URL built: %s", suggestURL))
+	return core.FetchSuggestions(ctx, suggestURL, query, core.ParseOpenSearchSuggestions)
+}
@@ -0,0 +1,22 @@
+package yandex
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/karust/openserp/core"
+)
+
+func TestBuildSuggestURL(t *testing.T) {
+	u, err := BuildSuggestURL(core.Query{Text: "горутины", LangCode: "ru"})
+	if err != nil {
+		t.Fatalf("BuildSuggestURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	if parsed.Host != "suggest.yandex.com" || parsed.Query().Get("part") != "горутины" || parsed.Query().Get("uil") != "ru" {
+		t.Fatalf("unexpected suggest URL: %s", u)
+	}
+}
@@ -4,11 +4,62 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/karust/openserp/core"
 )
 
-const baseURL = "https://www.yandex.com"
+const (
+	baseURL     = "https://www.yandex.com"
+	newsBaseURL = "https://newssearch.yandex.ru"
+	suggestURL  = "https://suggest.yandex.com/suggest-ff.cgi"
+)
+
+// Operators is Yandex's spelling of the structured query operators: title:
+// instead of intitle: and | for OR.
+var Operators = core.OperatorSyntax{
+	Phrase:      true,
+	Exclude:     "-",
+	ExcludeSite: "-site:",
+	InTitle:     "title:",
+	InURL:       "inurl:",
+	Or:          " | ",
+}
+
+// SupportsSafeSearch reports whether Yandex URLs can carry level. Strict maps
+// to family=yes and moderate is the default; turning the filter off is only a
+// saved setting, with no URL parameter.
+func SupportsSafeSearch(level core.SafeSearch) bool {
+	return level != core.SafeSearchOff
+}
+
+// addFamilyFilter turns on Yandex's family filter for strict SafeSearch. Every
+// search tab reads the same family= parameter.
+func addFamilyFilter(params url.Values, q core.Query) {
+	if q.SafeSearch == core.SafeSearchStrict {
+		params.Add("family", "yes")
+	}
+}
+
+// SupportsVerbatim reports whether Yandex can run a verbatim query on
+// vertical. Yandex News is a separate service without the switch.
+func SupportsVerbatim(vertical core.Vertical) bool {
+	switch vertical {
+	case core.VerticalWeb, core.VerticalImage, core.VerticalVideo, core.VerticalShopping:
+		return true
+	}
+	return false
+}
+
+// addVerbatim sends noreask=1, Yandex's "search exactly for" switch, for
+// verbatim queries: it turns off the automatic typo fix ("Исправлена
+// опечатка").
+func addVerbatim(params url.Values, q core.Query) {
+	if q.Verbatim {
+		params.Add("noreask", "1")
+	}
+}
 
 // BuildURL builds a Yandex web search URL for the provided query and page
 // index. It returns an error when the resulting query text is empty.
@@ -17,7 +68,7 @@ func BuildURL(q core.Query, page int) (string, error) {
 	base.Path += "search/"
 
 	params := url.Values{}
-	if q.Text != "" || q.Site != "" || q.Filetype != "" {
+	if !q.IsEmpty() {
 		text := q.Text
 		if q.Site != "" {
 			text += " site:" + q.Site
@@ -25,6 +76,8 @@ func BuildURL(q core.Query, page int) (string, error) {
 		if q.Filetype != "" {
 			text += " mime:" + q.Filetype
 		}
+		operators, _ := Operators.Compile(q)
+		text += operators
 		if q.DateInterval != "" {
 			text += " date:" + q.DateInterval
 		}
@@ -48,6 +101,8 @@ func BuildURL(q core.Query, page int) (string, error) {
 		// often. lr alone still ranks toward the region, just less precisely.
 		// params.Add("rstr", "true")
 	}
+	addFamilyFilter(params, q)
+	addVerbatim(params, q)
 
 	base.RawQuery = params.Encode()
 	return base.String(), nil
@@ -89,11 +144,139 @@ func BuildImageURL(q core.Query, page int) (string, error) {
 		// often. lr alone still ranks toward the region, just less precisely.
 		// params.Add("rstr", "true")
 	}
+	addFamilyFilter(params, q)
+	addVerbatim(params, q)
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildNewsURL builds a Yandex News search URL for the provided query and page
+// index. It returns an error when the query text or date interval is invalid.
+func BuildNewsURL(q core.Query, page int) (string, error) {
+	base, _ := url.Parse(newsBaseURL)
+	base.Path += "news/search"
+
+	params := url.Values{}
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		params.Add("text", text)
+		params.Add("p", fmt.Sprint(page))
+	}
+
+	if len(params.Get("text")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	// News search ignores the date: operator; it takes an epoch-millisecond
+	// range instead, end day inclusive.
+	if q.DateInterval != "" {
+		intervals := strings.Split(q.DateInterval, "..")
+		if len(intervals) != 2 {
+			return "", errors.New("incorrect date interval provided")
+		}
+		from, err := time.Parse("20060102", intervals[0])
+		if err != nil {
+			return "", errors.New("invalid start date format, expected YYYYMMDD")
+		}
+		to, err := time.Parse("20060102", intervals[1])
+		if err != nil {
+			return "", errors.New("invalid end date format, expected YYYYMMDD")
+		}
+		toMillis := to.AddDate(0, 0, 1).UnixMilli() - 1
+		params.Add("filter_date", fmt.Sprintf("%d,%d", from.UnixMilli(), toMillis))
+	}
+
+	if lr := yandexLR(q.Region); lr != "" {
+		params.Add("lr", lr)
+	}
+	addFamilyFilter(params, q)
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildVideoURL builds a Yandex video search URL for the provided query and
+// page index. It returns an error when the resulting query text is empty.
+func BuildVideoURL(q core.Query, page int) (string, error) {
+	base, _ := url.Parse(baseURL)
+	base.Path += "video/search"
+
+	params := url.Values{}
+	if q.Text != "" || q.Site != "" {
+		text := q.Text
+		if q.Site != "" {
+			text += " site:" + q.Site
+		}
+		if q.DateInterval != "" {
+			text += " date:" + q.DateInterval
+		}
+		params.Add("text", text)
+		params.Add("p", fmt.Sprint(page))
+	}
+
+	if len(params.Get("text")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	if lr := yandexLR(q.Region); lr != "" {
+		params.Add("lr", lr)
+	}
+	addFamilyFilter(params, q)
+	addVerbatim(params, q)
+
+	base.RawQuery = params.Encode()
+	return base.String(), nil
+}
+
+// BuildShoppingURL builds a Yandex products search URL for the provided query
+// and page index. Product search has no site: operator, so q.Site is ignored.
+// It returns an error when the resulting query text is empty.
+func BuildShoppingURL(q core.Query, page int) (string, error) {
+	base, _ := url.Parse(baseURL)
+	base.Path += "products/search"
+
+	params := url.Values{}
+	if q.Text != "" {
+		params.Add("text", q.Text)
+		params.Add("p", fmt.Sprint(page))
+	}
+
+	if len(params.Get("text")) == 0 {
+		return "", errors.New("empty query built")
+	}
+
+	if lr := yandexLR(q.Region); lr != "" {
+		params.Add("lr", lr)
+	}
+	addFamilyFilter(params, q)
+	addVerbatim(params, q)
 
 	base.RawQuery = params.Encode()
 	return base.String(), nil
 }
 
+// BuildSuggestURL builds a Yandex autocomplete URL for q.Text. suggest-ff.cgi
+// answers in the OpenSearch suggestions format; uil sets the interface
+// language and lr the region suggestions are ranked for.
+func BuildSuggestURL(q core.Query) (string, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return "", errors.New("empty query built")
+	}
+	params := url.Values{}
+	params.Add("part", q.Text)
+	if locale := core.ParseLocale(q.LangCode); locale.Language != "" {
+		params.Add("uil", locale.Language)
+	}
+	if lr := yandexLR(q.Region); lr != "" {
+		params.Add("lr", lr)
+	}
+	return suggestURL + "?" + params.Encode(), nil
+}
+
 func yandexLR(region string) string {
 	return core.ResolveRegion(region).YandexLR
 }
@@ -99,3 +99,64 @@ func TestBuildImageURLRegionLR(t *testing.T) {
 		t.Fatalf("rstr should never be set, got %q", gotRstr)
 	}
 }
+
+func TestBuildURLSafeSearch(t *testing.T) {
+	strict, err := BuildURL(core.Query{Text: "golang", SafeSearch: core.SafeSearchStrict}, 0)
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	if !strings.Contains(strict, "family=yes") {
+		t.Fatalf("expected family=yes for strict, got %s", strict)
+	}
+	moderate, err := BuildImageURL(core.Query{Text: "golang", SafeSearch: core.SafeSearchModerate}, 0)
+	if err != nil {
+		t.Fatalf("BuildImageURL() error = %v", err)
+	}
+	if strings.Contains(moderate, "family=") {
+		t.Fatalf("moderate is the Yandex default and should send nothing, got %s", moderate)
+	}
+	if SupportsSafeSearch(core.SafeSearchOff) || !SupportsSafeSearch(core.SafeSearchStrict) {
+		t.Fatal("Yandex can tighten the filter but not turn it off")
+	}
+
+	builders := map[string]func(core.Query, int) (string, error){
+		"image":    BuildImageURL,
+		"news":     BuildNewsURL,
+		"video":    BuildVideoURL,
+		"shopping": BuildShoppingURL,
+	}
+	for name, build := range builders {
+		got, err := build(core.Query{Text: "golang", SafeSearch: core.SafeSearchStrict}, 0)
+		if err != nil {
+			t.Fatalf("%s builder error = %v", name, err)
+		}
+		if !strings.Contains(got, "family=yes") {
+			t.Fatalf("expected family=yes on the %s URL, got %s", name, got)
+		}
+	}
+}
+
+func TestBuildURLVerbatim(t *testing.T) {
+	got, err := BuildURL(core.Query{Text: "яндкс", Verbatim: true}, 0)
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	if !strings.Contains(got, "noreask=1") {
+		t.Fatalf("expected noreask=1 for verbatim, got %s", got)
+	}
+	plain, _ := BuildURL(core.Query{Text: "яндкс"}, 0)
+	if strings.Contains(plain, "noreask") {
+		t.Fatalf("non-verbatim URL carries noreask: %s", plain)
+	}
+}
+
+func TestBuildURLOperators(t *testing.T) {
+	got, err := BuildURL(core.Query{Text: "golang", InTitle: "generics", OrTerms: []string{"tutorial", "guide"}}, 0)
+	if err != nil {
+		t.Fatalf("BuildURL() error = %v", err)
+	}
+	parsed, _ := url.Parse(got)
+	if text := parsed.Query().Get("text"); text != "golang title:generics (tutorial | guide)" {
+		t.Fatalf("unexpected text value: %q", text)
+	}
+}
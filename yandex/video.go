@@ -0,0 +1,182 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+)
+
+const yandexVideoPageSize = 20
+
+// ParseVideosHTML parses a Yandex video search HTML document.
+func ParseVideosHTML(r io.Reader) ([]core.SearchResult, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	pageStatus := classifyYandexDocument(doc)
+	if errors.Is(pageStatus, core.ErrEmptyResult) {
+		return []core.SearchResult{}, nil
+	}
+	if pageStatus != nil {
+		return nil, pageStatus
+	}
+	return parseYandexVideoDocument(doc, time.Now()), nil
+}
+
+// parseYandexVideoDocument extracts VideoSnippet cards. Upload dates use the
+// same relative and genitive-month forms as news cards.
+func parseYandexVideoDocument(doc *goquery.Document, now time.Time) []core.SearchResult {
+	results := []core.SearchResult{}
+	rank := core.NewRankState(0)
+
+	doc.Find(Selectors.VideoResults).Each(func(_ int, card *goquery.Selection) {
+		href := strings.TrimSpace(card.Find(Selectors.VideoLink).First().AttrOr("href", ""))
+		title := core.NormalizeWhitespace(card.Find(Selectors.VideoTitle).First().Text())
+		if !strings.HasPrefix(href, "http") || title == "" {
+			return
+		}
+
+		meta := &core.VideoMeta{
+			Channel:   core.NormalizeWhitespace(card.Find(Selectors.VideoChannel).First().Text()),
+			Thumbnail: yandexVideoThumbnail(card.Find(Selectors.VideoThumbnail).First().AttrOr("src", "")),
+		}
+		if d, ok := core.ParseVideoDuration(card.Find(Selectors.VideoDuration).First().Text()); ok {
+			meta.Duration = d
+		}
+		if published, ok := core.ParsePublishedTime(card.Find(Selectors.VideoTime).First().Text(), now); ok {
+			meta.PublishedAt = published
+		}
+		// Yandex often links its own player page; the host label is then the
+		// only hint of where the clip lives.
+		if core.VideoPlatform(href) == "" {
+			meta.Platform = strings.ToLower(core.NormalizeWhitespace(card.Find(Selectors.VideoHost).First().Text()))
+		}
+
+		resultRank, absoluteRank := rank.Next(false)
+		results = append(results, core.SearchResult{
+			Rank:         resultRank,
+			AbsoluteRank: absoluteRank,
+			URL:          href,
+			Title:        title,
+			Video:        meta,
+		})
+	})
+	return core.DeduplicateResults(results)
+}
+
+// yandexVideoThumbnail makes avatars.mds.yandex.net's protocol-relative
+// thumbnail URLs absolute.
+func yandexVideoThumbnail(src string) string {
+	src = strings.TrimSpace(src)
+	switch {
+	case strings.HasPrefix(src, "//"):
+		return "https:" + src
+	case strings.HasPrefix(src, "http"):
+		return src
+	default:
+		return ""
+	}
+}
+
+// SearchVideos runs a raw HTTP request against Yandex video search.
+func SearchVideos(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, "yandex", false)
+
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexVideoPageSize)
+	if err != nil {
+		return nil, err
+	}
+	videoURL, err := BuildVideoURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	core.WithRequest(ctx).WithField("url", videoURL).Debug(fmt.Sprintf("Yandex Video URL built: %s", videoURL))
+
+	res, err := core.RawSearchRequest(ctx, videoURL, query)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DrainAndCloseResponse(res)
+
+	body, err := core.ReadRawSearchBody(res)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if pageStatus := classifyYandexDocument(doc); pageStatus != nil {
+		if errors.Is(pageStatus, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageStatus
+	}
+
+	results := parseYandexVideoDocument(doc, time.Now())
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: yandex video returned no parseable results", core.ErrParser)
+	}
+	return pageYandexVertical(results, query, pageNum, skip, yandexVideoPageSize), nil
+}
+
+// SearchVideos executes a Yandex video search in the browser.
+func (yand *Yandex) SearchVideos(ctx context.Context, query core.Query) ([]core.SearchResult, error) {
+	ctx = core.PrepareEngineContext(ctx, query, yand.Name(), false)
+	scoped := *yand
+	scoped.logger = yand.logger.WithRequest(ctx)
+	yand = &scoped
+
+	yand.logger.Debug("Starting video search, query: %+v", query)
+	pageNum, skip, err := core.ComputePagination(query.Start, yandexVideoPageSize)
+	if err != nil {
+		return nil, err
+	}
+	u, err := BuildVideoURL(query, pageNum)
+	if err != nil {
+		return nil, err
+	}
+	page, err := yand.Navigate(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer core.DeferClosePage(ctx, page, &yand.Browser)()
+
+	waitFor := []string{Selectors.VideoResults, Selectors.NoResults, Selectors.Captcha}
+	if _, _, err := core.WaitForElements(ctx, page, waitFor, yand.GetSelectorTimeout()); err != nil {
+		if pageErr := yand.classifyPage(page); pageErr != nil {
+			if errors.Is(pageErr, core.ErrEmptyResult) {
+				return []core.SearchResult{}, nil
+			}
+			yand.logger.Error("Page classified as %v: %s", pageErr, u)
+			return nil, pageErr
+		}
+		if core.IsContextDone(err) {
+			return nil, err
+		}
+		return nil, core.ErrSearchTimeout
+	}
+
+	doc, err := core.DocumentFromPage(page)
+	if err != nil {
+		return nil, core.ErrParser
+	}
+	if pageErr := classifyYandexDocument(doc); pageErr != nil {
+		if errors.Is(pageErr, core.ErrEmptyResult) {
+			return []core.SearchResult{}, nil
+		}
+		return nil, pageErr
+	}
+
+	results := parseYandexVideoDocument(doc, time.Now())
+	yand.logger.Info("Video search completed: %d results", len(results))
+	return pageYandexVertical(results, query, pageNum, skip, yandexVideoPageSize), nil
+}
@@ -0,0 +1,54 @@
+package yandex
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/karust/openserp/core"
+	"github.com/karust/openserp/testutil"
+)
+
+func TestParseYandexVideoDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(testutil.ResponseFromFixture(t, "video_results.html").Body)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+	now := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	results := parseYandexVideoDocument(doc, now)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 video results, got %d", len(results))
+	}
+	testutil.AssertSequentialRanks(t, results)
+
+	first := results[0].Video
+	if first.Duration != time.Hour+2*time.Minute+3*time.Second || first.Channel != "Google for Developers" {
+		t.Fatalf("unexpected video metadata: %+v", first)
+	}
+	if first.Platform != "" || first.Thumbnail != "https://avatars.mds.yandex.net/get-vthumb/123/abc/800x360" {
+		t.Fatalf("expected URL-derived platform and absolute thumbnail, got %+v", first)
+	}
+	if !first.PublishedAt.Equal(time.Date(2023, time.March, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected upload date %s", first.PublishedAt)
+	}
+
+	second := results[1].Video
+	if second.Platform != "дзен" || !second.PublishedAt.Equal(now.AddDate(0, 0, -3)) {
+		t.Fatalf("expected host label for player links, got %+v", second)
+	}
+}
+
+func TestBuildVideoURL(t *testing.T) {
+	u, err := BuildVideoURL(core.Query{Text: "golang", Site: "youtube.com"}, 1)
+	if err != nil {
+		t.Fatalf("BuildVideoURL() error = %v", err)
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		t.Fatalf("parse built URL: %v", err)
+	}
+	if parsed.Path != "/video/search" || parsed.Query().Get("text") != "golang site:youtube.com" || parsed.Query().Get("p") != "1" {
+		t.Fatalf("unexpected video URL: %s", u)
+	}
+}